@@ -20,15 +20,25 @@ type HandlerConfig struct {
 	ResolverTimeout         config.Duration   `toml:"resolvertimeout"`
 	ResolverNotFoundTimeout config.Duration   `toml:"resolvernotfoundtimeout"`
 	ActionTimeout           config.Duration   `toml:"actiontimeout"`
+	StreamTimeout           config.Duration   `toml:"streamtimeout"`
+	DrainTimeout            config.Duration   `toml:"draintimeout"`
 	ServerTLS               *loader.Config    `toml:"server"`
 	ClientTLS               *loader.Config    `toml:"client"`
 	GRPCClient              map[string]string `toml:"grpcclient"`
 	DBConn                  config.EnvString  `toml:"dbconn"`
+	AutoMigrate             bool              `toml:"automigrate"`
 	Addresses               map[string]string `toml:"addresses"`
 	Netname                 string            `toml:"netname"`
 
-	Log      stashconfig.Config `toml:"log"`
-	Database DatabaseConfig     `toml:"database"`
+	Log               stashconfig.Config      `toml:"log"`
+	Database          DatabaseConfig          `toml:"database"`
+	MaterializedViews MaterializedViewsConfig `toml:"materializedviews"`
+	Metrics           MetricsConfig           `toml:"metrics"`
+	Tracing           TracingConfig           `toml:"tracing"`
+	Health            HealthConfig            `toml:"health"`
+	Events            EventsConfig            `toml:"events"`
+	Placement         PlacementConfig         `toml:"placement"`
+	Forecast          ForecastConfig          `toml:"forecast"`
 }
 
 func LoadHandlerConfig(fSys fs.FS, fp string, conf *HandlerConfig) error {
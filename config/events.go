@@ -0,0 +1,12 @@
+package config
+
+import "github.com/je4/utils/v2/pkg/config"
+
+// EventsConfig configures the publishing of the lifecycle events. The events are published
+// as soon as the database notifies their commit, PollInterval is the time after which the
+// publisher looks for new events if no notification arrives. Published events older than
+// Retention are deleted, zero keeps them.
+type EventsConfig struct {
+	PollInterval config.Duration `toml:"pollinterval"`
+	Retention    config.Duration `toml:"retention"`
+}
@@ -0,0 +1,9 @@
+package config
+
+import "github.com/je4/utils/v2/pkg/config"
+
+// ForecastConfig configures the capacity forecast. The ingest rates are averaged over the
+// object instances created in Window, unless a request passes its own window.
+type ForecastConfig struct {
+	Window config.Duration `toml:"window"`
+}
@@ -0,0 +1,12 @@
+package config
+
+import "github.com/je4/utils/v2/pkg/config"
+
+// HealthConfig configures the health checks. Interval is the time between two checks of
+// the database. The services reading the materialized views are reported as not serving
+// while a refresh of a view is pending and its last refresh is older than MaxViewAge, zero
+// disables the check.
+type HealthConfig struct {
+	Interval   config.Duration `toml:"interval"`
+	MaxViewAge config.Duration `toml:"maxviewage"`
+}
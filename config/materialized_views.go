@@ -0,0 +1,10 @@
+package config
+
+import "github.com/je4/utils/v2/pkg/config"
+
+// MaterializedViewsConfig configures the background refresh of the materialized views.
+// MinRefreshInterval is the minimum time between the start of two refreshes, changes
+// within the interval are refreshed together.
+type MaterializedViewsConfig struct {
+	MinRefreshInterval config.Duration `toml:"minrefreshinterval"`
+}
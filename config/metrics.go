@@ -0,0 +1,10 @@
+package config
+
+import "github.com/je4/utils/v2/pkg/config"
+
+// MetricsConfig configures the prometheus metrics listener. Without Addr no metrics are
+// served. StatisticsInterval is the time between two updates of the archive statistics.
+type MetricsConfig struct {
+	Addr               string          `toml:"addr"`
+	StatisticsInterval config.Duration `toml:"statisticsinterval"`
+}
@@ -0,0 +1,10 @@
+package config
+
+import "github.com/je4/utils/v2/pkg/config"
+
+// PlacementConfig configures the placement of object instances on the storage partitions.
+// The capacity a placement claims on a partition is released when the object instance is
+// stored or after ReservationTTL.
+type PlacementConfig struct {
+	ReservationTTL config.Duration `toml:"reservationttl"`
+}
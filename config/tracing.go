@@ -0,0 +1,11 @@
+package config
+
+// TracingConfig configures the export of OpenTelemetry traces over OTLP/gRPC. Without
+// Endpoint no spans are recorded. SampleRatio is the share of the traces started by the
+// handler that are recorded, traces of callers keep their sampling decision.
+type TracingConfig struct {
+	Endpoint    string  `toml:"endpoint"`
+	Insecure    bool    `toml:"insecure"`
+	ServiceName string  `toml:"servicename"`
+	SampleRatio float64 `toml:"sampleratio"`
+}
@@ -12,7 +12,9 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
+	sync "sync"
 )
 
 const (
@@ -22,6 +24,1501 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// CursorPagination requests the page following cursor. The skip of the pagination is
+// ignored, an empty cursor requests the first page. The total is only counted if
+// withTotalItems is set, because counting requires a scan of the whole listing.
+type CursorPagination struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination     *dlzamanagerproto.Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Cursor         string                       `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	WithTotalItems bool                         `protobuf:"varint,3,opt,name=withTotalItems,proto3" json:"withTotalItems,omitempty"`
+}
+
+func (x *CursorPagination) Reset() {
+	*x = CursorPagination{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_handler_proto_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CursorPagination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CursorPagination) ProtoMessage() {}
+
+func (x *CursorPagination) ProtoReflect() protoreflect.Message {
+	mi := &file_handler_proto_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CursorPagination.ProtoReflect.Descriptor instead.
+func (*CursorPagination) Descriptor() ([]byte, []int) {
+	return file_handler_proto_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CursorPagination) GetPagination() *dlzamanagerproto.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *CursorPagination) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *CursorPagination) GetWithTotalItems() bool {
+	if x != nil {
+		return x.WithTotalItems
+	}
+	return false
+}
+
+// nextCursor is empty on the last page.
+type ObjectsPage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Objects    []*dlzamanagerproto.Object `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	NextCursor string                     `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	TotalItems int32                      `protobuf:"varint,3,opt,name=totalItems,proto3" json:"totalItems,omitempty"`
+}
+
+func (x *ObjectsPage) Reset() {
+	*x = ObjectsPage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_handler_proto_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObjectsPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectsPage) ProtoMessage() {}
+
+func (x *ObjectsPage) ProtoReflect() protoreflect.Message {
+	mi := &file_handler_proto_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectsPage.ProtoReflect.Descriptor instead.
+func (*ObjectsPage) Descriptor() ([]byte, []int) {
+	return file_handler_proto_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ObjectsPage) GetObjects() []*dlzamanagerproto.Object {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+func (x *ObjectsPage) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ObjectsPage) GetTotalItems() int32 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+type FilesPage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Files      []*dlzamanagerproto.File `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	NextCursor string                   `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	TotalItems int32                    `protobuf:"varint,3,opt,name=totalItems,proto3" json:"totalItems,omitempty"`
+}
+
+func (x *FilesPage) Reset() {
+	*x = FilesPage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_handler_proto_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilesPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilesPage) ProtoMessage() {}
+
+func (x *FilesPage) ProtoReflect() protoreflect.Message {
+	mi := &file_handler_proto_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilesPage.ProtoReflect.Descriptor instead.
+func (*FilesPage) Descriptor() ([]byte, []int) {
+	return file_handler_proto_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FilesPage) GetFiles() []*dlzamanagerproto.File {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *FilesPage) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *FilesPage) GetTotalItems() int32 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+// MaterializedViews names materialized views, an empty list stands for all views.
+type MaterializedViews struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Views []string `protobuf:"bytes,1,rep,name=views,proto3" json:"views,omitempty"`
+}
+
+func (x *MaterializedViews) Reset() {
+	*x = MaterializedViews{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_handler_proto_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaterializedViews) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaterializedViews) ProtoMessage() {}
+
+func (x *MaterializedViews) ProtoReflect() protoreflect.Message {
+	mi := &file_handler_proto_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaterializedViews.ProtoReflect.Descriptor instead.
+func (*MaterializedViews) Descriptor() ([]byte, []int) {
+	return file_handler_proto_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MaterializedViews) GetViews() []string {
+	if x != nil {
+		return x.Views
+	}
+	return nil
+}
+
+// lastRefresh is the start of the last successful refresh, it is not set if it is not
+// known. pending is set while a refresh of the view is requested but has not started yet.
+type MaterializedViewRefresh struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	View        string                 `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	LastRefresh *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=lastRefresh,proto3" json:"lastRefresh,omitempty"`
+	Pending     bool                   `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+}
+
+func (x *MaterializedViewRefresh) Reset() {
+	*x = MaterializedViewRefresh{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_handler_proto_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaterializedViewRefresh) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaterializedViewRefresh) ProtoMessage() {}
+
+func (x *MaterializedViewRefresh) ProtoReflect() protoreflect.Message {
+	mi := &file_handler_proto_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaterializedViewRefresh.ProtoReflect.Descriptor instead.
+func (*MaterializedViewRefresh) Descriptor() ([]byte, []int) {
+	return file_handler_proto_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MaterializedViewRefresh) GetView() string {
+	if x != nil {
+		return x.View
+	}
+	return ""
+}
+
+func (x *MaterializedViewRefresh) GetLastRefresh() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRefresh
+	}
+	return nil
+}
+
+func (x *MaterializedViewRefresh) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+type MaterializedViewRefreshes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaterializedViewRefreshes []*MaterializedViewRefresh `protobuf:"bytes,1,rep,name=materializedViewRefreshes,proto3" json:"materializedViewRefreshes,omitempty"`
+}
+
+func (x *MaterializedViewRefreshes) Reset() {
+	*x = MaterializedViewRefreshes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_handler_proto_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaterializedViewRefreshes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaterializedViewRefreshes) ProtoMessage() {}
+
+func (x *MaterializedViewRefreshes) ProtoReflect() protoreflect.Message {
+	mi := &file_handler_proto_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaterializedViewRefreshes.ProtoReflect.Descriptor instead.
+func (*MaterializedViewRefreshes) Descriptor() ([]byte, []int) {
+	return file_handler_proto_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MaterializedViewRefreshes) GetMaterializedViewRefreshes() []*MaterializedViewRefresh {
+	if x != nil {
+		return x.MaterializedViewRefreshes
+	}
+	return nil
+}
+
+// RepairCandidate is an object instance with status error, the target, together with a
+// healthy instance of the same object it can be restored from, the source.
+type RepairCandidate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ObjectId                   string `protobuf:"bytes,1,opt,name=objectId,proto3" json:"objectId,omitempty"`
+	SourceObjectInstanceId     string `protobuf:"bytes,2,opt,name=sourceObjectInstanceId,proto3" json:"sourceObjectInstanceId,omitempty"`
+	SourcePath                 string `protobuf:"bytes,3,opt,name=sourcePath,proto3" json:"sourcePath,omitempty"`
+	SourceStorageLocationId    string `protobuf:"bytes,4,opt,name=sourceStorageLocationId,proto3" json:"sourceStorageLocationId,omitempty"`
+	SourceStorageLocationAlias string `protobuf:"bytes,5,opt,name=sourceStorageLocationAlias,proto3" json:"sourceStorageLocationAlias,omitempty"`
+	TargetObjectInstanceId     string `protobuf:"bytes,6,opt,name=targetObjectInstanceId,proto3" json:"targetObjectInstanceId,omitempty"`
+	TargetPath                 string `protobuf:"bytes,7,opt,name=targetPath,proto3" json:"targetPath,omitempty"`
+	TargetStorageLocationId    string `protobuf:"bytes,8,opt,name=targetStorageLocationId,proto3" json:"targetStorageLocationId,omitempty"`
+	TargetStorageLocationAlias string `protobuf:"bytes,9,opt,name=targetStorageLocationAlias,proto3" json:"targetStorageLocationAlias,omitempty"`
+}
+
+func (x *RepairCandidate) Reset() {
+	*x = RepairCandidate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_handler_proto_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepairCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairCandidate) ProtoMessage() {}
+
+func (x *RepairCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_handler_proto_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairCandidate.ProtoReflect.Descriptor instead.
+func (*RepairCandidate) Descriptor() ([]byte, []int) {
+	return file_handler_proto_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RepairCandidate) GetObjectId() string {
+	if x != nil {
+		return x.ObjectId
+	}
+	return ""
+}
+
+func (x *RepairCandidate) GetSourceObjectInstanceId() string {
+	if x != nil {
+		return x.SourceObjectInstanceId
+	}
+	return ""
+}
+
+func (x *RepairCandidate) GetSourcePath() string {
+	if x != nil {
+		return x.SourcePath
+	}
+	return ""
+}
+
+func (x *RepairCandidate) GetSourceStorageLocationId() string {
+	if x != nil {
+		return x.SourceStorageLocationId
+	}
+	return ""
+}
+
+func (x *RepairCandidate) GetSourceStorageLocationAlias() string {
+	if x != nil {
+		return x.SourceStorageLocationAlias
+	}
+	return ""
+}
+
+func (x *RepairCandidate) GetTargetObjectInstanceId() string {
+	if x != nil {
+		return x.TargetObjectInstanceId
+	}
+	return ""
+}
+
+func (x *RepairCandidate) GetTargetPath() string {
+	if x != nil {
+		return x.TargetPath
+	}
+	return ""
+}
+
+func (x *RepairCandidate) GetTargetStorageLocationId() string {
+	if x != nil {
+		return x.TargetStorageLocationId
+	}
+	return ""
+}
+
+func (x *RepairCandidate) GetTargetStorageLocationAlias() string {
+	if x != nil {
+		return x.TargetStorageLocationAlias
+	}
+	return ""
+}
+
+// AuditLogQuery selects entries of the audit log, empty filters match all entries. The
+// entries are sorted by the time of the change, the sort direction of the pagination
+// decides the order. since is inclusive, until exclusive.
+type AuditLogQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityType string                 `protobuf:"bytes,1,opt,name=entityType,proto3" json:"entityType,omitempty"`
+	EntityId   string                 `protobuf:"bytes,2,opt,name=entityId,proto3" json:"entityId,omitempty"`
+	Actor      string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Method     string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	Since      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=since,proto3" json:"since,omitempty"`
+	Until      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=until,proto3" json:"until,omitempty"`
+	Pagination *CursorPagination      `protobuf:"bytes,7,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *AuditLogQuery) Reset() {
+	*x = AuditLogQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_handler_proto_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLogQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogQuery) ProtoMessage() {}
+
+func (x *AuditLogQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_handler_proto_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogQuery.ProtoReflect.Descriptor instead.
+func (*AuditLogQuery) Descriptor() ([]byte, []int) {
+	return file_handler_proto_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AuditLogQuery) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *AuditLogQuery) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *AuditLogQuery) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditLogQuery) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditLogQuery) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *AuditLogQuery) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *AuditLogQuery) GetPagination() *CursorPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// AuditEntry is a change made through the clerk handler. before and after are the entity
+// as JSON, before is empty for created and after for deleted entities.
+type AuditEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created,proto3" json:"created,omitempty"`
+	Actor      string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Client     string                 `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
+	Method     string                 `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	EntityType string                 `protobuf:"bytes,6,opt,name=entityType,proto3" json:"entityType,omitempty"`
+	EntityId   string                 `protobuf:"bytes,7,opt,name=entityId,proto3" json:"entityId,omitempty"`
+	Before     string                 `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
+	After      string                 `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_handler_proto_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_handler_proto_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_handler_proto_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AuditEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+// nextCursor is empty on the last page.
+type AuditLogPage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuditEntries []*AuditEntry `protobuf:"bytes,1,rep,name=auditEntries,proto3" json:"auditEntries,omitempty"`
+	NextCursor   string        `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	TotalItems   int32         `protobuf:"varint,3,opt,name=totalItems,proto3" json:"totalItems,omitempty"`
+}
+
+func (x *AuditLogPage) Reset() {
+	*x = AuditLogPage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_handler_proto_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLogPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogPage) ProtoMessage() {}
+
+func (x *AuditLogPage) ProtoReflect() protoreflect.Message {
+	mi := &file_handler_proto_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogPage.ProtoReflect.Descriptor instead.
+func (*AuditLogPage) Descriptor() ([]byte, []int) {
+	return file_handler_proto_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AuditLogPage) GetAuditEntries() []*AuditEntry {
+	if x != nil {
+		return x.AuditEntries
+	}
+	return nil
+}
+
+func (x *AuditLogPage) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *AuditLogPage) GetTotalItems() int32 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+// WatchEventsRequest streams the lifecycle events with an offset greater than afterOffset,
+// a watcher resumes by passing the offset of the last event it has received. A negative
+// afterOffset streams only the events published from now on. types selects the event
+// types, an empty list selects all.
+type WatchEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AfterOffset int64    `protobuf:"varint,1,opt,name=afterOffset,proto3" json:"afterOffset,omitempty"`
+	Types       []string `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+}
+
+func (x *WatchEventsRequest) Reset() {
+	*x = WatchEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_handler_proto_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEventsRequest) ProtoMessage() {}
+
+func (x *WatchEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_handler_proto_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEventsRequest.ProtoReflect.Descriptor instead.
+func (*WatchEventsRequest) Descriptor() ([]byte, []int) {
+	return file_handler_proto_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WatchEventsRequest) GetAfterOffset() int64 {
+	if x != nil {
+		return x.AfterOffset
+	}
+	return 0
+}
+
+func (x *WatchEventsRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+// LifecycleEvent is a change of an object instance or archiving status. The offsets are
+// consecutive in the order the changes have been committed, payload is the changed entity
+// as JSON.
+type LifecycleEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset   int64                  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Created  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created,proto3" json:"created,omitempty"`
+	Type     string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	EntityId string                 `protobuf:"bytes,4,opt,name=entityId,proto3" json:"entityId,omitempty"`
+	Payload  string                 `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *LifecycleEvent) Reset() {
+	*x = LifecycleEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_handler_proto_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LifecycleEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LifecycleEvent) ProtoMessage() {}
+
+func (x *LifecycleEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_handler_proto_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LifecycleEvent.ProtoReflect.Descriptor instead.
+func (*LifecycleEvent) Descriptor() ([]byte, []int) {
+	return file_handler_proto_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LifecycleEvent) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *LifecycleEvent) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *LifecycleEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *LifecycleEvent) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *LifecycleEvent) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+// PlacementStrategy is the strategy choosing the partition of the storage location new
+// object instances are placed on: fill-first, least-used, round-robin or hash-by-signature.
+type PlacementStrategy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StorageLocationId string `protobuf:"bytes,1,opt,name=storageLocationId,proto3" json:"storageLocationId,omitempty"`
+	Strategy          string `protobuf:"bytes,2,opt,name=strategy,proto3" json:"strategy,omitempty"`
+}
+
+func (x *PlacementStrategy) Reset() {
+	*x = PlacementStrategy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_handler_proto_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlacementStrategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacementStrategy) ProtoMessage() {}
+
+func (x *PlacementStrategy) ProtoReflect() protoreflect.Message {
+	mi := &file_handler_proto_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacementStrategy.ProtoReflect.Descriptor instead.
+func (*PlacementStrategy) Descriptor() ([]byte, []int) {
+	return file_handler_proto_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PlacementStrategy) GetStorageLocationId() string {
+	if x != nil {
+		return x.StorageLocationId
+	}
+	return ""
+}
+
+func (x *PlacementStrategy) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+// PartitionProvisioning creates a partition of the storage location with maxSize and
+// maxObjects when no partition has room for an object, up to maxPartitions partitions.
+type PartitionProvisioning struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StorageLocationId string `protobuf:"bytes,1,opt,name=storageLocationId,proto3" json:"storageLocationId,omitempty"`
+	Enabled           bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	MaxSize           int64  `protobuf:"varint,3,opt,name=maxSize,proto3" json:"maxSize,omitempty"`
+	MaxObjects        int64  `protobuf:"varint,4,opt,name=maxObjects,proto3" json:"maxObjects,omitempty"`
+	MaxPartitions     int32  `protobuf:"varint,5,opt,name=maxPartitions,proto3" json:"maxPartitions,omitempty"`
+}
+
+func (x *PartitionProvisioning) Reset() {
+	*x = PartitionProvisioning{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_handler_proto_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PartitionProvisioning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartitionProvisioning) ProtoMessage() {}
+
+func (x *PartitionProvisioning) ProtoReflect() protoreflect.Message {
+	mi := &file_handler_proto_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartitionProvisioning.ProtoReflect.Descriptor instead.
+func (*PartitionProvisioning) Descriptor() ([]byte, []int) {
+	return file_handler_proto_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PartitionProvisioning) GetStorageLocationId() string {
+	if x != nil {
+		return x.StorageLocationId
+	}
+	return ""
+}
+
+func (x *PartitionProvisioning) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *PartitionProvisioning) GetMaxSize() int64 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+func (x *PartitionProvisioning) GetMaxObjects() int64 {
+	if x != nil {
+		return x.MaxObjects
+	}
+	return 0
+}
+
+func (x *PartitionProvisioning) GetMaxPartitions() int32 {
+	if x != nil {
+		return x.MaxPartitions
+	}
+	return 0
+}
+
+// CapacityForecastRequest selects the storage location to forecast, all locations if
+// storageLocationId is empty. The ingest rates are averaged over the last windowDays days,
+// at most 3650, over the configured window if it is 0.
+type CapacityForecastRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StorageLocationId string `protobuf:"bytes,1,opt,name=storageLocationId,proto3" json:"storageLocationId,omitempty"`
+	WindowDays        int32  `protobuf:"varint,2,opt,name=windowDays,proto3" json:"windowDays,omitempty"`
+}
+
+func (x *CapacityForecastRequest) Reset() {
+	*x = CapacityForecastRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_handler_proto_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CapacityForecastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapacityForecastRequest) ProtoMessage() {}
+
+func (x *CapacityForecastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_handler_proto_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapacityForecastRequest.ProtoReflect.Descriptor instead.
+func (*CapacityForecastRequest) Descriptor() ([]byte, []int) {
+	return file_handler_proto_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CapacityForecastRequest) GetStorageLocationId() string {
+	if x != nil {
+		return x.StorageLocationId
+	}
+	return ""
+}
+
+func (x *CapacityForecastRequest) GetWindowDays() int32 {
+	if x != nil {
+		return x.WindowDays
+	}
+	return 0
+}
+
+// CapacityProjection is the capacity with the mean daily ingest and the days until it is
+// full at that rate. The low and high rates bound the mean with 95% confidence, the
+// earliest and latest days until full follow from them. The days until full are the
+// earlier of size and objects, -1 if the ingest does not exhaust the capacity.
+type CapacityProjection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurrentSize           int64   `protobuf:"varint,1,opt,name=currentSize,proto3" json:"currentSize,omitempty"`
+	MaxSize               int64   `protobuf:"varint,2,opt,name=maxSize,proto3" json:"maxSize,omitempty"`
+	CurrentObjects        int64   `protobuf:"varint,3,opt,name=currentObjects,proto3" json:"currentObjects,omitempty"`
+	MaxObjects            int64   `protobuf:"varint,4,opt,name=maxObjects,proto3" json:"maxObjects,omitempty"`
+	DailySize             float64 `protobuf:"fixed64,5,opt,name=dailySize,proto3" json:"dailySize,omitempty"`
+	DailySizeLow          float64 `protobuf:"fixed64,6,opt,name=dailySizeLow,proto3" json:"dailySizeLow,omitempty"`
+	DailySizeHigh         float64 `protobuf:"fixed64,7,opt,name=dailySizeHigh,proto3" json:"dailySizeHigh,omitempty"`
+	DailyObjects          float64 `protobuf:"fixed64,8,opt,name=dailyObjects,proto3" json:"dailyObjects,omitempty"`
+	DailyObjectsLow       float64 `protobuf:"fixed64,9,opt,name=dailyObjectsLow,proto3" json:"dailyObjectsLow,omitempty"`
+	DailyObjectsHigh      float64 `protobuf:"fixed64,10,opt,name=dailyObjectsHigh,proto3" json:"dailyObjectsHigh,omitempty"`
+	DaysUntilFull         float64 `protobuf:"fixed64,11,opt,name=daysUntilFull,proto3" json:"daysUntilFull,omitempty"`
+	DaysUntilFullEarliest float64 `protobuf:"fixed64,12,opt,name=daysUntilFullEarliest,proto3" json:"daysUntilFullEarliest,omitempty"`
+	DaysUntilFullLatest   float64 `protobuf:"fixed64,13,opt,name=daysUntilFullLatest,proto3" json:"daysUntilFullLatest,omitempty"`
+}
+
+func (x *CapacityProjection) Reset() {
+	*x = CapacityProjection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_handler_proto_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CapacityProjection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapacityProjection) ProtoMessage() {}
+
+func (x *CapacityProjection) ProtoReflect() protoreflect.Message {
+	mi := &file_handler_proto_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapacityProjection.ProtoReflect.Descriptor instead.
+func (*CapacityProjection) Descriptor() ([]byte, []int) {
+	return file_handler_proto_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CapacityProjection) GetCurrentSize() int64 {
+	if x != nil {
+		return x.CurrentSize
+	}
+	return 0
+}
+
+func (x *CapacityProjection) GetMaxSize() int64 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+func (x *CapacityProjection) GetCurrentObjects() int64 {
+	if x != nil {
+		return x.CurrentObjects
+	}
+	return 0
+}
+
+func (x *CapacityProjection) GetMaxObjects() int64 {
+	if x != nil {
+		return x.MaxObjects
+	}
+	return 0
+}
+
+func (x *CapacityProjection) GetDailySize() float64 {
+	if x != nil {
+		return x.DailySize
+	}
+	return 0
+}
+
+func (x *CapacityProjection) GetDailySizeLow() float64 {
+	if x != nil {
+		return x.DailySizeLow
+	}
+	return 0
+}
+
+func (x *CapacityProjection) GetDailySizeHigh() float64 {
+	if x != nil {
+		return x.DailySizeHigh
+	}
+	return 0
+}
+
+func (x *CapacityProjection) GetDailyObjects() float64 {
+	if x != nil {
+		return x.DailyObjects
+	}
+	return 0
+}
+
+func (x *CapacityProjection) GetDailyObjectsLow() float64 {
+	if x != nil {
+		return x.DailyObjectsLow
+	}
+	return 0
+}
+
+func (x *CapacityProjection) GetDailyObjectsHigh() float64 {
+	if x != nil {
+		return x.DailyObjectsHigh
+	}
+	return 0
+}
+
+func (x *CapacityProjection) GetDaysUntilFull() float64 {
+	if x != nil {
+		return x.DaysUntilFull
+	}
+	return 0
+}
+
+func (x *CapacityProjection) GetDaysUntilFullEarliest() float64 {
+	if x != nil {
+		return x.DaysUntilFullEarliest
+	}
+	return 0
+}
+
+func (x *CapacityProjection) GetDaysUntilFullLatest() float64 {
+	if x != nil {
+		return x.DaysUntilFullLatest
+	}
+	return 0
+}
+
+type PartitionForecast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StoragePartitionId string              `protobuf:"bytes,1,opt,name=storagePartitionId,proto3" json:"storagePartitionId,omitempty"`
+	Alias              string              `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	Projection         *CapacityProjection `protobuf:"bytes,3,opt,name=projection,proto3" json:"projection,omitempty"`
+}
+
+func (x *PartitionForecast) Reset() {
+	*x = PartitionForecast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_handler_proto_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PartitionForecast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartitionForecast) ProtoMessage() {}
+
+func (x *PartitionForecast) ProtoReflect() protoreflect.Message {
+	mi := &file_handler_proto_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartitionForecast.ProtoReflect.Descriptor instead.
+func (*PartitionForecast) Descriptor() ([]byte, []int) {
+	return file_handler_proto_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PartitionForecast) GetStoragePartitionId() string {
+	if x != nil {
+		return x.StoragePartitionId
+	}
+	return ""
+}
+
+func (x *PartitionForecast) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *PartitionForecast) GetProjection() *CapacityProjection {
+	if x != nil {
+		return x.Projection
+	}
+	return nil
+}
+
+type TenantIngestRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId     string  `protobuf:"bytes,1,opt,name=tenantId,proto3" json:"tenantId,omitempty"`
+	DailySize    float64 `protobuf:"fixed64,2,opt,name=dailySize,proto3" json:"dailySize,omitempty"`
+	DailyObjects float64 `protobuf:"fixed64,3,opt,name=dailyObjects,proto3" json:"dailyObjects,omitempty"`
+}
+
+func (x *TenantIngestRate) Reset() {
+	*x = TenantIngestRate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_handler_proto_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TenantIngestRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantIngestRate) ProtoMessage() {}
+
+func (x *TenantIngestRate) ProtoReflect() protoreflect.Message {
+	mi := &file_handler_proto_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantIngestRate.ProtoReflect.Descriptor instead.
+func (*TenantIngestRate) Descriptor() ([]byte, []int) {
+	return file_handler_proto_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TenantIngestRate) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TenantIngestRate) GetDailySize() float64 {
+	if x != nil {
+		return x.DailySize
+	}
+	return 0
+}
+
+func (x *TenantIngestRate) GetDailyObjects() float64 {
+	if x != nil {
+		return x.DailyObjects
+	}
+	return 0
+}
+
+// LocationForecast is the projection of a storage location, including the partitions it
+// can still be grown by if partition provisioning is enabled.
+type LocationForecast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StorageLocationId string               `protobuf:"bytes,1,opt,name=storageLocationId,proto3" json:"storageLocationId,omitempty"`
+	Alias             string               `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	Projection        *CapacityProjection  `protobuf:"bytes,3,opt,name=projection,proto3" json:"projection,omitempty"`
+	Partitions        []*PartitionForecast `protobuf:"bytes,4,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	Tenants           []*TenantIngestRate  `protobuf:"bytes,5,rep,name=tenants,proto3" json:"tenants,omitempty"`
+}
+
+func (x *LocationForecast) Reset() {
+	*x = LocationForecast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_handler_proto_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocationForecast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationForecast) ProtoMessage() {}
+
+func (x *LocationForecast) ProtoReflect() protoreflect.Message {
+	mi := &file_handler_proto_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationForecast.ProtoReflect.Descriptor instead.
+func (*LocationForecast) Descriptor() ([]byte, []int) {
+	return file_handler_proto_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *LocationForecast) GetStorageLocationId() string {
+	if x != nil {
+		return x.StorageLocationId
+	}
+	return ""
+}
+
+func (x *LocationForecast) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *LocationForecast) GetProjection() *CapacityProjection {
+	if x != nil {
+		return x.Projection
+	}
+	return nil
+}
+
+func (x *LocationForecast) GetPartitions() []*PartitionForecast {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+func (x *LocationForecast) GetTenants() []*TenantIngestRate {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+type CapacityForecast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locations  []*LocationForecast    `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
+	WindowDays int32                  `protobuf:"varint,2,opt,name=windowDays,proto3" json:"windowDays,omitempty"`
+	Generated  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=generated,proto3" json:"generated,omitempty"`
+}
+
+func (x *CapacityForecast) Reset() {
+	*x = CapacityForecast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_handler_proto_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CapacityForecast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapacityForecast) ProtoMessage() {}
+
+func (x *CapacityForecast) ProtoReflect() protoreflect.Message {
+	mi := &file_handler_proto_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapacityForecast.ProtoReflect.Descriptor instead.
+func (*CapacityForecast) Descriptor() ([]byte, []int) {
+	return file_handler_proto_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CapacityForecast) GetLocations() []*LocationForecast {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+func (x *CapacityForecast) GetWindowDays() int32 {
+	if x != nil {
+		return x.WindowDays
+	}
+	return 0
+}
+
+func (x *CapacityForecast) GetGenerated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Generated
+	}
+	return nil
+}
+
 var File_handler_proto_proto protoreflect.FileDescriptor
 
 var file_handler_proto_proto_rawDesc = []byte{
@@ -29,798 +1526,1213 @@ var file_handler_proto_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x10, 0x64, 0x6c, 0x7a, 0x61, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x15, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xd5, 0x04, 0x0a, 0x15, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x2e, 0x64, 0x6c,
-	0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x19, 0x2e,
-	0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x4e, 0x6f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x19, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x25, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x1a, 0x19,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x10, 0x64, 0x6c, 0x7a, 0x61, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x90, 0x01, 0x0a, 0x10, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x3c, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x77,
+	0x69, 0x74, 0x68, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x81, 0x01,
+	0x0a, 0x0b, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a,
+	0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
 	0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x4e, 0x6f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0d, 0x47,
-	0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x79, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64,
-	0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x49, 0x64, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x00, 0x12, 0x6b,
-	0x0a, 0x29, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x42, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c,
-	0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49,
-	0x64, 0x1a, 0x26, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x1d, 0x47,
-	0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x73, 0x42, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64,
-	0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x49, 0x64, 0x1a, 0x21, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x7a, 0x0a, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x68, 0x61, 0x6e, 0x57, 0x69, 0x74,
-	0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12, 0x24, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x73, 0x57, 0x69,
-	0x74, 0x68, 0x53, 0x51, 0x4c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x1a, 0x20, 0x2e,
+	0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x22, 0x79, 0x0a, 0x09, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x2c,
+	0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
 	0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22,
-	0x00, 0x32, 0xc4, 0x0e, 0x0a, 0x1c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x48, 0x61, 0x6e,
-	0x64, 0x6c, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0f, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x48, 0x61, 0x73,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
-	0x65, 0x72, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0x18,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x29, 0x0a, 0x11,
+	0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x56, 0x69, 0x65, 0x77,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x17, 0x4d, 0x61, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x3c, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22,
+	0x80, 0x01, 0x0a, 0x19, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
+	0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x65, 0x73, 0x12, 0x63, 0x0a,
+	0x19, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x56, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x56, 0x69, 0x65, 0x77,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x19, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x65, 0x73, 0x22, 0xd1, 0x03, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x43, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x64, 0x12, 0x36, 0x0a, 0x16, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x16, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x38, 0x0a, 0x17, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x1a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x69,
+	0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x6c, 0x69, 0x61, 0x73, 0x12, 0x36, 0x0a, 0x16, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x38, 0x0a, 0x17,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x1a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x6c, 0x69, 0x61, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x22, 0x9d, 0x02, 0x0a, 0x0d, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x4c, 0x6f, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73,
+	0x69, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x3e, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x82, 0x02, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x8c, 0x01, 0x0a, 0x0c,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x50, 0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x0c,
+	0x61, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x61, 0x75,
+	0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65,
+	0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x4c, 0x0a, 0x12, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x66, 0x74, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x66, 0x74, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x66,
+	0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x22, 0x5d, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x22, 0xbf, 0x01, 0x0a, 0x15, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x11,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x24,
+	0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x67, 0x0a, 0x17, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2c, 0x0a, 0x11, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x44, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x44, 0x61, 0x79, 0x73, 0x22, 0x88, 0x04,
+	0x0a, 0x12, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x26, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61,
+	0x78, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x61, 0x69, 0x6c,
+	0x79, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x64, 0x61, 0x69,
+	0x6c, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x53,
+	0x69, 0x7a, 0x65, 0x4c, 0x6f, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x64, 0x61,
+	0x69, 0x6c, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x4c, 0x6f, 0x77, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x61,
+	0x69, 0x6c, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x48, 0x69, 0x67, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x48, 0x69, 0x67, 0x68,
+	0x12, 0x22, 0x0a, 0x0c, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x4c, 0x6f, 0x77, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x64,
+	0x61, 0x69, 0x6c, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x4c, 0x6f, 0x77, 0x12, 0x2a,
+	0x0a, 0x10, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x48, 0x69,
+	0x67, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x48, 0x69, 0x67, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x61,
+	0x79, 0x73, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x46, 0x75, 0x6c, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x64, 0x61, 0x79, 0x73, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x46, 0x75, 0x6c, 0x6c,
+	0x12, 0x34, 0x0a, 0x15, 0x64, 0x61, 0x79, 0x73, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x46, 0x75, 0x6c,
+	0x6c, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x15, 0x64, 0x61, 0x79, 0x73, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x46, 0x75, 0x6c, 0x6c, 0x45, 0x61,
+	0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x64, 0x61, 0x79, 0x73, 0x55, 0x6e,
+	0x74, 0x69, 0x6c, 0x46, 0x75, 0x6c, 0x6c, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x13, 0x64, 0x61, 0x79, 0x73, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x46, 0x75,
+	0x6c, 0x6c, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x11, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x2e,
+	0x0a, 0x12, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x12, 0x40, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x70, 0x0a, 0x10, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x64, 0x61, 0x69, 0x6c, 0x79,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x64, 0x61, 0x69, 0x6c,
+	0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0x93, 0x02, 0x0a, 0x10, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x2c, 0x0a,
+	0x11, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61,
+	0x73, 0x12, 0x40, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x6e, 0x67, 0x65, 0x73,
+	0x74, 0x52, 0x61, 0x74, 0x65, 0x52, 0x07, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x22, 0xaa,
+	0x01, 0x0a, 0x10, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x46, 0x6f, 0x72, 0x65, 0x63,
+	0x61, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f,
+	0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x44, 0x61, 0x79, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x44, 0x61, 0x79,
+	0x73, 0x12, 0x38, 0x0a, 0x09, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x32, 0xf6, 0x05, 0x0a, 0x15,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x2e,
+	0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a,
+	0x19, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x19,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x25, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x1a, 0x19, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x22, 0x00, 0x12, 0x41, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x79, 0x49, 0x64, 0x12, 0x14,
 	0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x1b, 0x46, 0x69,
-	0x6e, 0x64, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a,
-	0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x16, 0x47,
-	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x22, 0x2e,
+	0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x00,
+	0x12, 0x6b, 0x0a, 0x29, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x42, 0x79, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x2e,
 	0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x21, 0x2e, 0x64, 0x6c,
-	0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x1a, 0x22,
+	0x2e, 0x49, 0x64, 0x1a, 0x26, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x22, 0x00, 0x12, 0x58, 0x0a,
+	0x1d, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14,
 	0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x22, 0x2e, 0x64, 0x6c,
-	0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
-	0x00, 0x12, 0x7d, 0x0a, 0x25, 0x53, 0x61, 0x76, 0x65, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x41, 0x66, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x70,
-	0x79, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x38, 0x2e, 0x64, 0x6c, 0x7a,
-	0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x57, 0x69, 0x74, 0x68,
-	0x46, 0x69, 0x6c, 0x65, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x28, 0x01,
-	0x12, 0x51, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a,
-	0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64,
-	0x1a, 0x21, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x75, 0x0a, 0x2b, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x64, 0x53, 0x61, 0x76,
-	0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x74, 0x41, 0x6c, 0x69,
-	0x61, 0x73, 0x12, 0x22, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x22, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5b, 0x0a, 0x1b, 0x47, 0x65,
-	0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x21, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x1a, 0x19, 0x2e, 0x64,
+	0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x21, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x7a, 0x0a, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x78, 0x63, 0x65,
+	0x70, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x68, 0x61, 0x6e, 0x57,
+	0x69, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12, 0x24, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x73,
+	0x57, 0x69, 0x74, 0x68, 0x53, 0x51, 0x4c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x1a,
+	0x20, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72,
+	0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x30, 0x01, 0x12, 0x4f, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x20, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x30, 0x01, 0x32, 0xc4, 0x0e, 0x0a, 0x1c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0f, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x48, 0x61, 0x73, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x4f, 0x0a,
+	0x1b, 0x46, 0x69, 0x6e, 0x64, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x42, 0x79, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x14, 0x2e, 0x64,
 	0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x58, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x73, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79,
+	0x49, 0x64, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x56,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x22, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x21,
+	0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x69, 0x61,
+	0x73, 0x1a, 0x22, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79,
 	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x21,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x22,
 	0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x73, 0x12, 0x4e, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x14, 0x2e, 0x64, 0x6c,
+	0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x00, 0x12, 0x7d, 0x0a, 0x25, 0x53, 0x61, 0x76, 0x65, 0x41, 0x6c, 0x6c, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x41, 0x66, 0x74, 0x65, 0x72,
+	0x43, 0x6f, 0x70, 0x79, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x38, 0x2e,
+	0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x57,
+	0x69, 0x74, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x28, 0x01, 0x12, 0x51, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x12, 0x14, 0x2e,
+	0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x49, 0x64, 0x1a, 0x21, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x75, 0x0a, 0x2b, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x64,
+	0x53, 0x61, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x74,
+	0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x22, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x22, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5b, 0x0a,
+	0x1b, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x21, 0x2e, 0x64,
+	0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x1a,
+	0x19, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x58, 0x0a, 0x1d, 0x47, 0x65,
+	0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x42, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c,
 	0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49,
-	0x64, 0x12, 0x64, 0x0a, 0x27, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50,
-	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64,
+	0x64, 0x1a, 0x21, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x2e, 0x64,
 	0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x49, 0x64, 0x1a, 0x23, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x46, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x14,
+	0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x49, 0x64, 0x12, 0x64, 0x0a, 0x27, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
 	0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x49, 0x0a, 0x0b, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e,
+	0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x23, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x46, 0x0a, 0x14, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x49, 0x0a, 0x0b, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1e, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x41, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x79, 0x49, 0x64, 0x12, 0x14,
 	0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0x18,
+	0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x00,
+	0x12, 0x61, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x21,
 	0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0d, 0x47, 0x65,
-	0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x79, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c,
-	0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49,
-	0x64, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x00, 0x12, 0x61, 0x0a,
-	0x24, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x21, 0x2e, 0x64, 0x6c,
+	0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x22, 0x2e, 0x64, 0x6c,
 	0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00,
-	0x12, 0x6a, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61,
-	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x22, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x0e,
-	0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x19,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x48, 0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x73, 0x12, 0x19, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x1a, 0x19, 0x2e, 0x64,
+	0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x29, 0x47, 0x65, 0x74,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x79,
+	0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x41, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x1a, 0x20, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x00, 0x32, 0x94, 0x2f, 0x0a, 0x13,
+	0x43, 0x6c, 0x65, 0x72, 0x6b, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x64, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x42, 0x79, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x18, 0x2e, 0x64,
+	0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x18,
 	0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x4e, 0x6f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x1a, 0x19, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x65, 0x6e,
-	0x61, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x29, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x79, 0x46, 0x69, 0x6c,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x64, 0x12, 0x1f, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x6e, 0x64,
-	0x46, 0x69, 0x6c, 0x65, 0x1a, 0x20, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x00, 0x32, 0xbc, 0x25, 0x0a, 0x13, 0x43, 0x6c, 0x65,
-	0x72, 0x6b, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x3f, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x1d, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x42, 0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x64, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x42,
-	0x79, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x65, 0x6e,
-	0x61, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54,
-	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x18, 0x2e, 0x64, 0x6c,
-	0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0a, 0x53, 0x61, 0x76, 0x65, 0x54,
-	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x1a,
-	0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0c, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x64, 0x6c,
-	0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54,
-	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0x00, 0x12, 0x48, 0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x54, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x1a, 0x19,
+	0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0a, 0x53, 0x61,
+	0x76, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x44,
+	0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x18,
 	0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x1d, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x42, 0x79, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64,
-	0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x49, 0x64, 0x1a, 0x22, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x6f, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x1a, 0x19, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x5b,
+	0x0a, 0x1d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x22, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x13, 0x53,
+	0x61, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x13, 0x53, 0x61, 0x76, 0x65,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x21, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x22, 0x00, 0x12, 0x56, 0x0a,
+	0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79,
+	0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22,
+	0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
 	0x6f, 0x6e, 0x1a, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x15, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x00, 0x12, 0x4d, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x12,
-	0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0x00, 0x12, 0x54, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x64, 0x6c,
-	0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x16, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79,
+	0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x1d, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64,
+	0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x49, 0x64, 0x1a, 0x1c, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x00, 0x12, 0x4f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x76, 0x12, 0x14, 0x2e,
+	0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x49, 0x64, 0x1a, 0x1c, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c,
+	0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49,
+	0x64, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x48, 0x0a,
+	0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1c, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
 	0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x49, 0x64, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x22, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0x00, 0x12, 0x4e, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x12,
+	0x74, 0x6f, 0x2e, 0x49, 0x64, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x64, 0x6c,
+	0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x42, 0x79, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x18, 0x2e, 0x64,
+	0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x42, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d,
+	0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x19, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x42, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x14, 0x2e, 0x64, 0x6c,
+	0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49,
+	0x64, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x00, 0x12, 0x51, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x42, 0x79, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x20, 0x2e, 0x64,
+	0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x00,
+	0x12, 0x3d, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x64, 0x12,
 	0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0x00, 0x12, 0x51, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x42, 0x79, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x2e,
+	0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x16, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x00, 0x12,
+	0x5b, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x79, 0x49, 0x64, 0x12, 0x14, 0x2e,
 	0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x49, 0x64, 0x1a, 0x1d, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a,
-	0x1c, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12,
-	0x4f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x79, 0x49, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x76, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a,
-	0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64,
-	0x1a, 0x1c, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00,
-	0x12, 0x48, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x18,
-	0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x10, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
-	0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x14, 0x2e, 0x64,
+	0x2e, 0x49, 0x64, 0x1a, 0x25, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x21, 0x2e, 0x64,
 	0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x49, 0x64, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x00, 0x12, 0x41, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42,
-	0x79, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x73, 0x42, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x14, 0x2e,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x00, 0x12, 0x55, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64,
+	0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x49, 0x64, 0x1a, 0x22, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x64,
+	0x41, 0x6c, 0x6c, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x1c, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0x19, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x62,
+	0x0a, 0x21, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x42, 0x79, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x1c, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0x1d, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x00, 0x12, 0x5e, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x42, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x50, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x19, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x22, 0x00, 0x12, 0x5a, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x42, 0x79,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x1a, 0x17, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x00, 0x12, 0x5a,
+	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x46, 0x6f,
+	0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x2e,
 	0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x49, 0x64, 0x1a, 0x19, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0x00,
-	0x12, 0x48, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x79, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x18,
-	0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x15, 0x47, 0x65,
-	0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42,
-	0x79, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x20, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
+	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x1b, 0x2e, 0x64, 0x6c,
+	0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d,
+	0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x19, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x6e, 0x6f, 0x6d, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x19, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x6e, 0x6f, 0x6d, 0x73,
+	0x22, 0x00, 0x12, 0x6a, 0x0a, 0x25, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x64, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x2e, 0x64, 0x6c,
+	0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x21, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
 	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a,
-	0x0b, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64,
+	0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x00, 0x12, 0x56,
+	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x42, 0x79, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x64, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x2e,
+	0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x17, 0x2e, 0x64, 0x6c,
+	0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x22, 0x00, 0x12, 0x7c, 0x0a, 0x32, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x42, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x49, 0x64, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x2e, 0x64,
 	0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x49, 0x64, 0x1a, 0x16, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x1a,
-	0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x79, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a,
-	0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64,
-	0x1a, 0x25, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x16, 0x47, 0x65, 0x74,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x79, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x21, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x55,
-	0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x26, 0x2e, 0x64, 0x6c, 0x7a,
+	0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x73, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x21, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x00, 0x12, 0x78, 0x0a, 0x32, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x42, 0x79, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4f, 0x72, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x1c, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
 	0x22, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c,
-	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64,
-	0x12, 0x1c, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x19,
-	0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x21, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x54,
-	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64,
-	0x12, 0x1c, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x1d,
-	0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12,
-	0x5e, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x42, 0x79, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x50, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x1a, 0x19, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0x00, 0x12,
-	0x5a, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x29, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
 	0x65, 0x64, 0x12, 0x1c, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x1a, 0x17, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x1b, 0x47,
-	0x65, 0x74, 0x4d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x2e, 0x64, 0x6c, 0x7a,
-	0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x1b, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x69, 0x6d, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x6e, 0x6f, 0x6d, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x1a, 0x19, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x6e, 0x6f, 0x6d, 0x73, 0x22, 0x00, 0x12,
-	0x6a, 0x0a, 0x25, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x50,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x21, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x1b, 0x47,
-	0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x42, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49,
+	0x1a, 0x23, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x2f, 0x47, 0x65, 0x74, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49,
 	0x64, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x2e, 0x64, 0x6c, 0x7a,
 	0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x17, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x65,
-	0x73, 0x22, 0x00, 0x12, 0x7c, 0x0a, 0x32, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x42, 0x79,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
-	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x26, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x22,
-	0x00, 0x12, 0x55, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x2e,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x21, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x00, 0x12, 0x5d, 0x0a,
+	0x1e, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+	0x1e, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x19, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x50, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x1c,
+	0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1e, 0x2e, 0x68,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x17, 0x2e, 0x68,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x50, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x42, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x1e, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x1a, 0x17, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x50, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x51,
+	0x0a, 0x1b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x42,
+	0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x2e,
 	0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x49, 0x64, 0x1a, 0x21, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x00, 0x12, 0x78, 0x0a, 0x32, 0x47, 0x65, 0x74, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42,
-	0x79, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4f, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1c,
+	0x2e, 0x49, 0x64, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x61, 0x0a, 0x23, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x20,
 	0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x22, 0x2e, 0x64,
-	0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0x00, 0x12, 0x70, 0x0a, 0x29, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x12,
-	0x1c, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x23, 0x2e,
-	0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x2f, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x50, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x21, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x2b, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1b, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x7a,
-	0x65, 0x41, 0x6e, 0x64, 0x49, 0x64, 0x1a, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x22, 0x00, 0x12, 0x45,
-	0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x2e,
-	0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x49, 0x64, 0x1a, 0x1e, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x22, 0x00, 0x12, 0x49, 0x0a,
-	0x0b, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x2e, 0x64,
-	0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0x18, 0x2e, 0x64,
-	0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x46,
-	0x6f, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x1b,
+	0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x19, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x16, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x61, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x27, 0x2e, 0x68, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x74, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x65, 0x73, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x18, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x56, 0x69, 0x65,
+	0x77, 0x73, 0x12, 0x1f, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x56, 0x69,
+	0x65, 0x77, 0x73, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12,
+	0x62, 0x0a, 0x2b, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x6f, 0x72, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1b,
 	0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x41, 0x6e, 0x64, 0x49, 0x64, 0x22, 0x00, 0x12, 0x50, 0x0a,
-	0x19, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74,
-	0x79, 0x46, 0x6f, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a,
+	0x6f, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x41, 0x6e, 0x64, 0x49, 0x64, 0x1a, 0x14, 0x2e, 0x64, 0x6c,
+	0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49,
+	0x64, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x1e, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x2e, 0x64, 0x6c, 0x7a,
+	0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x6c, 0x7a,
 	0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64,
-	0x1a, 0x1b, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x41, 0x6e, 0x64, 0x49, 0x64, 0x22, 0x00, 0x12,
-	0x4b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x6f, 0x72, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x1b, 0x2e,
+	0x22, 0x00, 0x12, 0x49, 0x0a, 0x0b, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1e, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x53, 0x0a,
+	0x1c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x46, 0x6f, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x14, 0x2e,
 	0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x53, 0x69, 0x7a, 0x65, 0x41, 0x6e, 0x64, 0x49, 0x64, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x1f,
-	0x47, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x73, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x2e, 0x49, 0x64, 0x1a, 0x1b, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x41, 0x6e, 0x64, 0x49, 0x64,
+	0x22, 0x00, 0x12, 0x50, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x51,
+	0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x46, 0x6f, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
 	0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x1b, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61,
 	0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x41, 0x6e, 0x64,
-	0x49, 0x64, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x25, 0x47, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x4f, 0x66, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x2e,
-	0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x49, 0x64, 0x1a, 0x1b, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x41, 0x6e, 0x64, 0x49, 0x64,
-	0x22, 0x00, 0x12, 0x5d, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f,
-	0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64,
+	0x49, 0x64, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x46, 0x6f, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64,
 	0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
 	0x49, 0x64, 0x1a, 0x1b, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x41, 0x6e, 0x64, 0x49, 0x64, 0x22,
-	0x00, 0x12, 0x63, 0x0a, 0x28, 0x47, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x41, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53,
-	0x69, 0x7a, 0x65, 0x42, 0x79, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x2e,
-	0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x49, 0x64, 0x1a, 0x1f, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6e, 0x64,
-	0x53, 0x69, 0x7a, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x2a, 0x47, 0x65, 0x74, 0x53, 0x69, 0x7a,
-	0x65, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x6c, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x00, 0x12, 0x56, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
 	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x1f, 0x2e, 0x64, 0x6c, 0x7a,
-	0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x00, 0x12, 0x80, 0x01,
-	0x0a, 0x31, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x41,
-	0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x61, 0x74, 0x68, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x27, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x41, 0x6e, 0x64, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x20, 0x2e, 0x64,
-	0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x00,
-	0x12, 0x57, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x41, 0x6e, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x2e, 0x64, 0x6c,
-	0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x1a, 0x19, 0x2e, 0x64,
-	0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x4e, 0x6f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x20, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x52, 0x61, 0x77, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x42, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x2e,
-	0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x49, 0x64, 0x1a, 0x20, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x00, 0x32, 0x91, 0x0b, 0x0a, 0x18, 0x44, 0x69, 0x73, 0x70,
-	0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c,
-	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x1a, 0x19, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12,
-	0x55, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x19, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x73, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x21, 0x2e,
-	0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
-	0x12, 0x5f, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64,
-	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x21,
-	0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x73, 0x12, 0x4e, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x14, 0x2e, 0x64, 0x6c,
-	0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49,
-	0x64, 0x12, 0x5b, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x1b, 0x2e, 0x64, 0x6c, 0x7a,
+	0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69,
+	0x7a, 0x65, 0x41, 0x6e, 0x64, 0x49, 0x64, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x25, 0x47, 0x65, 0x74,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x46, 0x6f,
+	0x72, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x22, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x60,
-	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x45, 0x78, 0x63, 0x65, 0x70,
-	0x74, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x68, 0x61, 0x6e, 0x12, 0x24,
-	0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x49, 0x64, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x51, 0x4c, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x76, 0x61, 0x6c, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x00,
-	0x12, 0x61, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x21,
-	0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x00, 0x12, 0x8e, 0x01, 0x0a, 0x36, 0x47, 0x65, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74,
-	0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46,
-	0x6f, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14,
-	0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x3c, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64,
-	0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x1d, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x29, 0x47, 0x65, 0x74, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x73, 0x42, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x26, 0x2e, 0x64, 0x6c, 0x7a,
-	0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x73, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22,
-	0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x6a, 0x0a, 0x1e,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x1b, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x7a, 0x65,
+	0x41, 0x6e, 0x64, 0x49, 0x64, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x46, 0x6f, 0x72,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x1b, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x41,
+	0x6e, 0x64, 0x49, 0x64, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x28, 0x47, 0x65, 0x74, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x4f, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x41, 0x6e, 0x64, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x1f, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x2a, 0x47,
+	0x65, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x6c, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a,
+	0x1f, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x00, 0x12, 0x80, 0x01, 0x0a, 0x31, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x41, 0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x50, 0x61, 0x74, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x6c, 0x69, 0x61,
+	0x73, 0x41, 0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4e, 0x61, 0x6d,
+	0x65, 0x1a, 0x20, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x1f, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x6e, 0x64, 0x46, 0x69, 0x6c,
+	0x65, 0x1a, 0x19, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x22, 0x00, 0x12, 0x5c,
+	0x0a, 0x20, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x61, 0x77, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x20, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x1b, 0x2e, 0x68, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x4c, 0x6f, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x1a, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67,
+	0x50, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x14,
 	0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x22, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50,
-	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79,
+	0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x1f, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12,
+	0x1f, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x23,
+	0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x69, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e,
+	0x67, 0x12, 0x23, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x1a, 0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x00, 0x12, 0x5e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
+	0x79, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x68, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
+	0x79, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
+	0x22, 0x00, 0x32, 0xe2, 0x0b, 0x0a, 0x18, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x3f, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x1d, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x48, 0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x73, 0x12, 0x19, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x1a, 0x19, 0x2e,
+	0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x14, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x20, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x1a, 0x19, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x22,
+	0x00, 0x12, 0x58, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
 	0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x21, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x81, 0x01, 0x0a, 0x17,
-	0x63, 0x68, 0x2e, 0x75, 0x6e, 0x69, 0x62, 0x61, 0x73, 0x2e, 0x75, 0x62, 0x2e, 0x68, 0x61, 0x6e,
-	0x64, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x67, 0x42, 0x0c, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x63, 0x66, 0x6c, 0x2d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
-	0x2f, 0x64, 0x6c, 0x7a, 0x61, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2d, 0x68, 0x61,
-	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0xa2, 0x02, 0x03, 0x55, 0x42, 0x42, 0xaa, 0x02, 0x14, 0x55, 0x6e, 0x69, 0x62, 0x61,
-	0x73, 0x2e, 0x55, 0x42, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x47, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x5f, 0x0a, 0x24, 0x47,
+	0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x42, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x76, 0x65, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x21, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x14,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x12, 0x5b, 0x0a, 0x1d,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x42, 0x79, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x2e,
+	0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x49, 0x64, 0x1a, 0x22, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x1c, 0x47, 0x65, 0x74,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x68, 0x61, 0x6e, 0x12, 0x24, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x73,
+	0x57, 0x69, 0x74, 0x68, 0x53, 0x51, 0x4c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x1a,
+	0x18, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x24, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x21, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x8e,
+	0x01, 0x0a, 0x36, 0x47, 0x65, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f,
+	0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a,
+	0x3c, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46,
+	0x6f, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12,
+	0x51, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x42, 0x79, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x2e, 0x64, 0x6c,
+	0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49,
+	0x64, 0x1a, 0x1d, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x00, 0x12, 0x6b, 0x0a, 0x29, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x42, 0x79, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x14, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x49, 0x64, 0x1a, 0x26, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x22, 0x00, 0x12,
+	0x56, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x18, 0x2e,
+	0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x6a, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f,
+	0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x64, 0x6c, 0x7a, 0x61,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x7a,
+	0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x22, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x12, 0x14, 0x2e,
+	0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x49, 0x64, 0x1a, 0x21, 0x2e, 0x64, 0x6c, 0x7a, 0x61, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x42, 0x81, 0x01, 0x0a, 0x17, 0x63, 0x68, 0x2e, 0x75,
+	0x6e, 0x69, 0x62, 0x61, 0x73, 0x2e, 0x75, 0x62, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72,
+	0x2e, 0x70, 0x67, 0x42, 0x0c, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6f, 0x63, 0x66, 0x6c, 0x2d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x2f, 0x64, 0x6c, 0x7a,
+	0x61, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2d, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x72, 0x2f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0xa2, 0x02,
+	0x03, 0x55, 0x42, 0x42, 0xaa, 0x02, 0x14, 0x55, 0x6e, 0x69, 0x62, 0x61, 0x73, 0x2e, 0x55, 0x42,
+	0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x47, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_handler_proto_proto_rawDescOnce sync.Once
+	file_handler_proto_proto_rawDescData = file_handler_proto_proto_rawDesc
+)
+
+func file_handler_proto_proto_rawDescGZIP() []byte {
+	file_handler_proto_proto_rawDescOnce.Do(func() {
+		file_handler_proto_proto_rawDescData = protoimpl.X.CompressGZIP(file_handler_proto_proto_rawDescData)
+	})
+	return file_handler_proto_proto_rawDescData
 }
 
+var file_handler_proto_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_handler_proto_proto_goTypes = []interface{}{
-	(*dlzamanagerproto.ObjectInstance)(nil),                             // 0: dlzamanagerproto.ObjectInstance
-	(*dlzamanagerproto.ObjectInstanceCheck)(nil),                        // 1: dlzamanagerproto.ObjectInstanceCheck
-	(*dlzamanagerproto.Id)(nil),                                         // 2: dlzamanagerproto.Id
-	(*dlzamanagerproto.IdsWithSQLInterval)(nil),                         // 3: dlzamanagerproto.IdsWithSQLInterval
-	(*emptypb.Empty)(nil),                                               // 4: google.protobuf.Empty
-	(*dlzamanagerproto.UploaderAccessObject)(nil),                       // 5: dlzamanagerproto.UploaderAccessObject
-	(*dlzamanagerproto.CollectionAlias)(nil),                            // 6: dlzamanagerproto.CollectionAlias
-	(*dlzamanagerproto.InstanceWithPartitionAndObjectWithFile)(nil),     // 7: dlzamanagerproto.InstanceWithPartitionAndObjectWithFile
-	(*dlzamanagerproto.StoragePartition)(nil),                           // 8: dlzamanagerproto.StoragePartition
-	(*dlzamanagerproto.StatusObject)(nil),                               // 9: dlzamanagerproto.StatusObject
-	(*dlzamanagerproto.SizeObjectLocation)(nil),                         // 10: dlzamanagerproto.SizeObjectLocation
-	(*dlzamanagerproto.NoParam)(nil),                                    // 11: dlzamanagerproto.NoParam
-	(*dlzamanagerproto.ObjectAndFile)(nil),                              // 12: dlzamanagerproto.ObjectAndFile
-	(*dlzamanagerproto.Tenant)(nil),                                     // 13: dlzamanagerproto.Tenant
-	(*dlzamanagerproto.StorageLocation)(nil),                            // 14: dlzamanagerproto.StorageLocation
-	(*dlzamanagerproto.Collection)(nil),                                 // 15: dlzamanagerproto.Collection
-	(*dlzamanagerproto.Pagination)(nil),                                 // 16: dlzamanagerproto.Pagination
-	(*dlzamanagerproto.SizeAndId)(nil),                                  // 17: dlzamanagerproto.SizeAndId
-	(*dlzamanagerproto.AliasAndLocationsName)(nil),                      // 18: dlzamanagerproto.AliasAndLocationsName
-	(*dlzamanagerproto.Object)(nil),                                     // 19: dlzamanagerproto.Object
-	(*dlzamanagerproto.ObjectInstanceChecks)(nil),                       // 20: dlzamanagerproto.ObjectInstanceChecks
-	(*dlzamanagerproto.ObjectInstances)(nil),                            // 21: dlzamanagerproto.ObjectInstances
-	(*proto.DefaultResponse)(nil),                                       // 22: genericproto.DefaultResponse
-	(*dlzamanagerproto.Status)(nil),                                     // 23: dlzamanagerproto.Status
-	(*dlzamanagerproto.StorageLocations)(nil),                           // 24: dlzamanagerproto.StorageLocations
-	(*dlzamanagerproto.Objects)(nil),                                    // 25: dlzamanagerproto.Objects
-	(*dlzamanagerproto.StoragePartitions)(nil),                          // 26: dlzamanagerproto.StoragePartitions
-	(*dlzamanagerproto.Tenants)(nil),                                    // 27: dlzamanagerproto.Tenants
-	(*dlzamanagerproto.Collections)(nil),                                // 28: dlzamanagerproto.Collections
-	(*dlzamanagerproto.File)(nil),                                       // 29: dlzamanagerproto.File
-	(*dlzamanagerproto.Files)(nil),                                      // 30: dlzamanagerproto.Files
-	(*dlzamanagerproto.MimeTypes)(nil),                                  // 31: dlzamanagerproto.MimeTypes
-	(*dlzamanagerproto.Pronoms)(nil),                                    // 32: dlzamanagerproto.Pronoms
-	(*dlzamanagerproto.AmountAndSize)(nil),                              // 33: dlzamanagerproto.AmountAndSize
-	(*dlzamanagerproto.StorageLocationsCombinationsForCollections)(nil), // 34: dlzamanagerproto.StorageLocationsCombinationsForCollections
+	(*CursorPagination)(nil),                                            // 0: handlerproto.CursorPagination
+	(*ObjectsPage)(nil),                                                 // 1: handlerproto.ObjectsPage
+	(*FilesPage)(nil),                                                   // 2: handlerproto.FilesPage
+	(*MaterializedViews)(nil),                                           // 3: handlerproto.MaterializedViews
+	(*MaterializedViewRefresh)(nil),                                     // 4: handlerproto.MaterializedViewRefresh
+	(*MaterializedViewRefreshes)(nil),                                   // 5: handlerproto.MaterializedViewRefreshes
+	(*RepairCandidate)(nil),                                             // 6: handlerproto.RepairCandidate
+	(*AuditLogQuery)(nil),                                               // 7: handlerproto.AuditLogQuery
+	(*AuditEntry)(nil),                                                  // 8: handlerproto.AuditEntry
+	(*AuditLogPage)(nil),                                                // 9: handlerproto.AuditLogPage
+	(*WatchEventsRequest)(nil),                                          // 10: handlerproto.WatchEventsRequest
+	(*LifecycleEvent)(nil),                                              // 11: handlerproto.LifecycleEvent
+	(*PlacementStrategy)(nil),                                           // 12: handlerproto.PlacementStrategy
+	(*PartitionProvisioning)(nil),                                       // 13: handlerproto.PartitionProvisioning
+	(*CapacityForecastRequest)(nil),                                     // 14: handlerproto.CapacityForecastRequest
+	(*CapacityProjection)(nil),                                          // 15: handlerproto.CapacityProjection
+	(*PartitionForecast)(nil),                                           // 16: handlerproto.PartitionForecast
+	(*TenantIngestRate)(nil),                                            // 17: handlerproto.TenantIngestRate
+	(*LocationForecast)(nil),                                            // 18: handlerproto.LocationForecast
+	(*CapacityForecast)(nil),                                            // 19: handlerproto.CapacityForecast
+	(*dlzamanagerproto.Pagination)(nil),                                 // 20: dlzamanagerproto.Pagination
+	(*dlzamanagerproto.Object)(nil),                                     // 21: dlzamanagerproto.Object
+	(*dlzamanagerproto.File)(nil),                                       // 22: dlzamanagerproto.File
+	(*timestamppb.Timestamp)(nil),                                       // 23: google.protobuf.Timestamp
+	(*dlzamanagerproto.ObjectInstance)(nil),                             // 24: dlzamanagerproto.ObjectInstance
+	(*dlzamanagerproto.ObjectInstanceCheck)(nil),                        // 25: dlzamanagerproto.ObjectInstanceCheck
+	(*dlzamanagerproto.Id)(nil),                                         // 26: dlzamanagerproto.Id
+	(*dlzamanagerproto.IdsWithSQLInterval)(nil),                         // 27: dlzamanagerproto.IdsWithSQLInterval
+	(*emptypb.Empty)(nil),                                               // 28: google.protobuf.Empty
+	(*dlzamanagerproto.UploaderAccessObject)(nil),                       // 29: dlzamanagerproto.UploaderAccessObject
+	(*dlzamanagerproto.CollectionAlias)(nil),                            // 30: dlzamanagerproto.CollectionAlias
+	(*dlzamanagerproto.InstanceWithPartitionAndObjectWithFile)(nil),     // 31: dlzamanagerproto.InstanceWithPartitionAndObjectWithFile
+	(*dlzamanagerproto.StoragePartition)(nil),                           // 32: dlzamanagerproto.StoragePartition
+	(*dlzamanagerproto.StatusObject)(nil),                               // 33: dlzamanagerproto.StatusObject
+	(*dlzamanagerproto.SizeObjectLocation)(nil),                         // 34: dlzamanagerproto.SizeObjectLocation
+	(*dlzamanagerproto.NoParam)(nil),                                    // 35: dlzamanagerproto.NoParam
+	(*dlzamanagerproto.ObjectAndFile)(nil),                              // 36: dlzamanagerproto.ObjectAndFile
+	(*dlzamanagerproto.Tenant)(nil),                                     // 37: dlzamanagerproto.Tenant
+	(*dlzamanagerproto.StorageLocation)(nil),                            // 38: dlzamanagerproto.StorageLocation
+	(*dlzamanagerproto.Collection)(nil),                                 // 39: dlzamanagerproto.Collection
+	(*dlzamanagerproto.SizeAndId)(nil),                                  // 40: dlzamanagerproto.SizeAndId
+	(*dlzamanagerproto.AliasAndLocationsName)(nil),                      // 41: dlzamanagerproto.AliasAndLocationsName
+	(*dlzamanagerproto.ObjectInstanceChecks)(nil),                       // 42: dlzamanagerproto.ObjectInstanceChecks
+	(*dlzamanagerproto.ObjectInstances)(nil),                            // 43: dlzamanagerproto.ObjectInstances
+	(*proto.DefaultResponse)(nil),                                       // 44: genericproto.DefaultResponse
+	(*dlzamanagerproto.Status)(nil),                                     // 45: dlzamanagerproto.Status
+	(*dlzamanagerproto.StorageLocations)(nil),                           // 46: dlzamanagerproto.StorageLocations
+	(*dlzamanagerproto.Objects)(nil),                                    // 47: dlzamanagerproto.Objects
+	(*dlzamanagerproto.StoragePartitions)(nil),                          // 48: dlzamanagerproto.StoragePartitions
+	(*dlzamanagerproto.Tenants)(nil),                                    // 49: dlzamanagerproto.Tenants
+	(*dlzamanagerproto.Collections)(nil),                                // 50: dlzamanagerproto.Collections
+	(*dlzamanagerproto.Files)(nil),                                      // 51: dlzamanagerproto.Files
+	(*dlzamanagerproto.MimeTypes)(nil),                                  // 52: dlzamanagerproto.MimeTypes
+	(*dlzamanagerproto.Pronoms)(nil),                                    // 53: dlzamanagerproto.Pronoms
+	(*dlzamanagerproto.AmountAndSize)(nil),                              // 54: dlzamanagerproto.AmountAndSize
+	(*dlzamanagerproto.StorageLocationsCombinationsForCollections)(nil), // 55: dlzamanagerproto.StorageLocationsCombinationsForCollections
 }
 var file_handler_proto_proto_depIdxs = []int32{
-	0,  // 0: handlerproto.CheckerHandlerService.UpdateObjectInstance:input_type -> dlzamanagerproto.ObjectInstance
-	1,  // 1: handlerproto.CheckerHandlerService.CreateObjectInstanceCheck:input_type -> dlzamanagerproto.ObjectInstanceCheck
-	2,  // 2: handlerproto.CheckerHandlerService.GetObjectById:input_type -> dlzamanagerproto.Id
-	2,  // 3: handlerproto.CheckerHandlerService.GetObjectInstanceChecksByObjectInstanceId:input_type -> dlzamanagerproto.Id
-	2,  // 4: handlerproto.CheckerHandlerService.GetObjectsInstancesByObjectId:input_type -> dlzamanagerproto.Id
-	3,  // 5: handlerproto.CheckerHandlerService.GetObjectInstanceExceptListOlderThanWithChecks:input_type -> dlzamanagerproto.IdsWithSQLInterval
-	4,  // 6: handlerproto.StorageHandlerHandlerService.Ping:input_type -> google.protobuf.Empty
-	5,  // 7: handlerproto.StorageHandlerHandlerService.TenantHasAccess:input_type -> dlzamanagerproto.UploaderAccessObject
-	2,  // 8: handlerproto.StorageHandlerHandlerService.FindTenantByCollectionAlias:input_type -> dlzamanagerproto.Id
-	4,  // 9: handlerproto.StorageHandlerHandlerService.GetAllStorageLocations:input_type -> google.protobuf.Empty
-	6,  // 10: handlerproto.StorageHandlerHandlerService.GetStorageLocationsByCollectionAlias:input_type -> dlzamanagerproto.CollectionAlias
-	2,  // 11: handlerproto.StorageHandlerHandlerService.GetStorageLocationsByObjectId:input_type -> dlzamanagerproto.Id
-	7,  // 12: handlerproto.StorageHandlerHandlerService.SaveAllTableObjectsAfterCopyingStream:input_type -> dlzamanagerproto.InstanceWithPartitionAndObjectWithFile
-	2,  // 13: handlerproto.StorageHandlerHandlerService.GetStorageLocationById:input_type -> dlzamanagerproto.Id
-	8,  // 14: handlerproto.StorageHandlerHandlerService.GetAndSaveStoragePartitionWithRelevantAlias:input_type -> dlzamanagerproto.StoragePartition
-	6,  // 15: handlerproto.StorageHandlerHandlerService.GetObjectsByCollectionAlias:input_type -> dlzamanagerproto.CollectionAlias
-	2,  // 16: handlerproto.StorageHandlerHandlerService.GetObjectsInstancesByObjectId:input_type -> dlzamanagerproto.Id
-	0,  // 17: handlerproto.StorageHandlerHandlerService.CreateObjectInstance:input_type -> dlzamanagerproto.ObjectInstance
-	2,  // 18: handlerproto.StorageHandlerHandlerService.GetStoragePartitionsByStorageLocationId:input_type -> dlzamanagerproto.Id
-	2,  // 19: handlerproto.StorageHandlerHandlerService.DeleteObjectInstance:input_type -> dlzamanagerproto.Id
-	9,  // 20: handlerproto.StorageHandlerHandlerService.AlterStatus:input_type -> dlzamanagerproto.StatusObject
-	2,  // 21: handlerproto.StorageHandlerHandlerService.GetObjectById:input_type -> dlzamanagerproto.Id
-	2,  // 22: handlerproto.StorageHandlerHandlerService.GetStorageLocationByObjectInstanceId:input_type -> dlzamanagerproto.Id
-	10, // 23: handlerproto.StorageHandlerHandlerService.GetStoragePartitionForLocation:input_type -> dlzamanagerproto.SizeObjectLocation
-	11, // 24: handlerproto.StorageHandlerHandlerService.FindAllTenants:input_type -> dlzamanagerproto.NoParam
-	12, // 25: handlerproto.StorageHandlerHandlerService.GetObjectInstanceByFileNameAndPartitionId:input_type -> dlzamanagerproto.ObjectAndFile
-	4,  // 26: handlerproto.ClerkHandlerService.Ping:input_type -> google.protobuf.Empty
-	2,  // 27: handlerproto.ClerkHandlerService.FindTenantById:input_type -> dlzamanagerproto.Id
-	2,  // 28: handlerproto.ClerkHandlerService.DeleteTenant:input_type -> dlzamanagerproto.Id
-	13, // 29: handlerproto.ClerkHandlerService.SaveTenant:input_type -> dlzamanagerproto.Tenant
-	13, // 30: handlerproto.ClerkHandlerService.UpdateTenant:input_type -> dlzamanagerproto.Tenant
-	11, // 31: handlerproto.ClerkHandlerService.FindAllTenants:input_type -> dlzamanagerproto.NoParam
-	2,  // 32: handlerproto.ClerkHandlerService.GetStorageLocationsByTenantId:input_type -> dlzamanagerproto.Id
-	14, // 33: handlerproto.ClerkHandlerService.SaveStorageLocation:input_type -> dlzamanagerproto.StorageLocation
-	14, // 34: handlerproto.ClerkHandlerService.UpdateStorageLocation:input_type -> dlzamanagerproto.StorageLocation
-	2,  // 35: handlerproto.ClerkHandlerService.DeleteStorageLocationById:input_type -> dlzamanagerproto.Id
-	8,  // 36: handlerproto.ClerkHandlerService.CreateStoragePartition:input_type -> dlzamanagerproto.StoragePartition
-	8,  // 37: handlerproto.ClerkHandlerService.UpdateStoragePartition:input_type -> dlzamanagerproto.StoragePartition
-	2,  // 38: handlerproto.ClerkHandlerService.DeleteStoragePartitionById:input_type -> dlzamanagerproto.Id
-	2,  // 39: handlerproto.ClerkHandlerService.GetCollectionsByTenantId:input_type -> dlzamanagerproto.Id
-	2,  // 40: handlerproto.ClerkHandlerService.GetCollectionById:input_type -> dlzamanagerproto.Id
-	2,  // 41: handlerproto.ClerkHandlerService.GetCollectionByIdFromMv:input_type -> dlzamanagerproto.Id
-	2,  // 42: handlerproto.ClerkHandlerService.DeleteCollectionById:input_type -> dlzamanagerproto.Id
-	15, // 43: handlerproto.ClerkHandlerService.CreateCollection:input_type -> dlzamanagerproto.Collection
-	15, // 44: handlerproto.ClerkHandlerService.UpdateCollection:input_type -> dlzamanagerproto.Collection
-	2,  // 45: handlerproto.ClerkHandlerService.GetObjectById:input_type -> dlzamanagerproto.Id
-	2,  // 46: handlerproto.ClerkHandlerService.GetObjectsByChecksum:input_type -> dlzamanagerproto.Id
-	2,  // 47: handlerproto.ClerkHandlerService.GetObjectBySignature:input_type -> dlzamanagerproto.Id
-	2,  // 48: handlerproto.ClerkHandlerService.GetObjectInstanceById:input_type -> dlzamanagerproto.Id
-	2,  // 49: handlerproto.ClerkHandlerService.GetFileById:input_type -> dlzamanagerproto.Id
-	2,  // 50: handlerproto.ClerkHandlerService.GetObjectInstanceCheckById:input_type -> dlzamanagerproto.Id
-	2,  // 51: handlerproto.ClerkHandlerService.GetStorageLocationById:input_type -> dlzamanagerproto.Id
-	2,  // 52: handlerproto.ClerkHandlerService.GetStoragePartitionById:input_type -> dlzamanagerproto.Id
-	16, // 53: handlerproto.ClerkHandlerService.FindAllTenantsPaginated:input_type -> dlzamanagerproto.Pagination
-	16, // 54: handlerproto.ClerkHandlerService.GetCollectionsByTenantIdPaginated:input_type -> dlzamanagerproto.Pagination
-	16, // 55: handlerproto.ClerkHandlerService.GetObjectsByCollectionIdPaginated:input_type -> dlzamanagerproto.Pagination
-	16, // 56: handlerproto.ClerkHandlerService.GetFilesByCollectionIdPaginated:input_type -> dlzamanagerproto.Pagination
-	16, // 57: handlerproto.ClerkHandlerService.GetMimeTypesForCollectionId:input_type -> dlzamanagerproto.Pagination
-	16, // 58: handlerproto.ClerkHandlerService.GetPronomsForCollectionId:input_type -> dlzamanagerproto.Pagination
-	16, // 59: handlerproto.ClerkHandlerService.GetObjectInstancesByObjectIdPaginated:input_type -> dlzamanagerproto.Pagination
-	16, // 60: handlerproto.ClerkHandlerService.GetFilesByObjectIdPaginated:input_type -> dlzamanagerproto.Pagination
-	16, // 61: handlerproto.ClerkHandlerService.GetObjectInstanceChecksByObjectInstanceIdPaginated:input_type -> dlzamanagerproto.Pagination
-	2,  // 62: handlerproto.ClerkHandlerService.GetObjectInstancesByName:input_type -> dlzamanagerproto.Id
-	16, // 63: handlerproto.ClerkHandlerService.GetStorageLocationsByTenantOrCollectionIdPaginated:input_type -> dlzamanagerproto.Pagination
-	16, // 64: handlerproto.ClerkHandlerService.GetStoragePartitionsByLocationIdPaginated:input_type -> dlzamanagerproto.Pagination
-	16, // 65: handlerproto.ClerkHandlerService.GetObjectInstancesByStoragePartitionIdPaginated:input_type -> dlzamanagerproto.Pagination
-	17, // 66: handlerproto.ClerkHandlerService.GetStorageLocationsStatusForCollectionAlias:input_type -> dlzamanagerproto.SizeAndId
-	2,  // 67: handlerproto.ClerkHandlerService.CheckStatus:input_type -> dlzamanagerproto.Id
-	9,  // 68: handlerproto.ClerkHandlerService.CreateStatus:input_type -> dlzamanagerproto.StatusObject
-	9,  // 69: handlerproto.ClerkHandlerService.AlterStatus:input_type -> dlzamanagerproto.StatusObject
-	2,  // 70: handlerproto.ClerkHandlerService.GetResultingQualityForObject:input_type -> dlzamanagerproto.Id
-	2,  // 71: handlerproto.ClerkHandlerService.GetNeededQualityForObject:input_type -> dlzamanagerproto.Id
-	2,  // 72: handlerproto.ClerkHandlerService.GetStatusForObjectId:input_type -> dlzamanagerproto.Id
-	2,  // 73: handlerproto.ClerkHandlerService.GetAmountOfErrorsByCollectionId:input_type -> dlzamanagerproto.Id
-	2,  // 74: handlerproto.ClerkHandlerService.GetAmountOfErrorsForStorageLocationId:input_type -> dlzamanagerproto.Id
-	2,  // 75: handlerproto.ClerkHandlerService.GetAmountOfObjectsForStorageLocationId:input_type -> dlzamanagerproto.Id
-	2,  // 76: handlerproto.ClerkHandlerService.GetAmountOfObjectsAndTotalSizeByTenantId:input_type -> dlzamanagerproto.Id
-	2,  // 77: handlerproto.ClerkHandlerService.GetSizeForAllObjectInstancesByCollectionId:input_type -> dlzamanagerproto.Id
-	18, // 78: handlerproto.ClerkHandlerService.GetObjectInstancesBySignatureAndLocationsPathName:input_type -> dlzamanagerproto.AliasAndLocationsName
-	12, // 79: handlerproto.ClerkHandlerService.CreateObjectAndInstance:input_type -> dlzamanagerproto.ObjectAndFile
-	2,  // 80: handlerproto.ClerkHandlerService.CheckRawObjectInstanceByObjectId:input_type -> dlzamanagerproto.Id
-	4,  // 81: handlerproto.DispatcherHandlerService.Ping:input_type -> google.protobuf.Empty
-	11, // 82: handlerproto.DispatcherHandlerService.FindAllTenants:input_type -> dlzamanagerproto.NoParam
-	0,  // 83: handlerproto.DispatcherHandlerService.UpdateObjectInstance:input_type -> dlzamanagerproto.ObjectInstance
-	2,  // 84: handlerproto.DispatcherHandlerService.GetObjectsInstancesByObjectId:input_type -> dlzamanagerproto.Id
-	2,  // 85: handlerproto.DispatcherHandlerService.GetObjectInstancesByObjectIdPositive:input_type -> dlzamanagerproto.Id
-	0,  // 86: handlerproto.DispatcherHandlerService.CreateObjectInstance:input_type -> dlzamanagerproto.ObjectInstance
-	2,  // 87: handlerproto.DispatcherHandlerService.GetStorageLocationsByTenantId:input_type -> dlzamanagerproto.Id
-	3,  // 88: handlerproto.DispatcherHandlerService.GetObjectExceptListOlderThan:input_type -> dlzamanagerproto.IdsWithSQLInterval
-	2,  // 89: handlerproto.DispatcherHandlerService.GetStorageLocationByObjectInstanceId:input_type -> dlzamanagerproto.Id
-	2,  // 90: handlerproto.DispatcherHandlerService.GetExistingStorageLocationsCombinationsForCollectionId:input_type -> dlzamanagerproto.Id
-	2,  // 91: handlerproto.DispatcherHandlerService.GetCollectionsByTenantId:input_type -> dlzamanagerproto.Id
-	2,  // 92: handlerproto.DispatcherHandlerService.GetObjectInstanceChecksByObjectInstanceId:input_type -> dlzamanagerproto.Id
-	8,  // 93: handlerproto.DispatcherHandlerService.UpdateStoragePartition:input_type -> dlzamanagerproto.StoragePartition
-	10, // 94: handlerproto.DispatcherHandlerService.GetStoragePartitionForLocation:input_type -> dlzamanagerproto.SizeObjectLocation
-	2,  // 95: handlerproto.DispatcherHandlerService.GetStorageLocationById:input_type -> dlzamanagerproto.Id
-	11, // 96: handlerproto.CheckerHandlerService.UpdateObjectInstance:output_type -> dlzamanagerproto.NoParam
-	11, // 97: handlerproto.CheckerHandlerService.CreateObjectInstanceCheck:output_type -> dlzamanagerproto.NoParam
-	19, // 98: handlerproto.CheckerHandlerService.GetObjectById:output_type -> dlzamanagerproto.Object
-	20, // 99: handlerproto.CheckerHandlerService.GetObjectInstanceChecksByObjectInstanceId:output_type -> dlzamanagerproto.ObjectInstanceChecks
-	21, // 100: handlerproto.CheckerHandlerService.GetObjectsInstancesByObjectId:output_type -> dlzamanagerproto.ObjectInstances
-	0,  // 101: handlerproto.CheckerHandlerService.GetObjectInstanceExceptListOlderThanWithChecks:output_type -> dlzamanagerproto.ObjectInstance
-	22, // 102: handlerproto.StorageHandlerHandlerService.Ping:output_type -> genericproto.DefaultResponse
-	23, // 103: handlerproto.StorageHandlerHandlerService.TenantHasAccess:output_type -> dlzamanagerproto.Status
-	13, // 104: handlerproto.StorageHandlerHandlerService.FindTenantByCollectionAlias:output_type -> dlzamanagerproto.Tenant
-	24, // 105: handlerproto.StorageHandlerHandlerService.GetAllStorageLocations:output_type -> dlzamanagerproto.StorageLocations
-	24, // 106: handlerproto.StorageHandlerHandlerService.GetStorageLocationsByCollectionAlias:output_type -> dlzamanagerproto.StorageLocations
-	24, // 107: handlerproto.StorageHandlerHandlerService.GetStorageLocationsByObjectId:output_type -> dlzamanagerproto.StorageLocations
-	23, // 108: handlerproto.StorageHandlerHandlerService.SaveAllTableObjectsAfterCopyingStream:output_type -> dlzamanagerproto.Status
-	14, // 109: handlerproto.StorageHandlerHandlerService.GetStorageLocationById:output_type -> dlzamanagerproto.StorageLocation
-	8,  // 110: handlerproto.StorageHandlerHandlerService.GetAndSaveStoragePartitionWithRelevantAlias:output_type -> dlzamanagerproto.StoragePartition
-	25, // 111: handlerproto.StorageHandlerHandlerService.GetObjectsByCollectionAlias:output_type -> dlzamanagerproto.Objects
-	21, // 112: handlerproto.StorageHandlerHandlerService.GetObjectsInstancesByObjectId:output_type -> dlzamanagerproto.ObjectInstances
-	2,  // 113: handlerproto.StorageHandlerHandlerService.CreateObjectInstance:output_type -> dlzamanagerproto.Id
-	26, // 114: handlerproto.StorageHandlerHandlerService.GetStoragePartitionsByStorageLocationId:output_type -> dlzamanagerproto.StoragePartitions
-	23, // 115: handlerproto.StorageHandlerHandlerService.DeleteObjectInstance:output_type -> dlzamanagerproto.Status
-	23, // 116: handlerproto.StorageHandlerHandlerService.AlterStatus:output_type -> dlzamanagerproto.Status
-	19, // 117: handlerproto.StorageHandlerHandlerService.GetObjectById:output_type -> dlzamanagerproto.Object
-	14, // 118: handlerproto.StorageHandlerHandlerService.GetStorageLocationByObjectInstanceId:output_type -> dlzamanagerproto.StorageLocation
-	8,  // 119: handlerproto.StorageHandlerHandlerService.GetStoragePartitionForLocation:output_type -> dlzamanagerproto.StoragePartition
-	27, // 120: handlerproto.StorageHandlerHandlerService.FindAllTenants:output_type -> dlzamanagerproto.Tenants
-	0,  // 121: handlerproto.StorageHandlerHandlerService.GetObjectInstanceByFileNameAndPartitionId:output_type -> dlzamanagerproto.ObjectInstance
-	22, // 122: handlerproto.ClerkHandlerService.Ping:output_type -> genericproto.DefaultResponse
-	13, // 123: handlerproto.ClerkHandlerService.FindTenantById:output_type -> dlzamanagerproto.Tenant
-	23, // 124: handlerproto.ClerkHandlerService.DeleteTenant:output_type -> dlzamanagerproto.Status
-	23, // 125: handlerproto.ClerkHandlerService.SaveTenant:output_type -> dlzamanagerproto.Status
-	23, // 126: handlerproto.ClerkHandlerService.UpdateTenant:output_type -> dlzamanagerproto.Status
-	27, // 127: handlerproto.ClerkHandlerService.FindAllTenants:output_type -> dlzamanagerproto.Tenants
-	24, // 128: handlerproto.ClerkHandlerService.GetStorageLocationsByTenantId:output_type -> dlzamanagerproto.StorageLocations
-	2,  // 129: handlerproto.ClerkHandlerService.SaveStorageLocation:output_type -> dlzamanagerproto.Id
-	23, // 130: handlerproto.ClerkHandlerService.UpdateStorageLocation:output_type -> dlzamanagerproto.Status
-	23, // 131: handlerproto.ClerkHandlerService.DeleteStorageLocationById:output_type -> dlzamanagerproto.Status
-	2,  // 132: handlerproto.ClerkHandlerService.CreateStoragePartition:output_type -> dlzamanagerproto.Id
-	23, // 133: handlerproto.ClerkHandlerService.UpdateStoragePartition:output_type -> dlzamanagerproto.Status
-	23, // 134: handlerproto.ClerkHandlerService.DeleteStoragePartitionById:output_type -> dlzamanagerproto.Status
-	28, // 135: handlerproto.ClerkHandlerService.GetCollectionsByTenantId:output_type -> dlzamanagerproto.Collections
-	15, // 136: handlerproto.ClerkHandlerService.GetCollectionById:output_type -> dlzamanagerproto.Collection
-	15, // 137: handlerproto.ClerkHandlerService.GetCollectionByIdFromMv:output_type -> dlzamanagerproto.Collection
-	23, // 138: handlerproto.ClerkHandlerService.DeleteCollectionById:output_type -> dlzamanagerproto.Status
-	2,  // 139: handlerproto.ClerkHandlerService.CreateCollection:output_type -> dlzamanagerproto.Id
-	23, // 140: handlerproto.ClerkHandlerService.UpdateCollection:output_type -> dlzamanagerproto.Status
-	19, // 141: handlerproto.ClerkHandlerService.GetObjectById:output_type -> dlzamanagerproto.Object
-	25, // 142: handlerproto.ClerkHandlerService.GetObjectsByChecksum:output_type -> dlzamanagerproto.Objects
-	19, // 143: handlerproto.ClerkHandlerService.GetObjectBySignature:output_type -> dlzamanagerproto.Object
-	0,  // 144: handlerproto.ClerkHandlerService.GetObjectInstanceById:output_type -> dlzamanagerproto.ObjectInstance
-	29, // 145: handlerproto.ClerkHandlerService.GetFileById:output_type -> dlzamanagerproto.File
-	1,  // 146: handlerproto.ClerkHandlerService.GetObjectInstanceCheckById:output_type -> dlzamanagerproto.ObjectInstanceCheck
-	14, // 147: handlerproto.ClerkHandlerService.GetStorageLocationById:output_type -> dlzamanagerproto.StorageLocation
-	8,  // 148: handlerproto.ClerkHandlerService.GetStoragePartitionById:output_type -> dlzamanagerproto.StoragePartition
-	27, // 149: handlerproto.ClerkHandlerService.FindAllTenantsPaginated:output_type -> dlzamanagerproto.Tenants
-	28, // 150: handlerproto.ClerkHandlerService.GetCollectionsByTenantIdPaginated:output_type -> dlzamanagerproto.Collections
-	25, // 151: handlerproto.ClerkHandlerService.GetObjectsByCollectionIdPaginated:output_type -> dlzamanagerproto.Objects
-	30, // 152: handlerproto.ClerkHandlerService.GetFilesByCollectionIdPaginated:output_type -> dlzamanagerproto.Files
-	31, // 153: handlerproto.ClerkHandlerService.GetMimeTypesForCollectionId:output_type -> dlzamanagerproto.MimeTypes
-	32, // 154: handlerproto.ClerkHandlerService.GetPronomsForCollectionId:output_type -> dlzamanagerproto.Pronoms
-	21, // 155: handlerproto.ClerkHandlerService.GetObjectInstancesByObjectIdPaginated:output_type -> dlzamanagerproto.ObjectInstances
-	30, // 156: handlerproto.ClerkHandlerService.GetFilesByObjectIdPaginated:output_type -> dlzamanagerproto.Files
-	20, // 157: handlerproto.ClerkHandlerService.GetObjectInstanceChecksByObjectInstanceIdPaginated:output_type -> dlzamanagerproto.ObjectInstanceChecks
-	21, // 158: handlerproto.ClerkHandlerService.GetObjectInstancesByName:output_type -> dlzamanagerproto.ObjectInstances
-	24, // 159: handlerproto.ClerkHandlerService.GetStorageLocationsByTenantOrCollectionIdPaginated:output_type -> dlzamanagerproto.StorageLocations
-	26, // 160: handlerproto.ClerkHandlerService.GetStoragePartitionsByLocationIdPaginated:output_type -> dlzamanagerproto.StoragePartitions
-	21, // 161: handlerproto.ClerkHandlerService.GetObjectInstancesByStoragePartitionIdPaginated:output_type -> dlzamanagerproto.ObjectInstances
-	2,  // 162: handlerproto.ClerkHandlerService.GetStorageLocationsStatusForCollectionAlias:output_type -> dlzamanagerproto.Id
-	9,  // 163: handlerproto.ClerkHandlerService.CheckStatus:output_type -> dlzamanagerproto.StatusObject
-	2,  // 164: handlerproto.ClerkHandlerService.CreateStatus:output_type -> dlzamanagerproto.Id
-	23, // 165: handlerproto.ClerkHandlerService.AlterStatus:output_type -> dlzamanagerproto.Status
-	17, // 166: handlerproto.ClerkHandlerService.GetResultingQualityForObject:output_type -> dlzamanagerproto.SizeAndId
-	17, // 167: handlerproto.ClerkHandlerService.GetNeededQualityForObject:output_type -> dlzamanagerproto.SizeAndId
-	17, // 168: handlerproto.ClerkHandlerService.GetStatusForObjectId:output_type -> dlzamanagerproto.SizeAndId
-	17, // 169: handlerproto.ClerkHandlerService.GetAmountOfErrorsByCollectionId:output_type -> dlzamanagerproto.SizeAndId
-	17, // 170: handlerproto.ClerkHandlerService.GetAmountOfErrorsForStorageLocationId:output_type -> dlzamanagerproto.SizeAndId
-	17, // 171: handlerproto.ClerkHandlerService.GetAmountOfObjectsForStorageLocationId:output_type -> dlzamanagerproto.SizeAndId
-	33, // 172: handlerproto.ClerkHandlerService.GetAmountOfObjectsAndTotalSizeByTenantId:output_type -> dlzamanagerproto.AmountAndSize
-	33, // 173: handlerproto.ClerkHandlerService.GetSizeForAllObjectInstancesByCollectionId:output_type -> dlzamanagerproto.AmountAndSize
-	0,  // 174: handlerproto.ClerkHandlerService.GetObjectInstancesBySignatureAndLocationsPathName:output_type -> dlzamanagerproto.ObjectInstance
-	11, // 175: handlerproto.ClerkHandlerService.CreateObjectAndInstance:output_type -> dlzamanagerproto.NoParam
-	0,  // 176: handlerproto.ClerkHandlerService.CheckRawObjectInstanceByObjectId:output_type -> dlzamanagerproto.ObjectInstance
-	22, // 177: handlerproto.DispatcherHandlerService.Ping:output_type -> genericproto.DefaultResponse
-	27, // 178: handlerproto.DispatcherHandlerService.FindAllTenants:output_type -> dlzamanagerproto.Tenants
-	11, // 179: handlerproto.DispatcherHandlerService.UpdateObjectInstance:output_type -> dlzamanagerproto.NoParam
-	21, // 180: handlerproto.DispatcherHandlerService.GetObjectsInstancesByObjectId:output_type -> dlzamanagerproto.ObjectInstances
-	21, // 181: handlerproto.DispatcherHandlerService.GetObjectInstancesByObjectIdPositive:output_type -> dlzamanagerproto.ObjectInstances
-	2,  // 182: handlerproto.DispatcherHandlerService.CreateObjectInstance:output_type -> dlzamanagerproto.Id
-	24, // 183: handlerproto.DispatcherHandlerService.GetStorageLocationsByTenantId:output_type -> dlzamanagerproto.StorageLocations
-	19, // 184: handlerproto.DispatcherHandlerService.GetObjectExceptListOlderThan:output_type -> dlzamanagerproto.Object
-	14, // 185: handlerproto.DispatcherHandlerService.GetStorageLocationByObjectInstanceId:output_type -> dlzamanagerproto.StorageLocation
-	34, // 186: handlerproto.DispatcherHandlerService.GetExistingStorageLocationsCombinationsForCollectionId:output_type -> dlzamanagerproto.StorageLocationsCombinationsForCollections
-	28, // 187: handlerproto.DispatcherHandlerService.GetCollectionsByTenantId:output_type -> dlzamanagerproto.Collections
-	20, // 188: handlerproto.DispatcherHandlerService.GetObjectInstanceChecksByObjectInstanceId:output_type -> dlzamanagerproto.ObjectInstanceChecks
-	23, // 189: handlerproto.DispatcherHandlerService.UpdateStoragePartition:output_type -> dlzamanagerproto.Status
-	8,  // 190: handlerproto.DispatcherHandlerService.GetStoragePartitionForLocation:output_type -> dlzamanagerproto.StoragePartition
-	14, // 191: handlerproto.DispatcherHandlerService.GetStorageLocationById:output_type -> dlzamanagerproto.StorageLocation
-	96, // [96:192] is the sub-list for method output_type
-	0,  // [0:96] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	20,  // 0: handlerproto.CursorPagination.pagination:type_name -> dlzamanagerproto.Pagination
+	21,  // 1: handlerproto.ObjectsPage.objects:type_name -> dlzamanagerproto.Object
+	22,  // 2: handlerproto.FilesPage.files:type_name -> dlzamanagerproto.File
+	23,  // 3: handlerproto.MaterializedViewRefresh.lastRefresh:type_name -> google.protobuf.Timestamp
+	4,   // 4: handlerproto.MaterializedViewRefreshes.materializedViewRefreshes:type_name -> handlerproto.MaterializedViewRefresh
+	23,  // 5: handlerproto.AuditLogQuery.since:type_name -> google.protobuf.Timestamp
+	23,  // 6: handlerproto.AuditLogQuery.until:type_name -> google.protobuf.Timestamp
+	0,   // 7: handlerproto.AuditLogQuery.pagination:type_name -> handlerproto.CursorPagination
+	23,  // 8: handlerproto.AuditEntry.created:type_name -> google.protobuf.Timestamp
+	8,   // 9: handlerproto.AuditLogPage.auditEntries:type_name -> handlerproto.AuditEntry
+	23,  // 10: handlerproto.LifecycleEvent.created:type_name -> google.protobuf.Timestamp
+	15,  // 11: handlerproto.PartitionForecast.projection:type_name -> handlerproto.CapacityProjection
+	15,  // 12: handlerproto.LocationForecast.projection:type_name -> handlerproto.CapacityProjection
+	16,  // 13: handlerproto.LocationForecast.partitions:type_name -> handlerproto.PartitionForecast
+	17,  // 14: handlerproto.LocationForecast.tenants:type_name -> handlerproto.TenantIngestRate
+	18,  // 15: handlerproto.CapacityForecast.locations:type_name -> handlerproto.LocationForecast
+	23,  // 16: handlerproto.CapacityForecast.generated:type_name -> google.protobuf.Timestamp
+	24,  // 17: handlerproto.CheckerHandlerService.UpdateObjectInstance:input_type -> dlzamanagerproto.ObjectInstance
+	25,  // 18: handlerproto.CheckerHandlerService.CreateObjectInstanceCheck:input_type -> dlzamanagerproto.ObjectInstanceCheck
+	26,  // 19: handlerproto.CheckerHandlerService.GetObjectById:input_type -> dlzamanagerproto.Id
+	26,  // 20: handlerproto.CheckerHandlerService.GetObjectInstanceChecksByObjectInstanceId:input_type -> dlzamanagerproto.Id
+	26,  // 21: handlerproto.CheckerHandlerService.GetObjectsInstancesByObjectId:input_type -> dlzamanagerproto.Id
+	27,  // 22: handlerproto.CheckerHandlerService.GetObjectInstanceExceptListOlderThanWithChecks:input_type -> dlzamanagerproto.IdsWithSQLInterval
+	28,  // 23: handlerproto.CheckerHandlerService.GetRepairCandidates:input_type -> google.protobuf.Empty
+	10,  // 24: handlerproto.CheckerHandlerService.WatchEvents:input_type -> handlerproto.WatchEventsRequest
+	28,  // 25: handlerproto.StorageHandlerHandlerService.Ping:input_type -> google.protobuf.Empty
+	29,  // 26: handlerproto.StorageHandlerHandlerService.TenantHasAccess:input_type -> dlzamanagerproto.UploaderAccessObject
+	26,  // 27: handlerproto.StorageHandlerHandlerService.FindTenantByCollectionAlias:input_type -> dlzamanagerproto.Id
+	28,  // 28: handlerproto.StorageHandlerHandlerService.GetAllStorageLocations:input_type -> google.protobuf.Empty
+	30,  // 29: handlerproto.StorageHandlerHandlerService.GetStorageLocationsByCollectionAlias:input_type -> dlzamanagerproto.CollectionAlias
+	26,  // 30: handlerproto.StorageHandlerHandlerService.GetStorageLocationsByObjectId:input_type -> dlzamanagerproto.Id
+	31,  // 31: handlerproto.StorageHandlerHandlerService.SaveAllTableObjectsAfterCopyingStream:input_type -> dlzamanagerproto.InstanceWithPartitionAndObjectWithFile
+	26,  // 32: handlerproto.StorageHandlerHandlerService.GetStorageLocationById:input_type -> dlzamanagerproto.Id
+	32,  // 33: handlerproto.StorageHandlerHandlerService.GetAndSaveStoragePartitionWithRelevantAlias:input_type -> dlzamanagerproto.StoragePartition
+	30,  // 34: handlerproto.StorageHandlerHandlerService.GetObjectsByCollectionAlias:input_type -> dlzamanagerproto.CollectionAlias
+	26,  // 35: handlerproto.StorageHandlerHandlerService.GetObjectsInstancesByObjectId:input_type -> dlzamanagerproto.Id
+	24,  // 36: handlerproto.StorageHandlerHandlerService.CreateObjectInstance:input_type -> dlzamanagerproto.ObjectInstance
+	26,  // 37: handlerproto.StorageHandlerHandlerService.GetStoragePartitionsByStorageLocationId:input_type -> dlzamanagerproto.Id
+	26,  // 38: handlerproto.StorageHandlerHandlerService.DeleteObjectInstance:input_type -> dlzamanagerproto.Id
+	33,  // 39: handlerproto.StorageHandlerHandlerService.AlterStatus:input_type -> dlzamanagerproto.StatusObject
+	26,  // 40: handlerproto.StorageHandlerHandlerService.GetObjectById:input_type -> dlzamanagerproto.Id
+	26,  // 41: handlerproto.StorageHandlerHandlerService.GetStorageLocationByObjectInstanceId:input_type -> dlzamanagerproto.Id
+	34,  // 42: handlerproto.StorageHandlerHandlerService.GetStoragePartitionForLocation:input_type -> dlzamanagerproto.SizeObjectLocation
+	35,  // 43: handlerproto.StorageHandlerHandlerService.FindAllTenants:input_type -> dlzamanagerproto.NoParam
+	36,  // 44: handlerproto.StorageHandlerHandlerService.GetObjectInstanceByFileNameAndPartitionId:input_type -> dlzamanagerproto.ObjectAndFile
+	28,  // 45: handlerproto.ClerkHandlerService.Ping:input_type -> google.protobuf.Empty
+	26,  // 46: handlerproto.ClerkHandlerService.FindTenantById:input_type -> dlzamanagerproto.Id
+	26,  // 47: handlerproto.ClerkHandlerService.DeleteTenant:input_type -> dlzamanagerproto.Id
+	37,  // 48: handlerproto.ClerkHandlerService.SaveTenant:input_type -> dlzamanagerproto.Tenant
+	37,  // 49: handlerproto.ClerkHandlerService.UpdateTenant:input_type -> dlzamanagerproto.Tenant
+	35,  // 50: handlerproto.ClerkHandlerService.FindAllTenants:input_type -> dlzamanagerproto.NoParam
+	26,  // 51: handlerproto.ClerkHandlerService.GetStorageLocationsByTenantId:input_type -> dlzamanagerproto.Id
+	38,  // 52: handlerproto.ClerkHandlerService.SaveStorageLocation:input_type -> dlzamanagerproto.StorageLocation
+	38,  // 53: handlerproto.ClerkHandlerService.UpdateStorageLocation:input_type -> dlzamanagerproto.StorageLocation
+	26,  // 54: handlerproto.ClerkHandlerService.DeleteStorageLocationById:input_type -> dlzamanagerproto.Id
+	32,  // 55: handlerproto.ClerkHandlerService.CreateStoragePartition:input_type -> dlzamanagerproto.StoragePartition
+	32,  // 56: handlerproto.ClerkHandlerService.UpdateStoragePartition:input_type -> dlzamanagerproto.StoragePartition
+	26,  // 57: handlerproto.ClerkHandlerService.DeleteStoragePartitionById:input_type -> dlzamanagerproto.Id
+	26,  // 58: handlerproto.ClerkHandlerService.GetCollectionsByTenantId:input_type -> dlzamanagerproto.Id
+	26,  // 59: handlerproto.ClerkHandlerService.GetCollectionById:input_type -> dlzamanagerproto.Id
+	26,  // 60: handlerproto.ClerkHandlerService.GetCollectionByIdFromMv:input_type -> dlzamanagerproto.Id
+	26,  // 61: handlerproto.ClerkHandlerService.DeleteCollectionById:input_type -> dlzamanagerproto.Id
+	39,  // 62: handlerproto.ClerkHandlerService.CreateCollection:input_type -> dlzamanagerproto.Collection
+	39,  // 63: handlerproto.ClerkHandlerService.UpdateCollection:input_type -> dlzamanagerproto.Collection
+	26,  // 64: handlerproto.ClerkHandlerService.GetObjectById:input_type -> dlzamanagerproto.Id
+	26,  // 65: handlerproto.ClerkHandlerService.GetObjectsByChecksum:input_type -> dlzamanagerproto.Id
+	26,  // 66: handlerproto.ClerkHandlerService.GetObjectBySignature:input_type -> dlzamanagerproto.Id
+	26,  // 67: handlerproto.ClerkHandlerService.GetObjectInstanceById:input_type -> dlzamanagerproto.Id
+	26,  // 68: handlerproto.ClerkHandlerService.GetFileById:input_type -> dlzamanagerproto.Id
+	26,  // 69: handlerproto.ClerkHandlerService.GetObjectInstanceCheckById:input_type -> dlzamanagerproto.Id
+	26,  // 70: handlerproto.ClerkHandlerService.GetStorageLocationById:input_type -> dlzamanagerproto.Id
+	26,  // 71: handlerproto.ClerkHandlerService.GetStoragePartitionById:input_type -> dlzamanagerproto.Id
+	20,  // 72: handlerproto.ClerkHandlerService.FindAllTenantsPaginated:input_type -> dlzamanagerproto.Pagination
+	20,  // 73: handlerproto.ClerkHandlerService.GetCollectionsByTenantIdPaginated:input_type -> dlzamanagerproto.Pagination
+	20,  // 74: handlerproto.ClerkHandlerService.GetObjectsByCollectionIdPaginated:input_type -> dlzamanagerproto.Pagination
+	20,  // 75: handlerproto.ClerkHandlerService.GetFilesByCollectionIdPaginated:input_type -> dlzamanagerproto.Pagination
+	20,  // 76: handlerproto.ClerkHandlerService.GetMimeTypesForCollectionId:input_type -> dlzamanagerproto.Pagination
+	20,  // 77: handlerproto.ClerkHandlerService.GetPronomsForCollectionId:input_type -> dlzamanagerproto.Pagination
+	20,  // 78: handlerproto.ClerkHandlerService.GetObjectInstancesByObjectIdPaginated:input_type -> dlzamanagerproto.Pagination
+	20,  // 79: handlerproto.ClerkHandlerService.GetFilesByObjectIdPaginated:input_type -> dlzamanagerproto.Pagination
+	20,  // 80: handlerproto.ClerkHandlerService.GetObjectInstanceChecksByObjectInstanceIdPaginated:input_type -> dlzamanagerproto.Pagination
+	26,  // 81: handlerproto.ClerkHandlerService.GetObjectInstancesByName:input_type -> dlzamanagerproto.Id
+	20,  // 82: handlerproto.ClerkHandlerService.GetStorageLocationsByTenantOrCollectionIdPaginated:input_type -> dlzamanagerproto.Pagination
+	20,  // 83: handlerproto.ClerkHandlerService.GetStoragePartitionsByLocationIdPaginated:input_type -> dlzamanagerproto.Pagination
+	20,  // 84: handlerproto.ClerkHandlerService.GetObjectInstancesByStoragePartitionIdPaginated:input_type -> dlzamanagerproto.Pagination
+	0,   // 85: handlerproto.ClerkHandlerService.GetObjectsByCollectionIdCursor:input_type -> handlerproto.CursorPagination
+	0,   // 86: handlerproto.ClerkHandlerService.GetFilesByCollectionIdCursor:input_type -> handlerproto.CursorPagination
+	0,   // 87: handlerproto.ClerkHandlerService.GetFilesByObjectIdCursor:input_type -> handlerproto.CursorPagination
+	26,  // 88: handlerproto.ClerkHandlerService.ExportObjectsByCollectionId:input_type -> dlzamanagerproto.Id
+	26,  // 89: handlerproto.ClerkHandlerService.ExportObjectInstancesByCollectionId:input_type -> dlzamanagerproto.Id
+	26,  // 90: handlerproto.ClerkHandlerService.ExportFilesByCollectionId:input_type -> dlzamanagerproto.Id
+	28,  // 91: handlerproto.ClerkHandlerService.GetMaterializedViewRefreshes:input_type -> google.protobuf.Empty
+	3,   // 92: handlerproto.ClerkHandlerService.RefreshMaterializedViews:input_type -> handlerproto.MaterializedViews
+	40,  // 93: handlerproto.ClerkHandlerService.GetStorageLocationsStatusForCollectionAlias:input_type -> dlzamanagerproto.SizeAndId
+	26,  // 94: handlerproto.ClerkHandlerService.CheckStatus:input_type -> dlzamanagerproto.Id
+	33,  // 95: handlerproto.ClerkHandlerService.CreateStatus:input_type -> dlzamanagerproto.StatusObject
+	33,  // 96: handlerproto.ClerkHandlerService.AlterStatus:input_type -> dlzamanagerproto.StatusObject
+	26,  // 97: handlerproto.ClerkHandlerService.GetResultingQualityForObject:input_type -> dlzamanagerproto.Id
+	26,  // 98: handlerproto.ClerkHandlerService.GetNeededQualityForObject:input_type -> dlzamanagerproto.Id
+	26,  // 99: handlerproto.ClerkHandlerService.GetStatusForObjectId:input_type -> dlzamanagerproto.Id
+	26,  // 100: handlerproto.ClerkHandlerService.GetAmountOfErrorsByCollectionId:input_type -> dlzamanagerproto.Id
+	26,  // 101: handlerproto.ClerkHandlerService.GetAmountOfErrorsForStorageLocationId:input_type -> dlzamanagerproto.Id
+	26,  // 102: handlerproto.ClerkHandlerService.GetAmountOfObjectsForStorageLocationId:input_type -> dlzamanagerproto.Id
+	26,  // 103: handlerproto.ClerkHandlerService.GetAmountOfObjectsAndTotalSizeByTenantId:input_type -> dlzamanagerproto.Id
+	26,  // 104: handlerproto.ClerkHandlerService.GetSizeForAllObjectInstancesByCollectionId:input_type -> dlzamanagerproto.Id
+	41,  // 105: handlerproto.ClerkHandlerService.GetObjectInstancesBySignatureAndLocationsPathName:input_type -> dlzamanagerproto.AliasAndLocationsName
+	36,  // 106: handlerproto.ClerkHandlerService.CreateObjectAndInstance:input_type -> dlzamanagerproto.ObjectAndFile
+	26,  // 107: handlerproto.ClerkHandlerService.CheckRawObjectInstanceByObjectId:input_type -> dlzamanagerproto.Id
+	7,   // 108: handlerproto.ClerkHandlerService.GetAuditLog:input_type -> handlerproto.AuditLogQuery
+	26,  // 109: handlerproto.ClerkHandlerService.GetPlacementStrategy:input_type -> dlzamanagerproto.Id
+	12,  // 110: handlerproto.ClerkHandlerService.SetPlacementStrategy:input_type -> handlerproto.PlacementStrategy
+	26,  // 111: handlerproto.ClerkHandlerService.GetPartitionProvisioning:input_type -> dlzamanagerproto.Id
+	13,  // 112: handlerproto.ClerkHandlerService.SetPartitionProvisioning:input_type -> handlerproto.PartitionProvisioning
+	14,  // 113: handlerproto.ClerkHandlerService.GetCapacityForecast:input_type -> handlerproto.CapacityForecastRequest
+	28,  // 114: handlerproto.DispatcherHandlerService.Ping:input_type -> google.protobuf.Empty
+	35,  // 115: handlerproto.DispatcherHandlerService.FindAllTenants:input_type -> dlzamanagerproto.NoParam
+	24,  // 116: handlerproto.DispatcherHandlerService.UpdateObjectInstance:input_type -> dlzamanagerproto.ObjectInstance
+	26,  // 117: handlerproto.DispatcherHandlerService.GetObjectsInstancesByObjectId:input_type -> dlzamanagerproto.Id
+	26,  // 118: handlerproto.DispatcherHandlerService.GetObjectInstancesByObjectIdPositive:input_type -> dlzamanagerproto.Id
+	24,  // 119: handlerproto.DispatcherHandlerService.CreateObjectInstance:input_type -> dlzamanagerproto.ObjectInstance
+	26,  // 120: handlerproto.DispatcherHandlerService.GetStorageLocationsByTenantId:input_type -> dlzamanagerproto.Id
+	27,  // 121: handlerproto.DispatcherHandlerService.GetObjectExceptListOlderThan:input_type -> dlzamanagerproto.IdsWithSQLInterval
+	26,  // 122: handlerproto.DispatcherHandlerService.GetStorageLocationByObjectInstanceId:input_type -> dlzamanagerproto.Id
+	26,  // 123: handlerproto.DispatcherHandlerService.GetExistingStorageLocationsCombinationsForCollectionId:input_type -> dlzamanagerproto.Id
+	26,  // 124: handlerproto.DispatcherHandlerService.GetCollectionsByTenantId:input_type -> dlzamanagerproto.Id
+	26,  // 125: handlerproto.DispatcherHandlerService.GetObjectInstanceChecksByObjectInstanceId:input_type -> dlzamanagerproto.Id
+	32,  // 126: handlerproto.DispatcherHandlerService.UpdateStoragePartition:input_type -> dlzamanagerproto.StoragePartition
+	34,  // 127: handlerproto.DispatcherHandlerService.GetStoragePartitionForLocation:input_type -> dlzamanagerproto.SizeObjectLocation
+	26,  // 128: handlerproto.DispatcherHandlerService.GetStorageLocationById:input_type -> dlzamanagerproto.Id
+	10,  // 129: handlerproto.DispatcherHandlerService.WatchEvents:input_type -> handlerproto.WatchEventsRequest
+	35,  // 130: handlerproto.CheckerHandlerService.UpdateObjectInstance:output_type -> dlzamanagerproto.NoParam
+	35,  // 131: handlerproto.CheckerHandlerService.CreateObjectInstanceCheck:output_type -> dlzamanagerproto.NoParam
+	21,  // 132: handlerproto.CheckerHandlerService.GetObjectById:output_type -> dlzamanagerproto.Object
+	42,  // 133: handlerproto.CheckerHandlerService.GetObjectInstanceChecksByObjectInstanceId:output_type -> dlzamanagerproto.ObjectInstanceChecks
+	43,  // 134: handlerproto.CheckerHandlerService.GetObjectsInstancesByObjectId:output_type -> dlzamanagerproto.ObjectInstances
+	24,  // 135: handlerproto.CheckerHandlerService.GetObjectInstanceExceptListOlderThanWithChecks:output_type -> dlzamanagerproto.ObjectInstance
+	6,   // 136: handlerproto.CheckerHandlerService.GetRepairCandidates:output_type -> handlerproto.RepairCandidate
+	11,  // 137: handlerproto.CheckerHandlerService.WatchEvents:output_type -> handlerproto.LifecycleEvent
+	44,  // 138: handlerproto.StorageHandlerHandlerService.Ping:output_type -> genericproto.DefaultResponse
+	45,  // 139: handlerproto.StorageHandlerHandlerService.TenantHasAccess:output_type -> dlzamanagerproto.Status
+	37,  // 140: handlerproto.StorageHandlerHandlerService.FindTenantByCollectionAlias:output_type -> dlzamanagerproto.Tenant
+	46,  // 141: handlerproto.StorageHandlerHandlerService.GetAllStorageLocations:output_type -> dlzamanagerproto.StorageLocations
+	46,  // 142: handlerproto.StorageHandlerHandlerService.GetStorageLocationsByCollectionAlias:output_type -> dlzamanagerproto.StorageLocations
+	46,  // 143: handlerproto.StorageHandlerHandlerService.GetStorageLocationsByObjectId:output_type -> dlzamanagerproto.StorageLocations
+	45,  // 144: handlerproto.StorageHandlerHandlerService.SaveAllTableObjectsAfterCopyingStream:output_type -> dlzamanagerproto.Status
+	38,  // 145: handlerproto.StorageHandlerHandlerService.GetStorageLocationById:output_type -> dlzamanagerproto.StorageLocation
+	32,  // 146: handlerproto.StorageHandlerHandlerService.GetAndSaveStoragePartitionWithRelevantAlias:output_type -> dlzamanagerproto.StoragePartition
+	47,  // 147: handlerproto.StorageHandlerHandlerService.GetObjectsByCollectionAlias:output_type -> dlzamanagerproto.Objects
+	43,  // 148: handlerproto.StorageHandlerHandlerService.GetObjectsInstancesByObjectId:output_type -> dlzamanagerproto.ObjectInstances
+	26,  // 149: handlerproto.StorageHandlerHandlerService.CreateObjectInstance:output_type -> dlzamanagerproto.Id
+	48,  // 150: handlerproto.StorageHandlerHandlerService.GetStoragePartitionsByStorageLocationId:output_type -> dlzamanagerproto.StoragePartitions
+	45,  // 151: handlerproto.StorageHandlerHandlerService.DeleteObjectInstance:output_type -> dlzamanagerproto.Status
+	45,  // 152: handlerproto.StorageHandlerHandlerService.AlterStatus:output_type -> dlzamanagerproto.Status
+	21,  // 153: handlerproto.StorageHandlerHandlerService.GetObjectById:output_type -> dlzamanagerproto.Object
+	38,  // 154: handlerproto.StorageHandlerHandlerService.GetStorageLocationByObjectInstanceId:output_type -> dlzamanagerproto.StorageLocation
+	32,  // 155: handlerproto.StorageHandlerHandlerService.GetStoragePartitionForLocation:output_type -> dlzamanagerproto.StoragePartition
+	49,  // 156: handlerproto.StorageHandlerHandlerService.FindAllTenants:output_type -> dlzamanagerproto.Tenants
+	24,  // 157: handlerproto.StorageHandlerHandlerService.GetObjectInstanceByFileNameAndPartitionId:output_type -> dlzamanagerproto.ObjectInstance
+	44,  // 158: handlerproto.ClerkHandlerService.Ping:output_type -> genericproto.DefaultResponse
+	37,  // 159: handlerproto.ClerkHandlerService.FindTenantById:output_type -> dlzamanagerproto.Tenant
+	45,  // 160: handlerproto.ClerkHandlerService.DeleteTenant:output_type -> dlzamanagerproto.Status
+	45,  // 161: handlerproto.ClerkHandlerService.SaveTenant:output_type -> dlzamanagerproto.Status
+	45,  // 162: handlerproto.ClerkHandlerService.UpdateTenant:output_type -> dlzamanagerproto.Status
+	49,  // 163: handlerproto.ClerkHandlerService.FindAllTenants:output_type -> dlzamanagerproto.Tenants
+	46,  // 164: handlerproto.ClerkHandlerService.GetStorageLocationsByTenantId:output_type -> dlzamanagerproto.StorageLocations
+	26,  // 165: handlerproto.ClerkHandlerService.SaveStorageLocation:output_type -> dlzamanagerproto.Id
+	45,  // 166: handlerproto.ClerkHandlerService.UpdateStorageLocation:output_type -> dlzamanagerproto.Status
+	45,  // 167: handlerproto.ClerkHandlerService.DeleteStorageLocationById:output_type -> dlzamanagerproto.Status
+	26,  // 168: handlerproto.ClerkHandlerService.CreateStoragePartition:output_type -> dlzamanagerproto.Id
+	45,  // 169: handlerproto.ClerkHandlerService.UpdateStoragePartition:output_type -> dlzamanagerproto.Status
+	45,  // 170: handlerproto.ClerkHandlerService.DeleteStoragePartitionById:output_type -> dlzamanagerproto.Status
+	50,  // 171: handlerproto.ClerkHandlerService.GetCollectionsByTenantId:output_type -> dlzamanagerproto.Collections
+	39,  // 172: handlerproto.ClerkHandlerService.GetCollectionById:output_type -> dlzamanagerproto.Collection
+	39,  // 173: handlerproto.ClerkHandlerService.GetCollectionByIdFromMv:output_type -> dlzamanagerproto.Collection
+	45,  // 174: handlerproto.ClerkHandlerService.DeleteCollectionById:output_type -> dlzamanagerproto.Status
+	26,  // 175: handlerproto.ClerkHandlerService.CreateCollection:output_type -> dlzamanagerproto.Id
+	45,  // 176: handlerproto.ClerkHandlerService.UpdateCollection:output_type -> dlzamanagerproto.Status
+	21,  // 177: handlerproto.ClerkHandlerService.GetObjectById:output_type -> dlzamanagerproto.Object
+	47,  // 178: handlerproto.ClerkHandlerService.GetObjectsByChecksum:output_type -> dlzamanagerproto.Objects
+	21,  // 179: handlerproto.ClerkHandlerService.GetObjectBySignature:output_type -> dlzamanagerproto.Object
+	24,  // 180: handlerproto.ClerkHandlerService.GetObjectInstanceById:output_type -> dlzamanagerproto.ObjectInstance
+	22,  // 181: handlerproto.ClerkHandlerService.GetFileById:output_type -> dlzamanagerproto.File
+	25,  // 182: handlerproto.ClerkHandlerService.GetObjectInstanceCheckById:output_type -> dlzamanagerproto.ObjectInstanceCheck
+	38,  // 183: handlerproto.ClerkHandlerService.GetStorageLocationById:output_type -> dlzamanagerproto.StorageLocation
+	32,  // 184: handlerproto.ClerkHandlerService.GetStoragePartitionById:output_type -> dlzamanagerproto.StoragePartition
+	49,  // 185: handlerproto.ClerkHandlerService.FindAllTenantsPaginated:output_type -> dlzamanagerproto.Tenants
+	50,  // 186: handlerproto.ClerkHandlerService.GetCollectionsByTenantIdPaginated:output_type -> dlzamanagerproto.Collections
+	47,  // 187: handlerproto.ClerkHandlerService.GetObjectsByCollectionIdPaginated:output_type -> dlzamanagerproto.Objects
+	51,  // 188: handlerproto.ClerkHandlerService.GetFilesByCollectionIdPaginated:output_type -> dlzamanagerproto.Files
+	52,  // 189: handlerproto.ClerkHandlerService.GetMimeTypesForCollectionId:output_type -> dlzamanagerproto.MimeTypes
+	53,  // 190: handlerproto.ClerkHandlerService.GetPronomsForCollectionId:output_type -> dlzamanagerproto.Pronoms
+	43,  // 191: handlerproto.ClerkHandlerService.GetObjectInstancesByObjectIdPaginated:output_type -> dlzamanagerproto.ObjectInstances
+	51,  // 192: handlerproto.ClerkHandlerService.GetFilesByObjectIdPaginated:output_type -> dlzamanagerproto.Files
+	42,  // 193: handlerproto.ClerkHandlerService.GetObjectInstanceChecksByObjectInstanceIdPaginated:output_type -> dlzamanagerproto.ObjectInstanceChecks
+	43,  // 194: handlerproto.ClerkHandlerService.GetObjectInstancesByName:output_type -> dlzamanagerproto.ObjectInstances
+	46,  // 195: handlerproto.ClerkHandlerService.GetStorageLocationsByTenantOrCollectionIdPaginated:output_type -> dlzamanagerproto.StorageLocations
+	48,  // 196: handlerproto.ClerkHandlerService.GetStoragePartitionsByLocationIdPaginated:output_type -> dlzamanagerproto.StoragePartitions
+	43,  // 197: handlerproto.ClerkHandlerService.GetObjectInstancesByStoragePartitionIdPaginated:output_type -> dlzamanagerproto.ObjectInstances
+	1,   // 198: handlerproto.ClerkHandlerService.GetObjectsByCollectionIdCursor:output_type -> handlerproto.ObjectsPage
+	2,   // 199: handlerproto.ClerkHandlerService.GetFilesByCollectionIdCursor:output_type -> handlerproto.FilesPage
+	2,   // 200: handlerproto.ClerkHandlerService.GetFilesByObjectIdCursor:output_type -> handlerproto.FilesPage
+	21,  // 201: handlerproto.ClerkHandlerService.ExportObjectsByCollectionId:output_type -> dlzamanagerproto.Object
+	24,  // 202: handlerproto.ClerkHandlerService.ExportObjectInstancesByCollectionId:output_type -> dlzamanagerproto.ObjectInstance
+	22,  // 203: handlerproto.ClerkHandlerService.ExportFilesByCollectionId:output_type -> dlzamanagerproto.File
+	5,   // 204: handlerproto.ClerkHandlerService.GetMaterializedViewRefreshes:output_type -> handlerproto.MaterializedViewRefreshes
+	45,  // 205: handlerproto.ClerkHandlerService.RefreshMaterializedViews:output_type -> dlzamanagerproto.Status
+	26,  // 206: handlerproto.ClerkHandlerService.GetStorageLocationsStatusForCollectionAlias:output_type -> dlzamanagerproto.Id
+	33,  // 207: handlerproto.ClerkHandlerService.CheckStatus:output_type -> dlzamanagerproto.StatusObject
+	26,  // 208: handlerproto.ClerkHandlerService.CreateStatus:output_type -> dlzamanagerproto.Id
+	45,  // 209: handlerproto.ClerkHandlerService.AlterStatus:output_type -> dlzamanagerproto.Status
+	40,  // 210: handlerproto.ClerkHandlerService.GetResultingQualityForObject:output_type -> dlzamanagerproto.SizeAndId
+	40,  // 211: handlerproto.ClerkHandlerService.GetNeededQualityForObject:output_type -> dlzamanagerproto.SizeAndId
+	40,  // 212: handlerproto.ClerkHandlerService.GetStatusForObjectId:output_type -> dlzamanagerproto.SizeAndId
+	40,  // 213: handlerproto.ClerkHandlerService.GetAmountOfErrorsByCollectionId:output_type -> dlzamanagerproto.SizeAndId
+	40,  // 214: handlerproto.ClerkHandlerService.GetAmountOfErrorsForStorageLocationId:output_type -> dlzamanagerproto.SizeAndId
+	40,  // 215: handlerproto.ClerkHandlerService.GetAmountOfObjectsForStorageLocationId:output_type -> dlzamanagerproto.SizeAndId
+	54,  // 216: handlerproto.ClerkHandlerService.GetAmountOfObjectsAndTotalSizeByTenantId:output_type -> dlzamanagerproto.AmountAndSize
+	54,  // 217: handlerproto.ClerkHandlerService.GetSizeForAllObjectInstancesByCollectionId:output_type -> dlzamanagerproto.AmountAndSize
+	24,  // 218: handlerproto.ClerkHandlerService.GetObjectInstancesBySignatureAndLocationsPathName:output_type -> dlzamanagerproto.ObjectInstance
+	35,  // 219: handlerproto.ClerkHandlerService.CreateObjectAndInstance:output_type -> dlzamanagerproto.NoParam
+	24,  // 220: handlerproto.ClerkHandlerService.CheckRawObjectInstanceByObjectId:output_type -> dlzamanagerproto.ObjectInstance
+	9,   // 221: handlerproto.ClerkHandlerService.GetAuditLog:output_type -> handlerproto.AuditLogPage
+	12,  // 222: handlerproto.ClerkHandlerService.GetPlacementStrategy:output_type -> handlerproto.PlacementStrategy
+	45,  // 223: handlerproto.ClerkHandlerService.SetPlacementStrategy:output_type -> dlzamanagerproto.Status
+	13,  // 224: handlerproto.ClerkHandlerService.GetPartitionProvisioning:output_type -> handlerproto.PartitionProvisioning
+	45,  // 225: handlerproto.ClerkHandlerService.SetPartitionProvisioning:output_type -> dlzamanagerproto.Status
+	19,  // 226: handlerproto.ClerkHandlerService.GetCapacityForecast:output_type -> handlerproto.CapacityForecast
+	44,  // 227: handlerproto.DispatcherHandlerService.Ping:output_type -> genericproto.DefaultResponse
+	49,  // 228: handlerproto.DispatcherHandlerService.FindAllTenants:output_type -> dlzamanagerproto.Tenants
+	35,  // 229: handlerproto.DispatcherHandlerService.UpdateObjectInstance:output_type -> dlzamanagerproto.NoParam
+	43,  // 230: handlerproto.DispatcherHandlerService.GetObjectsInstancesByObjectId:output_type -> dlzamanagerproto.ObjectInstances
+	43,  // 231: handlerproto.DispatcherHandlerService.GetObjectInstancesByObjectIdPositive:output_type -> dlzamanagerproto.ObjectInstances
+	26,  // 232: handlerproto.DispatcherHandlerService.CreateObjectInstance:output_type -> dlzamanagerproto.Id
+	46,  // 233: handlerproto.DispatcherHandlerService.GetStorageLocationsByTenantId:output_type -> dlzamanagerproto.StorageLocations
+	21,  // 234: handlerproto.DispatcherHandlerService.GetObjectExceptListOlderThan:output_type -> dlzamanagerproto.Object
+	38,  // 235: handlerproto.DispatcherHandlerService.GetStorageLocationByObjectInstanceId:output_type -> dlzamanagerproto.StorageLocation
+	55,  // 236: handlerproto.DispatcherHandlerService.GetExistingStorageLocationsCombinationsForCollectionId:output_type -> dlzamanagerproto.StorageLocationsCombinationsForCollections
+	50,  // 237: handlerproto.DispatcherHandlerService.GetCollectionsByTenantId:output_type -> dlzamanagerproto.Collections
+	42,  // 238: handlerproto.DispatcherHandlerService.GetObjectInstanceChecksByObjectInstanceId:output_type -> dlzamanagerproto.ObjectInstanceChecks
+	45,  // 239: handlerproto.DispatcherHandlerService.UpdateStoragePartition:output_type -> dlzamanagerproto.Status
+	32,  // 240: handlerproto.DispatcherHandlerService.GetStoragePartitionForLocation:output_type -> dlzamanagerproto.StoragePartition
+	38,  // 241: handlerproto.DispatcherHandlerService.GetStorageLocationById:output_type -> dlzamanagerproto.StorageLocation
+	11,  // 242: handlerproto.DispatcherHandlerService.WatchEvents:output_type -> handlerproto.LifecycleEvent
+	130, // [130:243] is the sub-list for method output_type
+	17,  // [17:130] is the sub-list for method input_type
+	17,  // [17:17] is the sub-list for extension type_name
+	17,  // [17:17] is the sub-list for extension extendee
+	0,   // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_handler_proto_proto_init() }
@@ -828,18 +2740,261 @@ func file_handler_proto_proto_init() {
 	if File_handler_proto_proto != nil {
 		return
 	}
+	if !protoimpl.UnsafeEnabled {
+		file_handler_proto_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CursorPagination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_handler_proto_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObjectsPage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_handler_proto_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilesPage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_handler_proto_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaterializedViews); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_handler_proto_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaterializedViewRefresh); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_handler_proto_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaterializedViewRefreshes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_handler_proto_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepairCandidate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_handler_proto_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_handler_proto_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_handler_proto_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogPage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_handler_proto_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_handler_proto_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LifecycleEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_handler_proto_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlacementStrategy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_handler_proto_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartitionProvisioning); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_handler_proto_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CapacityForecastRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_handler_proto_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CapacityProjection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_handler_proto_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartitionForecast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_handler_proto_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantIngestRate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_handler_proto_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocationForecast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_handler_proto_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CapacityForecast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_handler_proto_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   0,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   4,
 		},
 		GoTypes:           file_handler_proto_proto_goTypes,
 		DependencyIndexes: file_handler_proto_proto_depIdxs,
+		MessageInfos:      file_handler_proto_proto_msgTypes,
 	}.Build()
 	File_handler_proto_proto = out.File
 	file_handler_proto_proto_rawDesc = nil
@@ -28,6 +28,8 @@ const (
 	CheckerHandlerService_GetObjectInstanceChecksByObjectInstanceId_FullMethodName      = "/handlerproto.CheckerHandlerService/GetObjectInstanceChecksByObjectInstanceId"
 	CheckerHandlerService_GetObjectsInstancesByObjectId_FullMethodName                  = "/handlerproto.CheckerHandlerService/GetObjectsInstancesByObjectId"
 	CheckerHandlerService_GetObjectInstanceExceptListOlderThanWithChecks_FullMethodName = "/handlerproto.CheckerHandlerService/GetObjectInstanceExceptListOlderThanWithChecks"
+	CheckerHandlerService_GetRepairCandidates_FullMethodName                            = "/handlerproto.CheckerHandlerService/GetRepairCandidates"
+	CheckerHandlerService_WatchEvents_FullMethodName                                    = "/handlerproto.CheckerHandlerService/WatchEvents"
 )
 
 // CheckerHandlerServiceClient is the client API for CheckerHandlerService service.
@@ -40,6 +42,8 @@ type CheckerHandlerServiceClient interface {
 	GetObjectInstanceChecksByObjectInstanceId(ctx context.Context, in *dlzamanagerproto.Id, opts ...grpc.CallOption) (*dlzamanagerproto.ObjectInstanceChecks, error)
 	GetObjectsInstancesByObjectId(ctx context.Context, in *dlzamanagerproto.Id, opts ...grpc.CallOption) (*dlzamanagerproto.ObjectInstances, error)
 	GetObjectInstanceExceptListOlderThanWithChecks(ctx context.Context, in *dlzamanagerproto.IdsWithSQLInterval, opts ...grpc.CallOption) (*dlzamanagerproto.ObjectInstance, error)
+	GetRepairCandidates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (CheckerHandlerService_GetRepairCandidatesClient, error)
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (CheckerHandlerService_WatchEventsClient, error)
 }
 
 type checkerHandlerServiceClient struct {
@@ -104,6 +108,70 @@ func (c *checkerHandlerServiceClient) GetObjectInstanceExceptListOlderThanWithCh
 	return out, nil
 }
 
+func (c *checkerHandlerServiceClient) GetRepairCandidates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (CheckerHandlerService_GetRepairCandidatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CheckerHandlerService_ServiceDesc.Streams[0], CheckerHandlerService_GetRepairCandidates_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &checkerHandlerServiceGetRepairCandidatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CheckerHandlerService_GetRepairCandidatesClient interface {
+	Recv() (*RepairCandidate, error)
+	grpc.ClientStream
+}
+
+type checkerHandlerServiceGetRepairCandidatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *checkerHandlerServiceGetRepairCandidatesClient) Recv() (*RepairCandidate, error) {
+	m := new(RepairCandidate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *checkerHandlerServiceClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (CheckerHandlerService_WatchEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CheckerHandlerService_ServiceDesc.Streams[1], CheckerHandlerService_WatchEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &checkerHandlerServiceWatchEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CheckerHandlerService_WatchEventsClient interface {
+	Recv() (*LifecycleEvent, error)
+	grpc.ClientStream
+}
+
+type checkerHandlerServiceWatchEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *checkerHandlerServiceWatchEventsClient) Recv() (*LifecycleEvent, error) {
+	m := new(LifecycleEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // CheckerHandlerServiceServer is the server API for CheckerHandlerService service.
 // All implementations must embed UnimplementedCheckerHandlerServiceServer
 // for forward compatibility
@@ -114,6 +182,8 @@ type CheckerHandlerServiceServer interface {
 	GetObjectInstanceChecksByObjectInstanceId(context.Context, *dlzamanagerproto.Id) (*dlzamanagerproto.ObjectInstanceChecks, error)
 	GetObjectsInstancesByObjectId(context.Context, *dlzamanagerproto.Id) (*dlzamanagerproto.ObjectInstances, error)
 	GetObjectInstanceExceptListOlderThanWithChecks(context.Context, *dlzamanagerproto.IdsWithSQLInterval) (*dlzamanagerproto.ObjectInstance, error)
+	GetRepairCandidates(*emptypb.Empty, CheckerHandlerService_GetRepairCandidatesServer) error
+	WatchEvents(*WatchEventsRequest, CheckerHandlerService_WatchEventsServer) error
 	mustEmbedUnimplementedCheckerHandlerServiceServer()
 }
 
@@ -139,6 +209,12 @@ func (UnimplementedCheckerHandlerServiceServer) GetObjectsInstancesByObjectId(co
 func (UnimplementedCheckerHandlerServiceServer) GetObjectInstanceExceptListOlderThanWithChecks(context.Context, *dlzamanagerproto.IdsWithSQLInterval) (*dlzamanagerproto.ObjectInstance, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetObjectInstanceExceptListOlderThanWithChecks not implemented")
 }
+func (UnimplementedCheckerHandlerServiceServer) GetRepairCandidates(*emptypb.Empty, CheckerHandlerService_GetRepairCandidatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetRepairCandidates not implemented")
+}
+func (UnimplementedCheckerHandlerServiceServer) WatchEvents(*WatchEventsRequest, CheckerHandlerService_WatchEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
 func (UnimplementedCheckerHandlerServiceServer) mustEmbedUnimplementedCheckerHandlerServiceServer() {}
 
 // UnsafeCheckerHandlerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -260,6 +336,48 @@ func _CheckerHandlerService_GetObjectInstanceExceptListOlderThanWithChecks_Handl
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CheckerHandlerService_GetRepairCandidates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CheckerHandlerServiceServer).GetRepairCandidates(m, &checkerHandlerServiceGetRepairCandidatesServer{stream})
+}
+
+type CheckerHandlerService_GetRepairCandidatesServer interface {
+	Send(*RepairCandidate) error
+	grpc.ServerStream
+}
+
+type checkerHandlerServiceGetRepairCandidatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *checkerHandlerServiceGetRepairCandidatesServer) Send(m *RepairCandidate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CheckerHandlerService_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CheckerHandlerServiceServer).WatchEvents(m, &checkerHandlerServiceWatchEventsServer{stream})
+}
+
+type CheckerHandlerService_WatchEventsServer interface {
+	Send(*LifecycleEvent) error
+	grpc.ServerStream
+}
+
+type checkerHandlerServiceWatchEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *checkerHandlerServiceWatchEventsServer) Send(m *LifecycleEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // CheckerHandlerService_ServiceDesc is the grpc.ServiceDesc for CheckerHandlerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -292,7 +410,18 @@ var CheckerHandlerService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _CheckerHandlerService_GetObjectInstanceExceptListOlderThanWithChecks_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetRepairCandidates",
+			Handler:       _CheckerHandlerService_GetRepairCandidates_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _CheckerHandlerService_WatchEvents_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "handler_proto.proto",
 }
 
@@ -1166,6 +1295,14 @@ const (
 	ClerkHandlerService_GetStorageLocationsByTenantOrCollectionIdPaginated_FullMethodName = "/handlerproto.ClerkHandlerService/GetStorageLocationsByTenantOrCollectionIdPaginated"
 	ClerkHandlerService_GetStoragePartitionsByLocationIdPaginated_FullMethodName          = "/handlerproto.ClerkHandlerService/GetStoragePartitionsByLocationIdPaginated"
 	ClerkHandlerService_GetObjectInstancesByStoragePartitionIdPaginated_FullMethodName    = "/handlerproto.ClerkHandlerService/GetObjectInstancesByStoragePartitionIdPaginated"
+	ClerkHandlerService_GetObjectsByCollectionIdCursor_FullMethodName                     = "/handlerproto.ClerkHandlerService/GetObjectsByCollectionIdCursor"
+	ClerkHandlerService_GetFilesByCollectionIdCursor_FullMethodName                       = "/handlerproto.ClerkHandlerService/GetFilesByCollectionIdCursor"
+	ClerkHandlerService_GetFilesByObjectIdCursor_FullMethodName                           = "/handlerproto.ClerkHandlerService/GetFilesByObjectIdCursor"
+	ClerkHandlerService_ExportObjectsByCollectionId_FullMethodName                        = "/handlerproto.ClerkHandlerService/ExportObjectsByCollectionId"
+	ClerkHandlerService_ExportObjectInstancesByCollectionId_FullMethodName                = "/handlerproto.ClerkHandlerService/ExportObjectInstancesByCollectionId"
+	ClerkHandlerService_ExportFilesByCollectionId_FullMethodName                          = "/handlerproto.ClerkHandlerService/ExportFilesByCollectionId"
+	ClerkHandlerService_GetMaterializedViewRefreshes_FullMethodName                       = "/handlerproto.ClerkHandlerService/GetMaterializedViewRefreshes"
+	ClerkHandlerService_RefreshMaterializedViews_FullMethodName                           = "/handlerproto.ClerkHandlerService/RefreshMaterializedViews"
 	ClerkHandlerService_GetStorageLocationsStatusForCollectionAlias_FullMethodName        = "/handlerproto.ClerkHandlerService/GetStorageLocationsStatusForCollectionAlias"
 	ClerkHandlerService_CheckStatus_FullMethodName                                        = "/handlerproto.ClerkHandlerService/CheckStatus"
 	ClerkHandlerService_CreateStatus_FullMethodName                                       = "/handlerproto.ClerkHandlerService/CreateStatus"
@@ -1181,6 +1318,12 @@ const (
 	ClerkHandlerService_GetObjectInstancesBySignatureAndLocationsPathName_FullMethodName  = "/handlerproto.ClerkHandlerService/GetObjectInstancesBySignatureAndLocationsPathName"
 	ClerkHandlerService_CreateObjectAndInstance_FullMethodName                            = "/handlerproto.ClerkHandlerService/CreateObjectAndInstance"
 	ClerkHandlerService_CheckRawObjectInstanceByObjectId_FullMethodName                   = "/handlerproto.ClerkHandlerService/CheckRawObjectInstanceByObjectId"
+	ClerkHandlerService_GetAuditLog_FullMethodName                                        = "/handlerproto.ClerkHandlerService/GetAuditLog"
+	ClerkHandlerService_GetPlacementStrategy_FullMethodName                               = "/handlerproto.ClerkHandlerService/GetPlacementStrategy"
+	ClerkHandlerService_SetPlacementStrategy_FullMethodName                               = "/handlerproto.ClerkHandlerService/SetPlacementStrategy"
+	ClerkHandlerService_GetPartitionProvisioning_FullMethodName                           = "/handlerproto.ClerkHandlerService/GetPartitionProvisioning"
+	ClerkHandlerService_SetPartitionProvisioning_FullMethodName                           = "/handlerproto.ClerkHandlerService/SetPartitionProvisioning"
+	ClerkHandlerService_GetCapacityForecast_FullMethodName                                = "/handlerproto.ClerkHandlerService/GetCapacityForecast"
 )
 
 // ClerkHandlerServiceClient is the client API for ClerkHandlerService service.
@@ -1202,10 +1345,12 @@ type ClerkHandlerServiceClient interface {
 	DeleteStoragePartitionById(ctx context.Context, in *dlzamanagerproto.Id, opts ...grpc.CallOption) (*dlzamanagerproto.Status, error)
 	GetCollectionsByTenantId(ctx context.Context, in *dlzamanagerproto.Id, opts ...grpc.CallOption) (*dlzamanagerproto.Collections, error)
 	GetCollectionById(ctx context.Context, in *dlzamanagerproto.Id, opts ...grpc.CallOption) (*dlzamanagerproto.Collection, error)
+	// materialized view mat_coll_obj_file
 	GetCollectionByIdFromMv(ctx context.Context, in *dlzamanagerproto.Id, opts ...grpc.CallOption) (*dlzamanagerproto.Collection, error)
 	DeleteCollectionById(ctx context.Context, in *dlzamanagerproto.Id, opts ...grpc.CallOption) (*dlzamanagerproto.Status, error)
 	CreateCollection(ctx context.Context, in *dlzamanagerproto.Collection, opts ...grpc.CallOption) (*dlzamanagerproto.Id, error)
 	UpdateCollection(ctx context.Context, in *dlzamanagerproto.Collection, opts ...grpc.CallOption) (*dlzamanagerproto.Status, error)
+	// materialized view mat_coll_obj
 	GetObjectById(ctx context.Context, in *dlzamanagerproto.Id, opts ...grpc.CallOption) (*dlzamanagerproto.Object, error)
 	GetObjectsByChecksum(ctx context.Context, in *dlzamanagerproto.Id, opts ...grpc.CallOption) (*dlzamanagerproto.Objects, error)
 	GetObjectBySignature(ctx context.Context, in *dlzamanagerproto.Id, opts ...grpc.CallOption) (*dlzamanagerproto.Object, error)
@@ -1215,10 +1360,14 @@ type ClerkHandlerServiceClient interface {
 	GetStorageLocationById(ctx context.Context, in *dlzamanagerproto.Id, opts ...grpc.CallOption) (*dlzamanagerproto.StorageLocation, error)
 	GetStoragePartitionById(ctx context.Context, in *dlzamanagerproto.Id, opts ...grpc.CallOption) (*dlzamanagerproto.StoragePartition, error)
 	FindAllTenantsPaginated(ctx context.Context, in *dlzamanagerproto.Pagination, opts ...grpc.CallOption) (*dlzamanagerproto.Tenants, error)
+	// materialized view mat_coll_obj_file
 	GetCollectionsByTenantIdPaginated(ctx context.Context, in *dlzamanagerproto.Pagination, opts ...grpc.CallOption) (*dlzamanagerproto.Collections, error)
+	// materialized view mat_coll_obj
 	GetObjectsByCollectionIdPaginated(ctx context.Context, in *dlzamanagerproto.Pagination, opts ...grpc.CallOption) (*dlzamanagerproto.Objects, error)
 	GetFilesByCollectionIdPaginated(ctx context.Context, in *dlzamanagerproto.Pagination, opts ...grpc.CallOption) (*dlzamanagerproto.Files, error)
+	// materialized view mat_tenant_file_join
 	GetMimeTypesForCollectionId(ctx context.Context, in *dlzamanagerproto.Pagination, opts ...grpc.CallOption) (*dlzamanagerproto.MimeTypes, error)
+	// materialized view mat_tenant_file_join
 	GetPronomsForCollectionId(ctx context.Context, in *dlzamanagerproto.Pagination, opts ...grpc.CallOption) (*dlzamanagerproto.Pronoms, error)
 	GetObjectInstancesByObjectIdPaginated(ctx context.Context, in *dlzamanagerproto.Pagination, opts ...grpc.CallOption) (*dlzamanagerproto.ObjectInstances, error)
 	GetFilesByObjectIdPaginated(ctx context.Context, in *dlzamanagerproto.Pagination, opts ...grpc.CallOption) (*dlzamanagerproto.Files, error)
@@ -1227,6 +1376,15 @@ type ClerkHandlerServiceClient interface {
 	GetStorageLocationsByTenantOrCollectionIdPaginated(ctx context.Context, in *dlzamanagerproto.Pagination, opts ...grpc.CallOption) (*dlzamanagerproto.StorageLocations, error)
 	GetStoragePartitionsByLocationIdPaginated(ctx context.Context, in *dlzamanagerproto.Pagination, opts ...grpc.CallOption) (*dlzamanagerproto.StoragePartitions, error)
 	GetObjectInstancesByStoragePartitionIdPaginated(ctx context.Context, in *dlzamanagerproto.Pagination, opts ...grpc.CallOption) (*dlzamanagerproto.ObjectInstances, error)
+	// materialized view mat_coll_obj
+	GetObjectsByCollectionIdCursor(ctx context.Context, in *CursorPagination, opts ...grpc.CallOption) (*ObjectsPage, error)
+	GetFilesByCollectionIdCursor(ctx context.Context, in *CursorPagination, opts ...grpc.CallOption) (*FilesPage, error)
+	GetFilesByObjectIdCursor(ctx context.Context, in *CursorPagination, opts ...grpc.CallOption) (*FilesPage, error)
+	ExportObjectsByCollectionId(ctx context.Context, in *dlzamanagerproto.Id, opts ...grpc.CallOption) (ClerkHandlerService_ExportObjectsByCollectionIdClient, error)
+	ExportObjectInstancesByCollectionId(ctx context.Context, in *dlzamanagerproto.Id, opts ...grpc.CallOption) (ClerkHandlerService_ExportObjectInstancesByCollectionIdClient, error)
+	ExportFilesByCollectionId(ctx context.Context, in *dlzamanagerproto.Id, opts ...grpc.CallOption) (ClerkHandlerService_ExportFilesByCollectionIdClient, error)
+	GetMaterializedViewRefreshes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MaterializedViewRefreshes, error)
+	RefreshMaterializedViews(ctx context.Context, in *MaterializedViews, opts ...grpc.CallOption) (*dlzamanagerproto.Status, error)
 	GetStorageLocationsStatusForCollectionAlias(ctx context.Context, in *dlzamanagerproto.SizeAndId, opts ...grpc.CallOption) (*dlzamanagerproto.Id, error)
 	CheckStatus(ctx context.Context, in *dlzamanagerproto.Id, opts ...grpc.CallOption) (*dlzamanagerproto.StatusObject, error)
 	CreateStatus(ctx context.Context, in *dlzamanagerproto.StatusObject, opts ...grpc.CallOption) (*dlzamanagerproto.Id, error)
@@ -1242,6 +1400,12 @@ type ClerkHandlerServiceClient interface {
 	GetObjectInstancesBySignatureAndLocationsPathName(ctx context.Context, in *dlzamanagerproto.AliasAndLocationsName, opts ...grpc.CallOption) (*dlzamanagerproto.ObjectInstance, error)
 	CreateObjectAndInstance(ctx context.Context, in *dlzamanagerproto.ObjectAndFile, opts ...grpc.CallOption) (*dlzamanagerproto.NoParam, error)
 	CheckRawObjectInstanceByObjectId(ctx context.Context, in *dlzamanagerproto.Id, opts ...grpc.CallOption) (*dlzamanagerproto.ObjectInstance, error)
+	GetAuditLog(ctx context.Context, in *AuditLogQuery, opts ...grpc.CallOption) (*AuditLogPage, error)
+	GetPlacementStrategy(ctx context.Context, in *dlzamanagerproto.Id, opts ...grpc.CallOption) (*PlacementStrategy, error)
+	SetPlacementStrategy(ctx context.Context, in *PlacementStrategy, opts ...grpc.CallOption) (*dlzamanagerproto.Status, error)
+	GetPartitionProvisioning(ctx context.Context, in *dlzamanagerproto.Id, opts ...grpc.CallOption) (*PartitionProvisioning, error)
+	SetPartitionProvisioning(ctx context.Context, in *PartitionProvisioning, opts ...grpc.CallOption) (*dlzamanagerproto.Status, error)
+	GetCapacityForecast(ctx context.Context, in *CapacityForecastRequest, opts ...grpc.CallOption) (*CapacityForecast, error)
 }
 
 type clerkHandlerServiceClient struct {
@@ -1612,6 +1776,147 @@ func (c *clerkHandlerServiceClient) GetObjectInstancesByStoragePartitionIdPagina
 	return out, nil
 }
 
+func (c *clerkHandlerServiceClient) GetObjectsByCollectionIdCursor(ctx context.Context, in *CursorPagination, opts ...grpc.CallOption) (*ObjectsPage, error) {
+	out := new(ObjectsPage)
+	err := c.cc.Invoke(ctx, ClerkHandlerService_GetObjectsByCollectionIdCursor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clerkHandlerServiceClient) GetFilesByCollectionIdCursor(ctx context.Context, in *CursorPagination, opts ...grpc.CallOption) (*FilesPage, error) {
+	out := new(FilesPage)
+	err := c.cc.Invoke(ctx, ClerkHandlerService_GetFilesByCollectionIdCursor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clerkHandlerServiceClient) GetFilesByObjectIdCursor(ctx context.Context, in *CursorPagination, opts ...grpc.CallOption) (*FilesPage, error) {
+	out := new(FilesPage)
+	err := c.cc.Invoke(ctx, ClerkHandlerService_GetFilesByObjectIdCursor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clerkHandlerServiceClient) ExportObjectsByCollectionId(ctx context.Context, in *dlzamanagerproto.Id, opts ...grpc.CallOption) (ClerkHandlerService_ExportObjectsByCollectionIdClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ClerkHandlerService_ServiceDesc.Streams[0], ClerkHandlerService_ExportObjectsByCollectionId_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &clerkHandlerServiceExportObjectsByCollectionIdClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ClerkHandlerService_ExportObjectsByCollectionIdClient interface {
+	Recv() (*dlzamanagerproto.Object, error)
+	grpc.ClientStream
+}
+
+type clerkHandlerServiceExportObjectsByCollectionIdClient struct {
+	grpc.ClientStream
+}
+
+func (x *clerkHandlerServiceExportObjectsByCollectionIdClient) Recv() (*dlzamanagerproto.Object, error) {
+	m := new(dlzamanagerproto.Object)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *clerkHandlerServiceClient) ExportObjectInstancesByCollectionId(ctx context.Context, in *dlzamanagerproto.Id, opts ...grpc.CallOption) (ClerkHandlerService_ExportObjectInstancesByCollectionIdClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ClerkHandlerService_ServiceDesc.Streams[1], ClerkHandlerService_ExportObjectInstancesByCollectionId_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &clerkHandlerServiceExportObjectInstancesByCollectionIdClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ClerkHandlerService_ExportObjectInstancesByCollectionIdClient interface {
+	Recv() (*dlzamanagerproto.ObjectInstance, error)
+	grpc.ClientStream
+}
+
+type clerkHandlerServiceExportObjectInstancesByCollectionIdClient struct {
+	grpc.ClientStream
+}
+
+func (x *clerkHandlerServiceExportObjectInstancesByCollectionIdClient) Recv() (*dlzamanagerproto.ObjectInstance, error) {
+	m := new(dlzamanagerproto.ObjectInstance)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *clerkHandlerServiceClient) ExportFilesByCollectionId(ctx context.Context, in *dlzamanagerproto.Id, opts ...grpc.CallOption) (ClerkHandlerService_ExportFilesByCollectionIdClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ClerkHandlerService_ServiceDesc.Streams[2], ClerkHandlerService_ExportFilesByCollectionId_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &clerkHandlerServiceExportFilesByCollectionIdClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ClerkHandlerService_ExportFilesByCollectionIdClient interface {
+	Recv() (*dlzamanagerproto.File, error)
+	grpc.ClientStream
+}
+
+type clerkHandlerServiceExportFilesByCollectionIdClient struct {
+	grpc.ClientStream
+}
+
+func (x *clerkHandlerServiceExportFilesByCollectionIdClient) Recv() (*dlzamanagerproto.File, error) {
+	m := new(dlzamanagerproto.File)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *clerkHandlerServiceClient) GetMaterializedViewRefreshes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MaterializedViewRefreshes, error) {
+	out := new(MaterializedViewRefreshes)
+	err := c.cc.Invoke(ctx, ClerkHandlerService_GetMaterializedViewRefreshes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clerkHandlerServiceClient) RefreshMaterializedViews(ctx context.Context, in *MaterializedViews, opts ...grpc.CallOption) (*dlzamanagerproto.Status, error) {
+	out := new(dlzamanagerproto.Status)
+	err := c.cc.Invoke(ctx, ClerkHandlerService_RefreshMaterializedViews_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clerkHandlerServiceClient) GetStorageLocationsStatusForCollectionAlias(ctx context.Context, in *dlzamanagerproto.SizeAndId, opts ...grpc.CallOption) (*dlzamanagerproto.Id, error) {
 	out := new(dlzamanagerproto.Id)
 	err := c.cc.Invoke(ctx, ClerkHandlerService_GetStorageLocationsStatusForCollectionAlias_FullMethodName, in, out, opts...)
@@ -1747,6 +2052,60 @@ func (c *clerkHandlerServiceClient) CheckRawObjectInstanceByObjectId(ctx context
 	return out, nil
 }
 
+func (c *clerkHandlerServiceClient) GetAuditLog(ctx context.Context, in *AuditLogQuery, opts ...grpc.CallOption) (*AuditLogPage, error) {
+	out := new(AuditLogPage)
+	err := c.cc.Invoke(ctx, ClerkHandlerService_GetAuditLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clerkHandlerServiceClient) GetPlacementStrategy(ctx context.Context, in *dlzamanagerproto.Id, opts ...grpc.CallOption) (*PlacementStrategy, error) {
+	out := new(PlacementStrategy)
+	err := c.cc.Invoke(ctx, ClerkHandlerService_GetPlacementStrategy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clerkHandlerServiceClient) SetPlacementStrategy(ctx context.Context, in *PlacementStrategy, opts ...grpc.CallOption) (*dlzamanagerproto.Status, error) {
+	out := new(dlzamanagerproto.Status)
+	err := c.cc.Invoke(ctx, ClerkHandlerService_SetPlacementStrategy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clerkHandlerServiceClient) GetPartitionProvisioning(ctx context.Context, in *dlzamanagerproto.Id, opts ...grpc.CallOption) (*PartitionProvisioning, error) {
+	out := new(PartitionProvisioning)
+	err := c.cc.Invoke(ctx, ClerkHandlerService_GetPartitionProvisioning_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clerkHandlerServiceClient) SetPartitionProvisioning(ctx context.Context, in *PartitionProvisioning, opts ...grpc.CallOption) (*dlzamanagerproto.Status, error) {
+	out := new(dlzamanagerproto.Status)
+	err := c.cc.Invoke(ctx, ClerkHandlerService_SetPartitionProvisioning_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clerkHandlerServiceClient) GetCapacityForecast(ctx context.Context, in *CapacityForecastRequest, opts ...grpc.CallOption) (*CapacityForecast, error) {
+	out := new(CapacityForecast)
+	err := c.cc.Invoke(ctx, ClerkHandlerService_GetCapacityForecast_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClerkHandlerServiceServer is the server API for ClerkHandlerService service.
 // All implementations must embed UnimplementedClerkHandlerServiceServer
 // for forward compatibility
@@ -1766,10 +2125,12 @@ type ClerkHandlerServiceServer interface {
 	DeleteStoragePartitionById(context.Context, *dlzamanagerproto.Id) (*dlzamanagerproto.Status, error)
 	GetCollectionsByTenantId(context.Context, *dlzamanagerproto.Id) (*dlzamanagerproto.Collections, error)
 	GetCollectionById(context.Context, *dlzamanagerproto.Id) (*dlzamanagerproto.Collection, error)
+	// materialized view mat_coll_obj_file
 	GetCollectionByIdFromMv(context.Context, *dlzamanagerproto.Id) (*dlzamanagerproto.Collection, error)
 	DeleteCollectionById(context.Context, *dlzamanagerproto.Id) (*dlzamanagerproto.Status, error)
 	CreateCollection(context.Context, *dlzamanagerproto.Collection) (*dlzamanagerproto.Id, error)
 	UpdateCollection(context.Context, *dlzamanagerproto.Collection) (*dlzamanagerproto.Status, error)
+	// materialized view mat_coll_obj
 	GetObjectById(context.Context, *dlzamanagerproto.Id) (*dlzamanagerproto.Object, error)
 	GetObjectsByChecksum(context.Context, *dlzamanagerproto.Id) (*dlzamanagerproto.Objects, error)
 	GetObjectBySignature(context.Context, *dlzamanagerproto.Id) (*dlzamanagerproto.Object, error)
@@ -1779,10 +2140,14 @@ type ClerkHandlerServiceServer interface {
 	GetStorageLocationById(context.Context, *dlzamanagerproto.Id) (*dlzamanagerproto.StorageLocation, error)
 	GetStoragePartitionById(context.Context, *dlzamanagerproto.Id) (*dlzamanagerproto.StoragePartition, error)
 	FindAllTenantsPaginated(context.Context, *dlzamanagerproto.Pagination) (*dlzamanagerproto.Tenants, error)
+	// materialized view mat_coll_obj_file
 	GetCollectionsByTenantIdPaginated(context.Context, *dlzamanagerproto.Pagination) (*dlzamanagerproto.Collections, error)
+	// materialized view mat_coll_obj
 	GetObjectsByCollectionIdPaginated(context.Context, *dlzamanagerproto.Pagination) (*dlzamanagerproto.Objects, error)
 	GetFilesByCollectionIdPaginated(context.Context, *dlzamanagerproto.Pagination) (*dlzamanagerproto.Files, error)
+	// materialized view mat_tenant_file_join
 	GetMimeTypesForCollectionId(context.Context, *dlzamanagerproto.Pagination) (*dlzamanagerproto.MimeTypes, error)
+	// materialized view mat_tenant_file_join
 	GetPronomsForCollectionId(context.Context, *dlzamanagerproto.Pagination) (*dlzamanagerproto.Pronoms, error)
 	GetObjectInstancesByObjectIdPaginated(context.Context, *dlzamanagerproto.Pagination) (*dlzamanagerproto.ObjectInstances, error)
 	GetFilesByObjectIdPaginated(context.Context, *dlzamanagerproto.Pagination) (*dlzamanagerproto.Files, error)
@@ -1791,6 +2156,15 @@ type ClerkHandlerServiceServer interface {
 	GetStorageLocationsByTenantOrCollectionIdPaginated(context.Context, *dlzamanagerproto.Pagination) (*dlzamanagerproto.StorageLocations, error)
 	GetStoragePartitionsByLocationIdPaginated(context.Context, *dlzamanagerproto.Pagination) (*dlzamanagerproto.StoragePartitions, error)
 	GetObjectInstancesByStoragePartitionIdPaginated(context.Context, *dlzamanagerproto.Pagination) (*dlzamanagerproto.ObjectInstances, error)
+	// materialized view mat_coll_obj
+	GetObjectsByCollectionIdCursor(context.Context, *CursorPagination) (*ObjectsPage, error)
+	GetFilesByCollectionIdCursor(context.Context, *CursorPagination) (*FilesPage, error)
+	GetFilesByObjectIdCursor(context.Context, *CursorPagination) (*FilesPage, error)
+	ExportObjectsByCollectionId(*dlzamanagerproto.Id, ClerkHandlerService_ExportObjectsByCollectionIdServer) error
+	ExportObjectInstancesByCollectionId(*dlzamanagerproto.Id, ClerkHandlerService_ExportObjectInstancesByCollectionIdServer) error
+	ExportFilesByCollectionId(*dlzamanagerproto.Id, ClerkHandlerService_ExportFilesByCollectionIdServer) error
+	GetMaterializedViewRefreshes(context.Context, *emptypb.Empty) (*MaterializedViewRefreshes, error)
+	RefreshMaterializedViews(context.Context, *MaterializedViews) (*dlzamanagerproto.Status, error)
 	GetStorageLocationsStatusForCollectionAlias(context.Context, *dlzamanagerproto.SizeAndId) (*dlzamanagerproto.Id, error)
 	CheckStatus(context.Context, *dlzamanagerproto.Id) (*dlzamanagerproto.StatusObject, error)
 	CreateStatus(context.Context, *dlzamanagerproto.StatusObject) (*dlzamanagerproto.Id, error)
@@ -1806,6 +2180,12 @@ type ClerkHandlerServiceServer interface {
 	GetObjectInstancesBySignatureAndLocationsPathName(context.Context, *dlzamanagerproto.AliasAndLocationsName) (*dlzamanagerproto.ObjectInstance, error)
 	CreateObjectAndInstance(context.Context, *dlzamanagerproto.ObjectAndFile) (*dlzamanagerproto.NoParam, error)
 	CheckRawObjectInstanceByObjectId(context.Context, *dlzamanagerproto.Id) (*dlzamanagerproto.ObjectInstance, error)
+	GetAuditLog(context.Context, *AuditLogQuery) (*AuditLogPage, error)
+	GetPlacementStrategy(context.Context, *dlzamanagerproto.Id) (*PlacementStrategy, error)
+	SetPlacementStrategy(context.Context, *PlacementStrategy) (*dlzamanagerproto.Status, error)
+	GetPartitionProvisioning(context.Context, *dlzamanagerproto.Id) (*PartitionProvisioning, error)
+	SetPartitionProvisioning(context.Context, *PartitionProvisioning) (*dlzamanagerproto.Status, error)
+	GetCapacityForecast(context.Context, *CapacityForecastRequest) (*CapacityForecast, error)
 	mustEmbedUnimplementedClerkHandlerServiceServer()
 }
 
@@ -1933,6 +2313,30 @@ func (UnimplementedClerkHandlerServiceServer) GetStoragePartitionsByLocationIdPa
 func (UnimplementedClerkHandlerServiceServer) GetObjectInstancesByStoragePartitionIdPaginated(context.Context, *dlzamanagerproto.Pagination) (*dlzamanagerproto.ObjectInstances, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetObjectInstancesByStoragePartitionIdPaginated not implemented")
 }
+func (UnimplementedClerkHandlerServiceServer) GetObjectsByCollectionIdCursor(context.Context, *CursorPagination) (*ObjectsPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetObjectsByCollectionIdCursor not implemented")
+}
+func (UnimplementedClerkHandlerServiceServer) GetFilesByCollectionIdCursor(context.Context, *CursorPagination) (*FilesPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFilesByCollectionIdCursor not implemented")
+}
+func (UnimplementedClerkHandlerServiceServer) GetFilesByObjectIdCursor(context.Context, *CursorPagination) (*FilesPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFilesByObjectIdCursor not implemented")
+}
+func (UnimplementedClerkHandlerServiceServer) ExportObjectsByCollectionId(*dlzamanagerproto.Id, ClerkHandlerService_ExportObjectsByCollectionIdServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportObjectsByCollectionId not implemented")
+}
+func (UnimplementedClerkHandlerServiceServer) ExportObjectInstancesByCollectionId(*dlzamanagerproto.Id, ClerkHandlerService_ExportObjectInstancesByCollectionIdServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportObjectInstancesByCollectionId not implemented")
+}
+func (UnimplementedClerkHandlerServiceServer) ExportFilesByCollectionId(*dlzamanagerproto.Id, ClerkHandlerService_ExportFilesByCollectionIdServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportFilesByCollectionId not implemented")
+}
+func (UnimplementedClerkHandlerServiceServer) GetMaterializedViewRefreshes(context.Context, *emptypb.Empty) (*MaterializedViewRefreshes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaterializedViewRefreshes not implemented")
+}
+func (UnimplementedClerkHandlerServiceServer) RefreshMaterializedViews(context.Context, *MaterializedViews) (*dlzamanagerproto.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshMaterializedViews not implemented")
+}
 func (UnimplementedClerkHandlerServiceServer) GetStorageLocationsStatusForCollectionAlias(context.Context, *dlzamanagerproto.SizeAndId) (*dlzamanagerproto.Id, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStorageLocationsStatusForCollectionAlias not implemented")
 }
@@ -1978,6 +2382,24 @@ func (UnimplementedClerkHandlerServiceServer) CreateObjectAndInstance(context.Co
 func (UnimplementedClerkHandlerServiceServer) CheckRawObjectInstanceByObjectId(context.Context, *dlzamanagerproto.Id) (*dlzamanagerproto.ObjectInstance, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckRawObjectInstanceByObjectId not implemented")
 }
+func (UnimplementedClerkHandlerServiceServer) GetAuditLog(context.Context, *AuditLogQuery) (*AuditLogPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
+}
+func (UnimplementedClerkHandlerServiceServer) GetPlacementStrategy(context.Context, *dlzamanagerproto.Id) (*PlacementStrategy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlacementStrategy not implemented")
+}
+func (UnimplementedClerkHandlerServiceServer) SetPlacementStrategy(context.Context, *PlacementStrategy) (*dlzamanagerproto.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPlacementStrategy not implemented")
+}
+func (UnimplementedClerkHandlerServiceServer) GetPartitionProvisioning(context.Context, *dlzamanagerproto.Id) (*PartitionProvisioning, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPartitionProvisioning not implemented")
+}
+func (UnimplementedClerkHandlerServiceServer) SetPartitionProvisioning(context.Context, *PartitionProvisioning) (*dlzamanagerproto.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPartitionProvisioning not implemented")
+}
+func (UnimplementedClerkHandlerServiceServer) GetCapacityForecast(context.Context, *CapacityForecastRequest) (*CapacityForecast, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapacityForecast not implemented")
+}
 func (UnimplementedClerkHandlerServiceServer) mustEmbedUnimplementedClerkHandlerServiceServer() {}
 
 // UnsafeClerkHandlerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -2711,6 +3133,159 @@ func _ClerkHandlerService_GetObjectInstancesByStoragePartitionIdPaginated_Handle
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClerkHandlerService_GetObjectsByCollectionIdCursor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CursorPagination)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClerkHandlerServiceServer).GetObjectsByCollectionIdCursor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClerkHandlerService_GetObjectsByCollectionIdCursor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClerkHandlerServiceServer).GetObjectsByCollectionIdCursor(ctx, req.(*CursorPagination))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClerkHandlerService_GetFilesByCollectionIdCursor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CursorPagination)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClerkHandlerServiceServer).GetFilesByCollectionIdCursor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClerkHandlerService_GetFilesByCollectionIdCursor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClerkHandlerServiceServer).GetFilesByCollectionIdCursor(ctx, req.(*CursorPagination))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClerkHandlerService_GetFilesByObjectIdCursor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CursorPagination)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClerkHandlerServiceServer).GetFilesByObjectIdCursor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClerkHandlerService_GetFilesByObjectIdCursor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClerkHandlerServiceServer).GetFilesByObjectIdCursor(ctx, req.(*CursorPagination))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClerkHandlerService_ExportObjectsByCollectionId_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(dlzamanagerproto.Id)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClerkHandlerServiceServer).ExportObjectsByCollectionId(m, &clerkHandlerServiceExportObjectsByCollectionIdServer{stream})
+}
+
+type ClerkHandlerService_ExportObjectsByCollectionIdServer interface {
+	Send(*dlzamanagerproto.Object) error
+	grpc.ServerStream
+}
+
+type clerkHandlerServiceExportObjectsByCollectionIdServer struct {
+	grpc.ServerStream
+}
+
+func (x *clerkHandlerServiceExportObjectsByCollectionIdServer) Send(m *dlzamanagerproto.Object) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ClerkHandlerService_ExportObjectInstancesByCollectionId_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(dlzamanagerproto.Id)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClerkHandlerServiceServer).ExportObjectInstancesByCollectionId(m, &clerkHandlerServiceExportObjectInstancesByCollectionIdServer{stream})
+}
+
+type ClerkHandlerService_ExportObjectInstancesByCollectionIdServer interface {
+	Send(*dlzamanagerproto.ObjectInstance) error
+	grpc.ServerStream
+}
+
+type clerkHandlerServiceExportObjectInstancesByCollectionIdServer struct {
+	grpc.ServerStream
+}
+
+func (x *clerkHandlerServiceExportObjectInstancesByCollectionIdServer) Send(m *dlzamanagerproto.ObjectInstance) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ClerkHandlerService_ExportFilesByCollectionId_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(dlzamanagerproto.Id)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClerkHandlerServiceServer).ExportFilesByCollectionId(m, &clerkHandlerServiceExportFilesByCollectionIdServer{stream})
+}
+
+type ClerkHandlerService_ExportFilesByCollectionIdServer interface {
+	Send(*dlzamanagerproto.File) error
+	grpc.ServerStream
+}
+
+type clerkHandlerServiceExportFilesByCollectionIdServer struct {
+	grpc.ServerStream
+}
+
+func (x *clerkHandlerServiceExportFilesByCollectionIdServer) Send(m *dlzamanagerproto.File) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ClerkHandlerService_GetMaterializedViewRefreshes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClerkHandlerServiceServer).GetMaterializedViewRefreshes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClerkHandlerService_GetMaterializedViewRefreshes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClerkHandlerServiceServer).GetMaterializedViewRefreshes(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClerkHandlerService_RefreshMaterializedViews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaterializedViews)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClerkHandlerServiceServer).RefreshMaterializedViews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClerkHandlerService_RefreshMaterializedViews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClerkHandlerServiceServer).RefreshMaterializedViews(ctx, req.(*MaterializedViews))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClerkHandlerService_GetStorageLocationsStatusForCollectionAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(dlzamanagerproto.SizeAndId)
 	if err := dec(in); err != nil {
@@ -2981,6 +3556,114 @@ func _ClerkHandlerService_CheckRawObjectInstanceByObjectId_Handler(srv interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClerkHandlerService_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditLogQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClerkHandlerServiceServer).GetAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClerkHandlerService_GetAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClerkHandlerServiceServer).GetAuditLog(ctx, req.(*AuditLogQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClerkHandlerService_GetPlacementStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(dlzamanagerproto.Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClerkHandlerServiceServer).GetPlacementStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClerkHandlerService_GetPlacementStrategy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClerkHandlerServiceServer).GetPlacementStrategy(ctx, req.(*dlzamanagerproto.Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClerkHandlerService_SetPlacementStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlacementStrategy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClerkHandlerServiceServer).SetPlacementStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClerkHandlerService_SetPlacementStrategy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClerkHandlerServiceServer).SetPlacementStrategy(ctx, req.(*PlacementStrategy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClerkHandlerService_GetPartitionProvisioning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(dlzamanagerproto.Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClerkHandlerServiceServer).GetPartitionProvisioning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClerkHandlerService_GetPartitionProvisioning_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClerkHandlerServiceServer).GetPartitionProvisioning(ctx, req.(*dlzamanagerproto.Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClerkHandlerService_SetPartitionProvisioning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PartitionProvisioning)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClerkHandlerServiceServer).SetPartitionProvisioning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClerkHandlerService_SetPartitionProvisioning_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClerkHandlerServiceServer).SetPartitionProvisioning(ctx, req.(*PartitionProvisioning))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClerkHandlerService_GetCapacityForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CapacityForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClerkHandlerServiceServer).GetCapacityForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClerkHandlerService_GetCapacityForecast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClerkHandlerServiceServer).GetCapacityForecast(ctx, req.(*CapacityForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ClerkHandlerService_ServiceDesc is the grpc.ServiceDesc for ClerkHandlerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -3148,6 +3831,26 @@ var ClerkHandlerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetObjectInstancesByStoragePartitionIdPaginated",
 			Handler:    _ClerkHandlerService_GetObjectInstancesByStoragePartitionIdPaginated_Handler,
 		},
+		{
+			MethodName: "GetObjectsByCollectionIdCursor",
+			Handler:    _ClerkHandlerService_GetObjectsByCollectionIdCursor_Handler,
+		},
+		{
+			MethodName: "GetFilesByCollectionIdCursor",
+			Handler:    _ClerkHandlerService_GetFilesByCollectionIdCursor_Handler,
+		},
+		{
+			MethodName: "GetFilesByObjectIdCursor",
+			Handler:    _ClerkHandlerService_GetFilesByObjectIdCursor_Handler,
+		},
+		{
+			MethodName: "GetMaterializedViewRefreshes",
+			Handler:    _ClerkHandlerService_GetMaterializedViewRefreshes_Handler,
+		},
+		{
+			MethodName: "RefreshMaterializedViews",
+			Handler:    _ClerkHandlerService_RefreshMaterializedViews_Handler,
+		},
 		{
 			MethodName: "GetStorageLocationsStatusForCollectionAlias",
 			Handler:    _ClerkHandlerService_GetStorageLocationsStatusForCollectionAlias_Handler,
@@ -3208,8 +3911,48 @@ var ClerkHandlerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckRawObjectInstanceByObjectId",
 			Handler:    _ClerkHandlerService_CheckRawObjectInstanceByObjectId_Handler,
 		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _ClerkHandlerService_GetAuditLog_Handler,
+		},
+		{
+			MethodName: "GetPlacementStrategy",
+			Handler:    _ClerkHandlerService_GetPlacementStrategy_Handler,
+		},
+		{
+			MethodName: "SetPlacementStrategy",
+			Handler:    _ClerkHandlerService_SetPlacementStrategy_Handler,
+		},
+		{
+			MethodName: "GetPartitionProvisioning",
+			Handler:    _ClerkHandlerService_GetPartitionProvisioning_Handler,
+		},
+		{
+			MethodName: "SetPartitionProvisioning",
+			Handler:    _ClerkHandlerService_SetPartitionProvisioning_Handler,
+		},
+		{
+			MethodName: "GetCapacityForecast",
+			Handler:    _ClerkHandlerService_GetCapacityForecast_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportObjectsByCollectionId",
+			Handler:       _ClerkHandlerService_ExportObjectsByCollectionId_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportObjectInstancesByCollectionId",
+			Handler:       _ClerkHandlerService_ExportObjectInstancesByCollectionId_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportFilesByCollectionId",
+			Handler:       _ClerkHandlerService_ExportFilesByCollectionId_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "handler_proto.proto",
 }
 
@@ -3229,6 +3972,7 @@ const (
 	DispatcherHandlerService_UpdateStoragePartition_FullMethodName                                 = "/handlerproto.DispatcherHandlerService/UpdateStoragePartition"
 	DispatcherHandlerService_GetStoragePartitionForLocation_FullMethodName                         = "/handlerproto.DispatcherHandlerService/GetStoragePartitionForLocation"
 	DispatcherHandlerService_GetStorageLocationById_FullMethodName                                 = "/handlerproto.DispatcherHandlerService/GetStorageLocationById"
+	DispatcherHandlerService_WatchEvents_FullMethodName                                            = "/handlerproto.DispatcherHandlerService/WatchEvents"
 )
 
 // DispatcherHandlerServiceClient is the client API for DispatcherHandlerService service.
@@ -3250,6 +3994,7 @@ type DispatcherHandlerServiceClient interface {
 	UpdateStoragePartition(ctx context.Context, in *dlzamanagerproto.StoragePartition, opts ...grpc.CallOption) (*dlzamanagerproto.Status, error)
 	GetStoragePartitionForLocation(ctx context.Context, in *dlzamanagerproto.SizeObjectLocation, opts ...grpc.CallOption) (*dlzamanagerproto.StoragePartition, error)
 	GetStorageLocationById(ctx context.Context, in *dlzamanagerproto.Id, opts ...grpc.CallOption) (*dlzamanagerproto.StorageLocation, error)
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (DispatcherHandlerService_WatchEventsClient, error)
 }
 
 type dispatcherHandlerServiceClient struct {
@@ -3395,6 +4140,38 @@ func (c *dispatcherHandlerServiceClient) GetStorageLocationById(ctx context.Cont
 	return out, nil
 }
 
+func (c *dispatcherHandlerServiceClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (DispatcherHandlerService_WatchEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DispatcherHandlerService_ServiceDesc.Streams[0], DispatcherHandlerService_WatchEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dispatcherHandlerServiceWatchEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DispatcherHandlerService_WatchEventsClient interface {
+	Recv() (*LifecycleEvent, error)
+	grpc.ClientStream
+}
+
+type dispatcherHandlerServiceWatchEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *dispatcherHandlerServiceWatchEventsClient) Recv() (*LifecycleEvent, error) {
+	m := new(LifecycleEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // DispatcherHandlerServiceServer is the server API for DispatcherHandlerService service.
 // All implementations must embed UnimplementedDispatcherHandlerServiceServer
 // for forward compatibility
@@ -3414,6 +4191,7 @@ type DispatcherHandlerServiceServer interface {
 	UpdateStoragePartition(context.Context, *dlzamanagerproto.StoragePartition) (*dlzamanagerproto.Status, error)
 	GetStoragePartitionForLocation(context.Context, *dlzamanagerproto.SizeObjectLocation) (*dlzamanagerproto.StoragePartition, error)
 	GetStorageLocationById(context.Context, *dlzamanagerproto.Id) (*dlzamanagerproto.StorageLocation, error)
+	WatchEvents(*WatchEventsRequest, DispatcherHandlerService_WatchEventsServer) error
 	mustEmbedUnimplementedDispatcherHandlerServiceServer()
 }
 
@@ -3466,6 +4244,9 @@ func (UnimplementedDispatcherHandlerServiceServer) GetStoragePartitionForLocatio
 func (UnimplementedDispatcherHandlerServiceServer) GetStorageLocationById(context.Context, *dlzamanagerproto.Id) (*dlzamanagerproto.StorageLocation, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStorageLocationById not implemented")
 }
+func (UnimplementedDispatcherHandlerServiceServer) WatchEvents(*WatchEventsRequest, DispatcherHandlerService_WatchEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
 func (UnimplementedDispatcherHandlerServiceServer) mustEmbedUnimplementedDispatcherHandlerServiceServer() {
 }
 
@@ -3750,6 +4531,27 @@ func _DispatcherHandlerService_GetStorageLocationById_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DispatcherHandlerService_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DispatcherHandlerServiceServer).WatchEvents(m, &dispatcherHandlerServiceWatchEventsServer{stream})
+}
+
+type DispatcherHandlerService_WatchEventsServer interface {
+	Send(*LifecycleEvent) error
+	grpc.ServerStream
+}
+
+type dispatcherHandlerServiceWatchEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *dispatcherHandlerServiceWatchEventsServer) Send(m *LifecycleEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // DispatcherHandlerService_ServiceDesc is the grpc.ServiceDesc for DispatcherHandlerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -3818,6 +4620,12 @@ var DispatcherHandlerService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _DispatcherHandlerService_GetStorageLocationById_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _DispatcherHandlerService_WatchEvents_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "handler_proto.proto",
 }
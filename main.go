@@ -8,6 +8,7 @@ import (
 	"io"
 	"io/fs"
 	"log"
+	"net/http"
 	"os"
 	"os/signal"
 	"path/filepath"
@@ -16,6 +17,7 @@ import (
 	"syscall"
 	"time"
 
+	"emperror.dev/errors"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/je4/trustutil/v2/pkg/certutil"
@@ -23,33 +25,178 @@ import (
 	"github.com/je4/utils/v2/pkg/zLogger"
 	"github.com/ocfl-archive/dlza-manager-handler/config"
 	pb "github.com/ocfl-archive/dlza-manager-handler/handlerproto"
+	"github.com/ocfl-archive/dlza-manager-handler/migrations"
 	"github.com/ocfl-archive/dlza-manager-handler/repository"
 	"github.com/ocfl-archive/dlza-manager-handler/server"
 	"github.com/ocfl-archive/dlza-manager-handler/service"
+	"github.com/ocfl-archive/dlza-manager-handler/shutdown"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	ublogger "gitlab.switch.ch/ub-unibas/go-ublogger/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
 	"go.ub.unibas.ch/cloud/certloader/v2/pkg/loader"
 	"go.ub.unibas.ch/cloud/miniresolverclient/pkg/miniresolverclient"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 var configfile = flag.String("config", "", "config file in toml format")
+var migrate = flag.String("migrate", "", "apply pending schema migrations (up), revert the latest one (down), adopt a schema created without migrations (baseline) or show the schema version (status) and exit")
 
 type queryTracer struct {
-	log zLogger.ZLogger
+	log    zLogger.ZLogger
+	tracer trace.Tracer
 }
 
-func (tracer *queryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+// TraceQueryStart starts a client span for the query. Queries of prepared statements are
+// executed with the name of the statement as SQL, so the span is named after it.
+func (tracer *queryTracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
 	tracer.log.Debug().Msgf("postgreSQL command start '%s' - %v", data.SQL, data.Args)
+	name, attributes := data.SQL, []attribute.KeyValue{semconv.DBSystemNamePostgreSQL}
+	if strings.ContainsAny(data.SQL, " \t\n") {
+		name, _, _ = strings.Cut(strings.TrimSpace(data.SQL), " ")
+		name = strings.ToUpper(name)
+		attributes = append(attributes, semconv.DBQueryText(data.SQL))
+	} else {
+		attributes = append(attributes, semconv.DBOperationName(data.SQL))
+	}
+	if conn != nil {
+		attributes = append(attributes, semconv.DBNamespace(conn.Config().Database))
+	}
+	ctx, _ = tracer.tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attributes...))
 	return ctx
 }
 
 func (tracer *queryTracer) TraceQueryEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
 	if data.Err != nil {
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
 		tracer.log.Error().Err(data.Err).Msgf("postgreSQL command error")
 		return
 	}
+	span.SetAttributes(attribute.Int64("db.response.returned_rows", data.CommandTag.RowsAffected()))
 	tracer.log.Debug().Msgf("postgreSQL command end: %s (%d)", data.CommandTag.String(), data.CommandTag.RowsAffected())
 }
 
+// setupTracing exports the spans to the OTLP endpoint of the configuration and accepts the
+// W3C trace context of the callers. Without endpoint only the trace context is propagated.
+func setupTracing(ctx context.Context, conf config.TracingConfig) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if conf.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+	options := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(conf.Endpoint)}
+	if conf.Insecure {
+		options = append(options, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, options...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create trace exporter")
+	}
+	serviceName := conf.ServiceName
+	if serviceName == "" {
+		serviceName = "dlza-manager-handler"
+	}
+	sampleRatio := conf.SampleRatio
+	if sampleRatio <= 0 {
+		sampleRatio = 1
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// drainServer stops accepting calls and waits for the calls being handled. The calls still
+// running when ctx is done are aborted and returned in the error.
+func drainServer(ctx context.Context, grpcServer interface {
+	GracefulStop()
+	Stop()
+}, inFlight *server.InFlight) error {
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+	}
+	calls := inFlight.Calls()
+	grpcServer.Stop()
+	<-stopped
+	return errors.Wrapf(ctx.Err(), "aborted %d calls: %s", len(calls), strings.Join(calls, "; "))
+}
+
+// serveMetrics serves the metrics of the registry on addr under /metrics.
+func serveMetrics(addr string, registry *prometheus.Registry, logger zLogger.ZLogger) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{Registry: registry}))
+	metricsServer := &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		logger.Info().Msgf("serving metrics on %s", addr)
+		if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error().Err(err).Msg("metrics listener stopped")
+		}
+	}()
+	return metricsServer
+}
+
+// migrateSchema runs the migration mode or, without one, makes sure the database has the
+// schema version of this build before any statement is prepared on it.
+func migrateSchema(dbConn string, mode string, autoMigrate bool, logger zLogger.ZLogger) error {
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, dbConn)
+	if err != nil {
+		return errors.Wrap(err, "cannot connect to database")
+	}
+	defer conn.Close(ctx)
+	migrator, err := migrations.NewMigrator(conn, migrations.MigrationFS, logger)
+	if err != nil {
+		return err
+	}
+	switch mode {
+	case "":
+		if autoMigrate {
+			return migrator.Up(ctx)
+		}
+		return migrator.Check(ctx)
+	case "up":
+		return migrator.Up(ctx)
+	case "down":
+		return migrator.Down(ctx)
+	case "baseline":
+		return migrator.Baseline(ctx)
+	case "status":
+		applied, err := migrator.Applied(ctx)
+		if err != nil {
+			return err
+		}
+		for _, migration := range applied {
+			fmt.Printf("%d_%s applied %s\n", migration.Version, migration.Name, migration.Applied.Format(time.RFC3339))
+		}
+		fmt.Printf("schema version %d, latest version %d\n", len(applied), migrator.LatestVersion())
+		return nil
+	default:
+		return errors.Errorf("unknown migration mode '%s', expected up, down, baseline or status", mode)
+	}
+}
+
 func main() {
 	flag.Parse()
 
@@ -75,6 +222,20 @@ func main() {
 		ClientTLS: &loader.Config{
 			Type: "DEV",
 		},
+		DrainTimeout: configutil.Duration(30 * time.Second),
+		MaterializedViews: config.MaterializedViewsConfig{
+			MinRefreshInterval: configutil.Duration(30 * time.Second),
+		},
+		Events: config.EventsConfig{
+			PollInterval: configutil.Duration(30 * time.Second),
+			Retention:    configutil.Duration(7 * 24 * time.Hour),
+		},
+		Placement: config.PlacementConfig{
+			ReservationTTL: configutil.Duration(6 * time.Hour),
+		},
+		Forecast: config.ForecastConfig{
+			Window: configutil.Duration(90 * 24 * time.Hour),
+		},
 	}
 	if err := config.LoadHandlerConfig(cfgFS, cfgFile, conf); err != nil {
 		log.Fatalf("cannot load toml from [%v] %s: %v", cfgFS, cfgFile, err)
@@ -109,17 +270,31 @@ func main() {
 	if err != nil {
 		log.Fatalf("cannot create logger: %v", err)
 	}
-	if _logstash != nil {
-		defer _logstash.Close()
-	}
-
-	if _logfile != nil {
-		defer _logfile.Close()
+	closeLoggers := func() {
+		if _logstash != nil {
+			_logstash.Close()
+		}
+		if _logfile != nil {
+			_logfile.Close()
+		}
 	}
 
 	l2 := _logger.With().Timestamp().Str("host", hostname).Logger() //.Output(output)
 	var logger zLogger.ZLogger = &l2
 
+	if err := migrateSchema(string(conf.DBConn), *migrate, conf.AutoMigrate, logger); err != nil {
+		logger.Fatal().Err(err).Msg("cannot migrate database schema")
+	}
+	if *migrate != "" {
+		closeLoggers()
+		return
+	}
+
+	shutdownTracing, err := setupTracing(context.Background(), conf.Tracing)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("cannot set up tracing")
+	}
+
 	pgxConf, err := pgxpool.ParseConfig(string(conf.DBConn))
 	if err != nil {
 		logger.Fatal().Err(err).Msg("cannot parse db connection string")
@@ -129,7 +304,7 @@ func main() {
 		return service.AfterConnectFunc(ctx, conn, logger)
 	}
 	pgxConf.BeforeConnect = func(ctx context.Context, cfg *pgx.ConnConfig) error {
-		cfg.Tracer = &queryTracer{log: logger}
+		cfg.Tracer = &queryTracer{log: logger, tracer: otel.Tracer("github.com/ocfl-archive/dlza-manager-handler/repository")}
 		return nil
 	}
 	var conn *pgxpool.Pool
@@ -144,7 +319,9 @@ func main() {
 	if err != nil {
 		logger.Fatal().Err(err).Msgf("cannot connect to database: %s", conf.DBConn)
 	}
-	defer conn.Close()
+	if err := service.VerifyPreparedStatements(context.Background(), conn); err != nil {
+		logger.Fatal().Err(err).Msg("database schema check failed")
+	}
 
 	// create TLS Certificate.
 	// the certificate MUST contain <package>.<service> as DNS name
@@ -177,7 +354,6 @@ func main() {
 	if err != nil {
 		logger.Fatal().Msgf("cannot create resolver client: %v", err)
 	}
-	defer resolverClient.Close()
 
 	grpcServer, err := resolverClient.NewServerAddresses(conf.LocalAddr, conf.Addresses, conf.Domains, true)
 	if err != nil {
@@ -195,30 +371,85 @@ func main() {
 	objectInstanceCheckRepository := repository.NewObjectInstanceCheckRepository(conn)
 	storageLocationRepository := repository.NewStorageLocationRepository(conn)
 	storagePartitionRepository := repository.NewStoragePartitionRepository(conn)
-	//checkerRepository := repository.NewCheckerRepository(conn)
+	checkerRepository := repository.NewCheckerRepository(conn)
 	dispatcherRepository := repository.NewDispatcherRepository(conn)
 	statusRepository := repository.NewStatusRepository(conn)
 	refreshMaterializedViewRepository := repository.NewRefreshMaterializedViewsRepository(conn)
 	transactionRepository := repository.NewTransactionRepository(conn, objectRepository, objectInstanceRepository)
 
+	materializedViewRefresher := service.NewMaterializedViewRefresher(refreshMaterializedViewRepository, time.Duration(conf.MaterializedViews.MinRefreshInterval), logger)
+	refresherCtx, stopRefresher := context.WithCancel(context.Background())
+	go materializedViewRefresher.Run(refresherCtx)
+
+	pollInterval := time.Duration(conf.Events.PollInterval)
+	if pollInterval <= 0 {
+		pollInterval = 30 * time.Second
+	}
+	eventPublisher := service.NewEventPublisher(repository.NewOutboxRepository(conn), pollInterval, time.Duration(conf.Events.Retention), logger)
+	publisherCtx, stopPublisher := context.WithCancel(context.Background())
+	go eventPublisher.Run(publisherCtx)
+
 	objectInstanceService := service.NewObjectInstanceService(objectInstanceRepository)
 	tenantService := service.NewTenantService(tenantRepository)
 
-	storagePartitionService := service.StoragePartitionService{StoragePartitionRepository: storagePartitionRepository, ObjectRepository: objectRepository, StorageLocationRepository: storageLocationRepository}
+	reservationRepository := repository.NewReservationRepository(conn)
+	placementRepository := repository.NewPlacementRepository(conn)
+	storagePartitionService := service.StoragePartitionService{StoragePartitionRepository: storagePartitionRepository, ObjectRepository: objectRepository, StorageLocationRepository: storageLocationRepository,
+		ReservationRepository: reservationRepository, ReservationTTL: time.Duration(conf.Placement.ReservationTTL),
+		PlacementRepository: placementRepository, PlacementStrategies: service.NewPlacementStrategies()}
+
+	statisticsRepository := repository.NewStatisticsRepository(conn)
+	capacityForecastService := service.NewCapacityForecastService(statisticsRepository, placementRepository,
+		time.Duration(conf.Forecast.Window))
 
 	uploadService := service.NewUploaderService(tenantRepository, collectionRepository)
 	storageLocationService := service.NewStorageLocationService(collectionRepository, storageLocationRepository, storagePartitionService)
-	pb.RegisterDispatcherHandlerServiceServer(grpcServer, server.NewDispatcherHandlerServer(storagePartitionService, dispatcherRepository, tenantService, objectInstanceRepository, objectRepository, collectionRepository, storageLocationRepository, objectInstanceCheckRepository, logger))
-	pb.RegisterStorageHandlerHandlerServiceServer(grpcServer, &server.StorageHandlerHandlerServer{CollectionRepository: collectionRepository,
+	inFlight := server.NewInFlight()
+	registrar := server.NewInterceptingRegistrar(grpcServer).
+		Use(inFlight.Interceptors()).
+		Use(server.TracingInterceptors())
+	statisticsCtx, stopStatistics := context.WithCancel(context.Background())
+	var metricsServer *http.Server
+	if conf.Metrics.Addr != "" {
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(collectors.NewGoCollector(), collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+			service.NewPoolCollector(conn))
+		statisticsInterval := time.Duration(conf.Metrics.StatisticsInterval)
+		if statisticsInterval <= 0 {
+			statisticsInterval = 5 * time.Minute
+		}
+		archiveStatistics := service.NewArchiveStatistics(statisticsRepository, storageLocationRepository, statisticsInterval, logger)
+		registry.MustRegister(archiveStatistics)
+		go archiveStatistics.Run(statisticsCtx)
+		registrar.Use(server.NewRPCMetrics(registry).Interceptors())
+		metricsServer = serveMetrics(conf.Metrics.Addr, registry, logger)
+	}
+	registrar.Use(server.ErrorInterceptors()).
+		Use(server.ActionTimeoutInterceptors(time.Duration(conf.ActionTimeout), time.Duration(conf.StreamTimeout)))
+	pb.RegisterDispatcherHandlerServiceServer(registrar, server.NewDispatcherHandlerServer(storagePartitionService, dispatcherRepository, tenantService, objectInstanceRepository, objectRepository, collectionRepository, storageLocationRepository, objectInstanceCheckRepository, eventPublisher, logger))
+	pb.RegisterStorageHandlerHandlerServiceServer(registrar, &server.StorageHandlerHandlerServer{CollectionRepository: collectionRepository,
 		ObjectRepository: objectRepository, StorageLocationRepository: storageLocationRepository, ObjectInstanceRepository: objectInstanceRepository,
-		StoragePartitionService: storagePartitionService, FileRepository: fileRepository, StatusRepository: statusRepository, TransactionRepository: transactionRepository,
-		RefreshMaterializedViewsRepository: refreshMaterializedViewRepository, UploaderService: uploadService, TenantRepository: tenantRepository, TenantService: tenantService, Logger: logger})
-	pb.RegisterClerkHandlerServiceServer(grpcServer, &server.ClerkHandlerServer{TenantService: tenantService,
+		StoragePartitionService: storagePartitionService, FileRepository: fileRepository, StatusRepository: statusRepository, TransactionRepository: transactionRepository, ReservationRepository: reservationRepository,
+		MaterializedViewRefresher: materializedViewRefresher, UploaderService: uploadService, TenantRepository: tenantRepository, TenantService: tenantService, Logger: logger})
+	pb.RegisterClerkHandlerServiceServer(registrar, &server.ClerkHandlerServer{TenantService: tenantService,
 		CollectionRepository: collectionRepository, StorageLocationRepository: storageLocationRepository, ObjectRepository: objectRepository, ObjectInstanceRepository: objectInstanceRepository,
 		FileRepository: fileRepository, ObjectInstanceCheckRepository: objectInstanceCheckRepository, StoragePartitionRepository: storagePartitionRepository, StatusRepository: statusRepository,
-		ObjectInstanceService: objectInstanceService, TenantRepository: tenantRepository, StorageLocationService: storageLocationService, RefreshMaterializedViewsRepository: refreshMaterializedViewRepository, Logger: logger})
-	pb.RegisterCheckerHandlerServiceServer(grpcServer, &server.CheckerHandlerServer{ObjectInstanceRepository: objectInstanceRepository, ObjectInstanceCheckRepository: objectInstanceCheckRepository,
-		ObjectRepository: objectRepository, Logger: logger})
+		ObjectInstanceService: objectInstanceService, TenantRepository: tenantRepository, StorageLocationService: storageLocationService, MaterializedViewRefresher: materializedViewRefresher,
+		TransactionRepository: transactionRepository, AuditRepository: repository.NewAuditRepository(conn),
+		PlacementRepository: placementRepository, CapacityForecastService: capacityForecastService, Logger: logger})
+	pb.RegisterCheckerHandlerServiceServer(registrar, &server.CheckerHandlerServer{ObjectInstanceRepository: objectInstanceRepository, ObjectInstanceCheckRepository: objectInstanceCheckRepository,
+		ObjectRepository: objectRepository, CheckerRepository: checkerRepository, EventPublisher: eventPublisher, Logger: logger})
+
+	healthServer := health.NewServer()
+	healthInterval := time.Duration(conf.Health.Interval)
+	if healthInterval <= 0 {
+		healthInterval = 10 * time.Second
+	}
+	healthMonitor := server.NewHealthMonitor(healthServer, func(ctx context.Context) error { return service.CheckDatabase(ctx, conn, logger) },
+		materializedViewRefresher, healthInterval, time.Duration(conf.Health.MaxViewAge), logger)
+	healthCtx, stopHealthMonitor := context.WithCancel(context.Background())
+	go healthMonitor.Run(healthCtx)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
 
 	grpcServer.Startup()
 	done := make(chan os.Signal, 1)
@@ -227,5 +458,26 @@ func main() {
 	s := <-done
 	fmt.Println("got signal:", s)
 
-	defer grpcServer.GracefulStop()
+	shutdownManager := shutdown.NewManager(time.Duration(conf.DrainTimeout), logger)
+	shutdownManager.AddFunc("health status", func() {
+		stopHealthMonitor()
+		healthServer.Shutdown()
+	})
+	// the event streams do not end on their own and would hold up the drain
+	shutdownManager.Add("event streams", eventPublisher.Stop)
+	shutdownManager.Add("grpc calls", func(ctx context.Context) error { return drainServer(ctx, grpcServer, inFlight) })
+	shutdownManager.Add("materialized view refresh", materializedViewRefresher.Stop)
+	shutdownManager.AddFunc("background jobs", func() {
+		stopRefresher()
+		stopPublisher()
+		stopStatistics()
+	})
+	if metricsServer != nil {
+		shutdownManager.Add("metrics listener", metricsServer.Shutdown)
+	}
+	shutdownManager.Add("resolver client", func(context.Context) error { return resolverClient.Close() })
+	shutdownManager.Add("traces", shutdownTracing)
+	shutdownManager.AddFunc("loggers", closeLoggers)
+	shutdownManager.AddFunc("database pool", conn.Close)
+	shutdownManager.Shutdown()
 }
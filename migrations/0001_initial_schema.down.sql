@@ -0,0 +1,21 @@
+DROP FUNCTION refresh_mvw3();
+DROP FUNCTION refresh_mvw2();
+DROP FUNCTION refresh_mvw1();
+DROP MATERIALIZED VIEW mat_tenant_file_join;
+DROP MATERIALIZED VIEW mat_coll_obj_file;
+DROP MATERIALIZED VIEW mat_coll_obj;
+DROP VIEW quality_with_locations;
+DROP VIEW obj_loc;
+DROP VIEW col_obj_inst;
+DROP VIEW storage_partition;
+DROP TABLE archiving_status;
+DROP TABLE file;
+DROP TABLE object_instance_check;
+DROP TABLE object_instance;
+DROP TABLE object;
+DROP TABLE storage_partition_group_elem;
+DROP TABLE storage_partition_base;
+DROP TABLE storage_location;
+DROP TABLE collection;
+DROP TABLE tenant;
+DROP TABLE api_key;
@@ -0,0 +1,236 @@
+-- Tables, views and functions the handler reads and writes. The column order matters,
+-- because the repositories select * and scan the columns by position.
+CREATE TABLE api_key
+(
+    id  uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    key text NOT NULL UNIQUE
+);
+
+CREATE TABLE tenant
+(
+    name       text NOT NULL,
+    alias      text NOT NULL UNIQUE,
+    person     text,
+    email      text,
+    id         uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    api_key_id uuid REFERENCES api_key (id)
+);
+
+CREATE TABLE collection
+(
+    alias       text    NOT NULL UNIQUE,
+    description text,
+    owner       text,
+    owner_mail  text,
+    name        text,
+    quality     integer NOT NULL DEFAULT 0,
+    tenant_id   uuid    NOT NULL REFERENCES tenant (id),
+    id          uuid PRIMARY KEY DEFAULT gen_random_uuid()
+);
+
+CREATE TABLE storage_location
+(
+    alias               text    NOT NULL UNIQUE,
+    type                text    NOT NULL,
+    vault               text,
+    connection          text    NOT NULL,
+    quality             integer NOT NULL DEFAULT 0,
+    price               integer NOT NULL DEFAULT 0,
+    security_compliency text,
+    fill_first          boolean NOT NULL DEFAULT false,
+    ocfl_type           text,
+    tenant_id           uuid    NOT NULL REFERENCES tenant (id),
+    id                  uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    number_of_threads   integer NOT NULL DEFAULT 1,
+    "group"             text
+);
+
+CREATE TABLE storage_partition_base
+(
+    alias               text   NOT NULL UNIQUE,
+    name                text,
+    max_size            bigint NOT NULL,
+    max_objects         bigint NOT NULL,
+    id                  uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    storage_location_id uuid   NOT NULL REFERENCES storage_location (id)
+);
+
+CREATE TABLE storage_partition_group_elem
+(
+    partition_group_id uuid NOT NULL REFERENCES storage_partition_base (id),
+    alias              text NOT NULL UNIQUE,
+    id                 uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    name               text
+);
+
+CREATE TABLE object
+(
+    signature          text                     NOT NULL,
+    sets               text[],
+    identifiers        text[],
+    title              text,
+    alternative_titles text[],
+    description        text,
+    keywords           text[],
+    "references"       text[],
+    ingest_workflow    text,
+    "user"             text,
+    address            text,
+    created            timestamp with time zone NOT NULL DEFAULT now(),
+    last_changed       timestamp with time zone NOT NULL DEFAULT now(),
+    size               bigint                   NOT NULL DEFAULT 0,
+    id                 uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    collection_id      uuid                     NOT NULL REFERENCES collection (id),
+    checksum           text,
+    authors            text[],
+    holding            text,
+    expiration         date,
+    head               text,
+    versions           text,
+    "binary"           boolean                  NOT NULL DEFAULT false
+);
+CREATE INDEX object_collection_id_idx ON object (collection_id);
+CREATE INDEX object_signature_idx ON object (signature);
+
+CREATE TABLE object_instance
+(
+    path                 text                     NOT NULL,
+    size                 bigint                   NOT NULL DEFAULT 0,
+    created              timestamp with time zone NOT NULL DEFAULT now(),
+    status               text                     NOT NULL,
+    id                   uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    storage_partition_id uuid                     NOT NULL REFERENCES storage_partition_base (id),
+    object_id            uuid                     NOT NULL REFERENCES object (id)
+);
+CREATE INDEX object_instance_object_id_idx ON object_instance (object_id);
+CREATE INDEX object_instance_storage_partition_id_idx ON object_instance (storage_partition_id);
+
+CREATE TABLE object_instance_check
+(
+    checktime          timestamp with time zone NOT NULL DEFAULT now(),
+    error              boolean                  NOT NULL,
+    message            text,
+    id                 uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    object_instance_id uuid                     NOT NULL REFERENCES object_instance (id) ON DELETE CASCADE,
+    check_type         text
+);
+CREATE INDEX object_instance_check_object_instance_id_idx ON object_instance_check (object_instance_id);
+
+CREATE TABLE file
+(
+    checksum  text,
+    name      text[],
+    size      bigint NOT NULL DEFAULT 0,
+    mime_type text,
+    pronom    text,
+    width     bigint,
+    height    bigint,
+    duration  bigint,
+    id        uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    object_id uuid   NOT NULL REFERENCES object (id)
+);
+CREATE INDEX file_object_id_idx ON file (object_id);
+
+CREATE TABLE archiving_status
+(
+    id           uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    last_changed timestamp with time zone NOT NULL DEFAULT now(),
+    status       text                     NOT NULL
+);
+
+-- storage_partition adds the space and the number of objects used to the partitions.
+CREATE VIEW storage_partition AS
+SELECT spb.alias,
+       spb.name,
+       spb.max_size,
+       spb.max_objects,
+       coalesce(sum(oi.size), 0)::bigint AS current_size,
+       count(oi.id)                      AS current_objects,
+       spb.id,
+       spb.storage_location_id
+FROM storage_partition_base spb
+         left join object_instance oi on oi.storage_partition_id = spb.id
+group by spb.id;
+
+-- col_obj_inst lists the status of every instance of the objects of a collection.
+CREATE VIEW col_obj_inst AS
+SELECT o.id, o.collection_id, oi.status
+FROM object o
+         inner join object_instance oi on oi.object_id = o.id;
+
+-- obj_loc is the combination of storage locations every object is stored on.
+CREATE VIEW obj_loc AS
+SELECT o.id,
+       o.collection_id,
+       array_agg(DISTINCT sl.id::text ORDER BY sl.id::text) AS locations,
+       sum(sl.quality)::integer                             AS quality,
+       sum(sl.price)::integer                               AS price,
+       o.size
+FROM object o
+         inner join object_instance oi on oi.object_id = o.id
+         inner join storage_partition_base spb on spb.id = oi.storage_partition_id
+         inner join storage_location sl on sl.id = spb.storage_location_id
+group by o.id;
+
+-- quality_with_locations adds the storage locations of the object and whether all of
+-- its instances are ok to the objects.
+CREATE VIEW quality_with_locations AS
+SELECT o.*,
+       array_agg(DISTINCT sl.id::text ORDER BY sl.id::text) AS locations,
+       bool_and(oi.status = 'ok')                           AS ok
+FROM object o
+         inner join object_instance oi on oi.object_id = o.id
+         inner join storage_partition_base spb on spb.id = oi.storage_partition_id
+         inner join storage_location sl on sl.id = spb.storage_location_id
+group by o.id;
+
+CREATE MATERIALIZED VIEW mat_coll_obj AS
+SELECT o.*, totals.total_file_size, totals.total_file_count, c.tenant_id
+FROM object o
+         inner join collection c on c.id = o.collection_id
+         left join lateral (SELECT sum(f.size) as total_file_size, count(f.*) as total_file_count
+                            FROM file f
+                            where f.object_id = o.id) totals on true;
+CREATE UNIQUE INDEX mat_coll_obj_id_idx ON mat_coll_obj (id);
+
+CREATE MATERIALIZED VIEW mat_coll_obj_file AS
+SELECT c.*, files.total_file_size, files.total_file_count, objects.total_object_count
+FROM collection c
+         left join lateral (SELECT sum(f.size) as total_file_size, count(f.*) as total_file_count
+                            FROM file f
+                                     inner join object o on o.id = f.object_id
+                            where o.collection_id = c.id) files on true
+         left join lateral (SELECT count(o.*) as total_object_count FROM object o where o.collection_id = c.id) objects
+                   on true;
+CREATE UNIQUE INDEX mat_coll_obj_file_id_idx ON mat_coll_obj_file (id);
+
+CREATE MATERIALIZED VIEW mat_tenant_file_join AS
+SELECT f.*, o.collection_id, c.tenant_id
+FROM file f
+         inner join object o on o.id = f.object_id
+         inner join collection c on c.id = o.collection_id;
+CREATE UNIQUE INDEX mat_tenant_file_join_id_idx ON mat_tenant_file_join (id);
+
+CREATE FUNCTION refresh_mvw1() RETURNS void
+    LANGUAGE plpgsql AS
+$$
+BEGIN
+    REFRESH MATERIALIZED VIEW CONCURRENTLY mat_coll_obj;
+END
+$$;
+
+CREATE FUNCTION refresh_mvw2() RETURNS void
+    LANGUAGE plpgsql AS
+$$
+BEGIN
+    REFRESH MATERIALIZED VIEW CONCURRENTLY mat_coll_obj_file;
+END
+$$;
+
+CREATE FUNCTION refresh_mvw3() RETURNS void
+    LANGUAGE plpgsql AS
+$$
+BEGIN
+    REFRESH MATERIALIZED VIEW CONCURRENTLY mat_tenant_file_join;
+END
+$$;
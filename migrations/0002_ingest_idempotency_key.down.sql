@@ -0,0 +1 @@
+DROP TABLE ingest_idempotency_key;
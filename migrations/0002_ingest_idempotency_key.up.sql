@@ -0,0 +1,10 @@
+-- Idempotency keys of the ingest commits sent through SaveAllTableObjectsAfterCopyingStream.
+-- A key is inserted in the same transaction as the ingested rows, so it only exists if the
+-- commit succeeded. The status is returned again when a client replays the same key.
+CREATE TABLE ingest_idempotency_key
+(
+    idempotency_key    text PRIMARY KEY,
+    object_instance_id uuid                     NOT NULL,
+    ok                 boolean                  NOT NULL,
+    created            timestamp with time zone NOT NULL DEFAULT now()
+);
@@ -0,0 +1 @@
+DROP TABLE materialized_view_refresh;
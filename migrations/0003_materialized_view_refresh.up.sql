@@ -0,0 +1,7 @@
+-- Start of the last successful refresh of every materialized view, written by the
+-- materialized view refresher and reported with the reads served from the views.
+CREATE TABLE materialized_view_refresh
+(
+    view      text PRIMARY KEY,
+    refreshed timestamp with time zone NOT NULL
+);
@@ -0,0 +1,39 @@
+-- Changes made through the clerk handlers. actor is the user named by the caller or, if
+-- none is named, the identity of the client certificate, client is always the identity of
+-- the certificate. before and after hold the entity as JSON, before is null for entities
+-- created and after for entities deleted. Rows can only be appended.
+CREATE TABLE audit_log
+(
+    id          uuid PRIMARY KEY                  DEFAULT gen_random_uuid(),
+    created     timestamp with time zone NOT NULL DEFAULT clock_timestamp(),
+    actor       text                     NOT NULL,
+    client      text                     NOT NULL,
+    method      text                     NOT NULL,
+    entity_type text                     NOT NULL,
+    entity_id   text                     NOT NULL,
+    before      jsonb,
+    after       jsonb
+);
+
+CREATE INDEX audit_log_created_idx ON audit_log (created, id);
+CREATE INDEX audit_log_entity_idx ON audit_log (entity_type, entity_id, created);
+
+CREATE FUNCTION audit_log_append_only() RETURNS trigger
+    LANGUAGE plpgsql AS
+$$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$;
+
+CREATE TRIGGER audit_log_no_update_delete
+    BEFORE UPDATE OR DELETE
+    ON audit_log
+    FOR EACH ROW
+EXECUTE FUNCTION audit_log_append_only();
+
+CREATE TRIGGER audit_log_no_truncate
+    BEFORE TRUNCATE
+    ON audit_log
+    FOR EACH STATEMENT
+EXECUTE FUNCTION audit_log_append_only();
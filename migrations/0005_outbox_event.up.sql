@@ -0,0 +1,32 @@
+-- Object lifecycle events, inserted in the transaction of the change. event_offset is
+-- null until the event is published: the publisher numbers the committed events under an
+-- advisory lock, so that the offsets grow without gaps in the order they become visible
+-- and a watcher can resume after the last offset it has seen.
+CREATE TABLE outbox_event
+(
+    id           bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    event_offset bigint UNIQUE,
+    created      timestamp with time zone NOT NULL DEFAULT clock_timestamp(),
+    event_type   text                     NOT NULL,
+    entity_id    text                     NOT NULL,
+    payload      jsonb                    NOT NULL
+);
+
+CREATE INDEX outbox_event_unpublished_idx ON outbox_event (id) WHERE event_offset IS NULL;
+CREATE INDEX outbox_event_created_idx ON outbox_event (created);
+
+-- wakes the publisher when the transaction inserting events commits
+CREATE FUNCTION outbox_event_notify() RETURNS trigger
+    LANGUAGE plpgsql AS
+$$
+BEGIN
+    PERFORM pg_notify('outbox_event', '');
+    RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER outbox_event_inserted
+    AFTER INSERT
+    ON outbox_event
+    FOR EACH STATEMENT
+EXECUTE FUNCTION outbox_event_notify();
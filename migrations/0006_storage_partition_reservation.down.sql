@@ -0,0 +1 @@
+DROP TABLE storage_partition_reservation;
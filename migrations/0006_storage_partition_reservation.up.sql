@@ -0,0 +1,17 @@
+-- Capacity claimed on a partition by a placement until the object instance is stored by
+-- SaveAllTableObjectsAfterCopyingStream. Reservations past expires no longer count and
+-- are deleted by the next placement.
+CREATE TABLE storage_partition_reservation
+(
+    id                   uuid PRIMARY KEY                  DEFAULT gen_random_uuid(),
+    storage_partition_id uuid                     NOT NULL REFERENCES storage_partition_base (id) ON DELETE CASCADE,
+    signature            text                     NOT NULL,
+    location_group       text                     NOT NULL,
+    size                 bigint                   NOT NULL,
+    created              timestamp with time zone NOT NULL DEFAULT now(),
+    expires              timestamp with time zone NOT NULL
+);
+
+CREATE INDEX storage_partition_reservation_partition_idx ON storage_partition_reservation (storage_partition_id, signature);
+CREATE INDEX storage_partition_reservation_signature_idx ON storage_partition_reservation (signature, location_group);
+CREATE INDEX storage_partition_reservation_expires_idx ON storage_partition_reservation (expires);
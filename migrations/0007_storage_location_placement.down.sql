@@ -0,0 +1 @@
+DROP TABLE storage_location_placement;
@@ -0,0 +1,6 @@
+-- Placement strategy of a storage location, the locations without a row use fill-first.
+CREATE TABLE storage_location_placement
+(
+    storage_location_id uuid PRIMARY KEY REFERENCES storage_location (id) ON DELETE CASCADE,
+    strategy            text NOT NULL
+);
@@ -0,0 +1,11 @@
+-- Automatic provisioning of partitions of a storage location when no partition has room
+-- for an object. The new partitions get max_size and max_objects, the location is grown up
+-- to max_partitions partitions.
+CREATE TABLE storage_location_provisioning
+(
+    storage_location_id uuid PRIMARY KEY REFERENCES storage_location (id) ON DELETE CASCADE,
+    enabled             boolean NOT NULL,
+    max_size            bigint  NOT NULL,
+    max_objects         bigint  NOT NULL,
+    max_partitions      integer NOT NULL
+);
@@ -0,0 +1,14 @@
+-- Last number N of the "<storage_location_id>-partition-N" aliases allocated per storage
+-- location. The row is locked by the upsert allocating the next number, so concurrent
+-- allocations on a location get distinct numbers.
+CREATE TABLE storage_partition_alias_counter
+(
+    storage_location_id uuid PRIMARY KEY REFERENCES storage_location (id) ON DELETE CASCADE,
+    last_number         integer NOT NULL
+);
+
+INSERT INTO storage_partition_alias_counter(storage_location_id, last_number)
+SELECT storage_location_id, max(substring(alias FROM 'partition-([0-9]+)$')::integer)
+FROM storage_partition_base
+WHERE alias ~ 'partition-[0-9]+$'
+GROUP BY storage_location_id;
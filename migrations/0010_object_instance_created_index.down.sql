@@ -0,0 +1 @@
+DROP INDEX object_instance_created_idx;
@@ -0,0 +1,2 @@
+-- The capacity forecast reads the object instances created in its window.
+CREATE INDEX object_instance_created_idx ON object_instance (created);
@@ -0,0 +1,11 @@
+-- Offset of the latest event numbered by the publisher. It is kept when the retention has
+-- deleted all published events, so that the offsets only ever grow.
+CREATE TABLE outbox_offset
+(
+    id          boolean PRIMARY KEY DEFAULT true CHECK (id),
+    last_offset bigint NOT NULL
+);
+
+INSERT INTO outbox_offset(last_offset)
+SELECT coalesce(max(event_offset), 0)
+FROM outbox_event;
@@ -0,0 +1,2 @@
+ALTER TABLE ingest_idempotency_key
+    ADD COLUMN ok boolean NOT NULL DEFAULT true;
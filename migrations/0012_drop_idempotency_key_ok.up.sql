@@ -0,0 +1,3 @@
+-- A key is only stored by a successful commit, so the status replayed for it is always ok.
+ALTER TABLE ingest_idempotency_key
+    DROP COLUMN ok;
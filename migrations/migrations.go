@@ -0,0 +1,329 @@
+package migrations
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/je4/utils/v2/pkg/zLogger"
+)
+
+// lockId is the key of the advisory lock held while migrating, so that handlers starting
+// at the same time do not apply the same migration twice.
+const lockId = 7133546113
+
+const createMigrationTable = "CREATE TABLE IF NOT EXISTS schema_migration (" +
+	" version integer PRIMARY KEY," +
+	" name text NOT NULL," +
+	" checksum text NOT NULL," +
+	" applied timestamp with time zone NOT NULL DEFAULT now())"
+
+var migrationFileRegexp = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+var createdRelationRegexp = regexp.MustCompile(`(?im)^CREATE\s+(?:TABLE|VIEW|MATERIALIZED\s+VIEW)\s+(\w+)`)
+
+// Migration changes the schema from version Version-1 to Version with Up and back with
+// Down. Checksum is the hash of Up, it is stored with the applied version to detect
+// migrations that were changed after they had been applied.
+type Migration struct {
+	Version  int
+	Name     string
+	Up       string
+	Down     string
+	Checksum string
+}
+
+// Relations returns the tables and views created by the up script of the migration.
+func (m Migration) Relations() []string {
+	var relations []string
+	for _, match := range createdRelationRegexp.FindAllStringSubmatch(m.Up, -1) {
+		relations = append(relations, match[1])
+	}
+	return relations
+}
+
+// AppliedMigration is a version recorded in the schema_migration table.
+type AppliedMigration struct {
+	Version  int
+	Name     string
+	Checksum string
+	Applied  time.Time
+}
+
+// Load reads the migrations named <version>_<name>.up.sql and <version>_<name>.down.sql
+// from fSys. The versions have to start at 1 and must not have gaps.
+func Load(fSys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fSys, ".")
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read migrations")
+	}
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		match := migrationFileRegexp.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, errors.Errorf("migration file name '%s' does not match <version>_<name>.<up|down>.sql", entry.Name())
+		}
+		version, err := strconv.Atoi(match[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid version in migration file name '%s'", entry.Name())
+		}
+		data, err := fs.ReadFile(fSys, entry.Name())
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot read migration '%s'", entry.Name())
+		}
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, errors.Errorf("migrations '%s' and '%s' have the same version %d", migration.Name, match[2], version)
+		}
+		if match[3] == "up" {
+			migration.Up = string(data)
+			checksum := sha256.Sum256(data)
+			migration.Checksum = hex.EncodeToString(checksum[:])
+		} else {
+			migration.Down = string(data)
+		}
+	}
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	for i, migration := range migrations {
+		if migration.Version != i+1 {
+			return nil, errors.Errorf("migration version %d is missing", i+1)
+		}
+		if migration.Up == "" || migration.Down == "" {
+			return nil, errors.Errorf("migration %d_%s needs an up and a down script", migration.Version, migration.Name)
+		}
+	}
+	return migrations, nil
+}
+
+// Migrator applies the migrations to the database of a single connection.
+type Migrator struct {
+	conn       *pgx.Conn
+	migrations []Migration
+	logger     zLogger.ZLogger
+}
+
+func NewMigrator(conn *pgx.Conn, fSys fs.FS, logger zLogger.ZLogger) (*Migrator, error) {
+	migrations, err := Load(fSys)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{conn: conn, migrations: migrations, logger: logger}, nil
+}
+
+// LatestVersion is the schema version this build expects.
+func (m *Migrator) LatestVersion() int {
+	return len(m.migrations)
+}
+
+// Applied returns the versions recorded in the database, ordered by version.
+func (m *Migrator) Applied(ctx context.Context) ([]AppliedMigration, error) {
+	var exists bool
+	if err := m.conn.QueryRow(ctx, "SELECT to_regclass('schema_migration') IS NOT NULL").Scan(&exists); err != nil {
+		return nil, errors.Wrap(err, "cannot check for table schema_migration")
+	}
+	applied := make([]AppliedMigration, 0)
+	if !exists {
+		return applied, nil
+	}
+	rows, err := m.conn.Query(ctx, "SELECT version, name, checksum, applied FROM schema_migration order by version")
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read table schema_migration")
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var migration AppliedMigration
+		if err := rows.Scan(&migration.Version, &migration.Name, &migration.Checksum, &migration.Applied); err != nil {
+			return nil, errors.Wrap(err, "cannot scan row of table schema_migration")
+		}
+		applied = append(applied, migration)
+	}
+	return applied, rows.Err()
+}
+
+// verify makes sure that the applied versions are the first migrations of this build
+// and unchanged. A database with a newer or unknown version must not be used, because
+// the queries of this build may not match its schema.
+func (m *Migrator) verify(applied []AppliedMigration) error {
+	for i, migration := range applied {
+		if migration.Version > len(m.migrations) {
+			return errors.Errorf("database schema version %d is newer than version %d known to this build", migration.Version, len(m.migrations))
+		}
+		if migration.Version != i+1 {
+			return errors.Errorf("database schema version %d is unknown, version %d is missing", migration.Version, i+1)
+		}
+		if migration.Checksum != m.migrations[i].Checksum {
+			return errors.Errorf("checksum of applied migration %d_%s does not match the migration of this build", migration.Version, migration.Name)
+		}
+	}
+	return nil
+}
+
+// Check returns an error unless the database has exactly the schema version of this
+// build.
+func (m *Migrator) Check(ctx context.Context) error {
+	applied, err := m.Applied(ctx)
+	if err != nil {
+		return err
+	}
+	if err := m.verify(applied); err != nil {
+		return err
+	}
+	if err := m.checkUnrecordedSchema(ctx, applied); err != nil {
+		return err
+	}
+	if len(applied) < len(m.migrations) {
+		return errors.Errorf("database schema version %d is older than version %d, run with -migrate up", len(applied), len(m.migrations))
+	}
+	return nil
+}
+
+// initialRelations splits the relations created by the initial migration into the ones
+// that exist in the database and the missing ones.
+func (m *Migrator) initialRelations(ctx context.Context) (existing []string, missing []string, err error) {
+	for _, relation := range m.migrations[0].Relations() {
+		var exists bool
+		if err := m.conn.QueryRow(ctx, "SELECT to_regclass($1::text) IS NOT NULL", relation).Scan(&exists); err != nil {
+			return nil, nil, errors.Wrapf(err, "cannot check for relation %s", relation)
+		}
+		if exists {
+			existing = append(existing, relation)
+		} else {
+			missing = append(missing, relation)
+		}
+	}
+	return existing, missing, nil
+}
+
+// checkUnrecordedSchema returns an error if no version is recorded but the database has
+// the schema of the initial migration, created before the handler had migrations.
+func (m *Migrator) checkUnrecordedSchema(ctx context.Context, applied []AppliedMigration) error {
+	if len(applied) > 0 {
+		return nil
+	}
+	existing, _, err := m.initialRelations(ctx)
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 {
+		return errors.Errorf("database has a schema without recorded version (tables %v), run with -migrate baseline to adopt it", existing)
+	}
+	return nil
+}
+
+// Baseline records the initial migration as applied without running it. It adopts a
+// database whose schema was created before the handler had migrations and requires all
+// tables and views of the initial migration to exist.
+func (m *Migrator) Baseline(ctx context.Context) error {
+	return m.locked(ctx, func() error {
+		if _, err := m.conn.Exec(ctx, createMigrationTable); err != nil {
+			return errors.Wrap(err, "cannot create table schema_migration")
+		}
+		applied, err := m.Applied(ctx)
+		if err != nil {
+			return err
+		}
+		if len(applied) > 0 {
+			return errors.Errorf("database schema version %d is recorded already, only databases without version can be adopted", len(applied))
+		}
+		existing, missing, err := m.initialRelations(ctx)
+		if err != nil {
+			return err
+		}
+		if len(existing) == 0 {
+			return errors.New("database has no schema to adopt, run with -migrate up")
+		}
+		if len(missing) > 0 {
+			return errors.Errorf("database schema cannot be adopted, tables or views %v of the initial migration are missing", missing)
+		}
+		initial := m.migrations[0]
+		m.logger.Info().Msgf("adopting existing schema as migration %d_%s", initial.Version, initial.Name)
+		_, err = m.conn.Exec(ctx, "INSERT INTO schema_migration(version, name, checksum) VALUES ($1, $2, $3)",
+			initial.Version, initial.Name, initial.Checksum)
+		return errors.Wrapf(err, "cannot record migration %d_%s", initial.Version, initial.Name)
+	})
+}
+
+// Up applies all pending migrations, each one in its own transaction.
+func (m *Migrator) Up(ctx context.Context) error {
+	return m.locked(ctx, func() error {
+		if _, err := m.conn.Exec(ctx, createMigrationTable); err != nil {
+			return errors.Wrap(err, "cannot create table schema_migration")
+		}
+		applied, err := m.Applied(ctx)
+		if err != nil {
+			return err
+		}
+		if err := m.verify(applied); err != nil {
+			return err
+		}
+		if err := m.checkUnrecordedSchema(ctx, applied); err != nil {
+			return err
+		}
+		for _, migration := range m.migrations[len(applied):] {
+			m.logger.Info().Msgf("applying migration %d_%s", migration.Version, migration.Name)
+			err := pgx.BeginFunc(ctx, m.conn, func(tx pgx.Tx) error {
+				if _, err := tx.Exec(ctx, migration.Up); err != nil {
+					return errors.Wrapf(err, "cannot apply migration %d_%s", migration.Version, migration.Name)
+				}
+				_, err := tx.Exec(ctx, "INSERT INTO schema_migration(version, name, checksum) VALUES ($1, $2, $3)",
+					migration.Version, migration.Name, migration.Checksum)
+				return errors.Wrapf(err, "cannot record migration %d_%s", migration.Version, migration.Name)
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Down reverts the latest applied migration.
+func (m *Migrator) Down(ctx context.Context) error {
+	return m.locked(ctx, func() error {
+		applied, err := m.Applied(ctx)
+		if err != nil {
+			return err
+		}
+		if err := m.verify(applied); err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			return errors.New("no migration to revert")
+		}
+		migration := m.migrations[len(applied)-1]
+		m.logger.Info().Msgf("reverting migration %d_%s", migration.Version, migration.Name)
+		return pgx.BeginFunc(ctx, m.conn, func(tx pgx.Tx) error {
+			if _, err := tx.Exec(ctx, migration.Down); err != nil {
+				return errors.Wrapf(err, "cannot revert migration %d_%s", migration.Version, migration.Name)
+			}
+			_, err := tx.Exec(ctx, "DELETE FROM schema_migration WHERE version = $1", migration.Version)
+			return errors.Wrapf(err, "cannot remove migration %d_%s", migration.Version, migration.Name)
+		})
+	})
+}
+
+func (m *Migrator) locked(ctx context.Context, fn func() error) error {
+	if _, err := m.conn.Exec(ctx, "SELECT pg_advisory_lock($1)", lockId); err != nil {
+		return errors.Wrap(err, "cannot lock schema for migration")
+	}
+	defer m.conn.Exec(context.Background(), "SELECT pg_advisory_unlock($1)", lockId)
+	return fn()
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Types of the entities recorded in the audit log.
+const (
+	AuditEntityTenant            = "tenant"
+	AuditEntityCollection        = "collection"
+	AuditEntityStorageLocation   = "storage_location"
+	AuditEntityStoragePartition  = "storage_partition"
+	AuditEntityArchivingStatus   = "archiving_status"
+	AuditEntityObject            = "object"
+	AuditEntityPlacementStrategy = "placement_strategy"
+	AuditEntityProvisioning      = "partition_provisioning"
+)
+
+// AuditEntry is a change of an entity. Before and After are the entity as JSON, Before
+// is nil for created and After for deleted entities.
+type AuditEntry struct {
+	Id         string
+	Created    time.Time
+	Actor      string
+	Client     string
+	Method     string
+	EntityType string
+	EntityId   string
+	Before     []byte
+	After      []byte
+}
+
+// AuditFilter selects entries of the audit log, empty fields match all entries.
+type AuditFilter struct {
+	EntityType string
+	EntityId   string
+	Actor      string
+	Method     string
+	Since      time.Time
+	Until      time.Time
+}
+
+type AuditRepository interface {
+	CreateAuditEntry(ctx context.Context, entry AuditEntry) error
+	// CreateAuditEntryTx appends the entry in the transaction of the change it records, so
+	// that the change is only committed together with its entry.
+	CreateAuditEntryTx(ctx context.Context, tx pgx.Tx, entry AuditEntry) error
+	GetAuditEntriesKeyset(ctx context.Context, filter AuditFilter, pagination KeysetPagination) ([]AuditEntry, string, int, error)
+}
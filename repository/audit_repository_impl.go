@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	CreateAuditEntry = "CreateAuditEntry"
+)
+
+const auditListing = "SELECT id, created, actor, client, method, entity_type, entity_id, before, after FROM audit_log"
+
+// auditKeysetColumns sort the audit log by the time of the change only.
+var auditKeysetColumns = keysetColumns{
+	columns: map[string]keysetColumn{
+		"created": {expression: "created", sqlType: "timestamptz"},
+	},
+	fallback: "created",
+	id:       "id",
+}
+
+func NewAuditRepository(db *pgxpool.Pool) AuditRepository {
+	return &AuditRepositoryImpl{Db: db}
+}
+
+type AuditRepositoryImpl struct {
+	Db *pgxpool.Pool
+}
+
+var auditPreparedStatements = map[string]string{
+	CreateAuditEntry: "INSERT INTO audit_log(actor, client, method, entity_type, entity_id, before, after)" +
+		" VALUES ($1, $2, $3, $4, $5, $6, $7)",
+}
+
+func CreateAuditPreparedStatements(ctx context.Context, conn *pgx.Conn) error {
+	return prepareStatements(ctx, conn, auditPreparedStatements)
+}
+
+func (a *AuditRepositoryImpl) CreateAuditEntry(ctx context.Context, entry AuditEntry) error {
+	return createAuditEntry(ctx, a.Db, entry)
+}
+
+func (a *AuditRepositoryImpl) CreateAuditEntryTx(ctx context.Context, tx pgx.Tx, entry AuditEntry) error {
+	return createAuditEntry(ctx, tx, entry)
+}
+
+func createAuditEntry(ctx context.Context, db querier, entry AuditEntry) error {
+	_, err := db.Exec(ctx, CreateAuditEntry, entry.Actor, entry.Client, entry.Method, entry.EntityType, entry.EntityId,
+		jsonOrNull(entry.Before), jsonOrNull(entry.After))
+	if err != nil {
+		return errors.Wrapf(err, "Could not execute query for method: %v", CreateAuditEntry)
+	}
+	return nil
+}
+
+func (a *AuditRepositoryImpl) GetAuditEntriesKeyset(ctx context.Context, filter AuditFilter, pagination KeysetPagination) ([]AuditEntry, string, int, error) {
+	var builder queryBuilder
+	if filter.EntityType != "" {
+		builder.whereEquals("entity_type", filter.EntityType)
+	}
+	if filter.EntityId != "" {
+		builder.whereEquals("entity_id", filter.EntityId)
+	}
+	if filter.Actor != "" {
+		builder.whereEquals("actor", filter.Actor)
+	}
+	if filter.Method != "" {
+		builder.whereEquals("method", filter.Method)
+	}
+	if !filter.Since.IsZero() {
+		builder.where("created >= " + builder.bind(filter.Since))
+	}
+	if !filter.Until.IsZero() {
+		builder.where("created < " + builder.bind(filter.Until))
+	}
+	query := auditListing + builder.whereClause()
+	keyset, err := builder.keyset(query, pagination, auditKeysetColumns)
+	if err != nil {
+		return nil, "", 0, errors.Wrapf(err, "Could not build keyset query for audit log")
+	}
+	rows, err := a.Db.Query(ctx, keyset.sql, keyset.args...)
+	if err != nil {
+		return nil, "", 0, errors.Wrapf(err, "Could not execute query: %s", keyset.sql)
+	}
+	defer rows.Close()
+	var entries []AuditEntry
+	var cursorValue string
+	fetched := 0
+	for rows.Next() {
+		fetched++
+		if keyset.hasNext(fetched) {
+			break
+		}
+		var entry AuditEntry
+		err := rows.Scan(&entry.Id, &entry.Created, &entry.Actor, &entry.Client, &entry.Method, &entry.EntityType,
+			&entry.EntityId, &entry.Before, &entry.After, &cursorValue)
+		if err != nil {
+			return nil, "", 0, errors.Wrapf(err, "Could not scan rows for query: %s", keyset.sql)
+		}
+		entries = append(entries, entry)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, "", 0, errors.Wrapf(err, "Could not read rows for query: %s", keyset.sql)
+	}
+	var nextCursor string
+	if keyset.hasNext(fetched) {
+		nextCursor = keyset.cursorAfter(cursorValue, entries[len(entries)-1].Id)
+	}
+	var totalItems int
+	if pagination.WithTotalItems {
+		totalItems, err = builder.count(ctx, a.Db, query)
+		if err != nil {
+			return nil, "", 0, err
+		}
+	}
+	return entries, nextCursor, totalItems, nil
+}
+
+// jsonOrNull passes missing JSON as null instead of an empty document.
+func jsonOrNull(data []byte) any {
+	if len(data) == 0 {
+		return nil
+	}
+	return string(data)
+}
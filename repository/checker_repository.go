@@ -1,7 +1,25 @@
 package repository
 
-import "github.com/ocfl-archive/dlza-manager/models"
+import (
+	"context"
+	"github.com/ocfl-archive/dlza-manager/models"
+)
 
 type CheckerRepository interface {
-	GetPathsToCopy() ([]models.CopyPaths, error)
+	GetPathsToCopy(ctx context.Context) ([]models.CopyPaths, error)
+	ExportRepairCandidates(ctx context.Context, send func(candidate RepairCandidate) error) error
+}
+
+// RepairCandidate is an object instance with status error together with a healthy
+// instance of the same object it can be copied from.
+type RepairCandidate struct {
+	ObjectId                   string
+	SourceObjectInstanceId     string
+	SourcePath                 string
+	SourceStorageLocationId    string
+	SourceStorageLocationAlias string
+	TargetObjectInstanceId     string
+	TargetPath                 string
+	TargetStorageLocationId    string
+	TargetStorageLocationAlias string
 }
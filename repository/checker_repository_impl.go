@@ -2,7 +2,6 @@ package repository
 
 import (
 	"context"
-	"fmt"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
@@ -15,78 +14,45 @@ type CheckerRepositoryImpl struct {
 	Db *pgxpool.Pool
 }
 
-const (
-	FindAllObjectsWithErrors     = "FindAllObjectsWithErrors"
-	FindObjectIdByObjectInstance = "FindObjectIdByObjectInstance"
-)
-
-func CreateCheckerPreparedStatements(ctx context.Context, conn *pgx.Conn) error {
-
-	preparedStatements := map[string]string{
-		FindAllObjectsWithErrors: "SELECT oi.object_id, oi.path FROM object_instance oi inner join object_instance_check oic" +
-			" on oi.id = oic.object_instance_id" +
-			" where checktime <=  CURRENT_DATE - '30 day'::interval" +
-			" AND oic.error = 'true'",
-		FindObjectIdByObjectInstance: "SELECT oi.status, oi.path FROM object_instance oi where oi.object_id = $1",
-	}
-	for name, sqlStm := range preparedStatements {
-		if _, err := conn.Prepare(ctx, name, sqlStm); err != nil {
-			return errors.Wrapf(err, "cannot prepare statement '%s' - '%s'", name, sqlStm)
-		}
-	}
-	return nil
-}
-
-func (c *CheckerRepositoryImpl) GetPathsToCopy() ([]models.CopyPaths, error) {
-
-	rowsRs, err := c.Db.Query(context.Background(), FindAllObjectsWithErrors)
+// repairCandidatesQuery pairs every object instance with status error with the most
+// recently created instance with status ok of the same object. Objects without any
+// healthy instance cannot be repaired by copying and are left out.
+const repairCandidatesQuery = "SELECT oi.object_id, src.id, src.path, src.storage_location_id, src.alias," +
+	" oi.id, oi.path, sl.id, sl.alias" +
+	" FROM object_instance oi" +
+	" inner join storage_partition sp on sp.id = oi.storage_partition_id" +
+	" inner join storage_location sl on sl.id = sp.storage_location_id" +
+	" inner join lateral (SELECT ok.id, ok.path, okl.id as storage_location_id, okl.alias FROM object_instance ok" +
+	" inner join storage_partition okp on okp.id = ok.storage_partition_id" +
+	" inner join storage_location okl on okl.id = okp.storage_location_id" +
+	" where ok.object_id = oi.object_id and ok.status = 'ok'" +
+	" order by ok.created desc, ok.id limit 1) src on true" +
+	" where oi.status = 'error'" +
+	" order by oi.object_id, oi.id"
+
+func (c *CheckerRepositoryImpl) GetPathsToCopy(ctx context.Context) ([]models.CopyPaths, error) {
+	list := make([]models.CopyPaths, 0)
+	err := c.ExportRepairCandidates(ctx, func(candidate RepairCandidate) error {
+		list = append(list, models.CopyPaths{From: candidate.SourcePath, To: candidate.TargetPath})
+		return nil
+	})
 	if err != nil {
-		return nil, errors.Wrapf(err, "cannot execute query for method: %v", FindAllObjectsWithErrors)
+		return nil, err
 	}
+	return list, nil
+}
 
-	listObjectI := make([]string, 0)
-	for rowsRs.Next() {
-
-		var objectId string
-		var to string
-
-		err := rowsRs.Scan(&objectId, &to)
-		if err != nil {
-			return nil, errors.Wrapf(err, "cannot map data")
-		}
-		listObjectI = append(listObjectI, objectId)
-	}
-	list := make([]models.CopyPaths, 0)
-	for index, item := range listObjectI {
-		rowsOI, err := c.Db.Query(context.Background(), FindObjectIdByObjectInstance, item)
+func (c *CheckerRepositoryImpl) ExportRepairCandidates(ctx context.Context, send func(candidate RepairCandidate) error) error {
+	return exportRows(ctx, c.Db, repairCandidatesQuery, nil, func(rows pgx.Rows) error {
+		var candidate RepairCandidate
+		err := rows.Scan(&candidate.ObjectId, &candidate.SourceObjectInstanceId, &candidate.SourcePath, &candidate.SourceStorageLocationId,
+			&candidate.SourceStorageLocationAlias, &candidate.TargetObjectInstanceId, &candidate.TargetPath, &candidate.TargetStorageLocationId,
+			&candidate.TargetStorageLocationAlias)
 		if err != nil {
-			return nil, errors.Wrapf(err, "cannot execute query for method: %v", FindObjectIdByObjectInstance)
-		}
-		copyPaths := models.CopyPaths{}
-		for rowsOI.Next() {
-			var status string
-			var path string
-
-			err := rowsOI.Scan(&status, &path)
-			if err != nil {
-				return nil, errors.Wrapf(err, "cannot map data")
-			}
-			if status == "error" {
-				copyPaths.To = path
-			}
-			if status == "ok" {
-				copyPaths.From = path
-			}
-			if copyPaths.To != "" && copyPaths.From != "" {
-				break
-			}
+			return errors.Wrapf(err, "Could not scan rows for query: %s", repairCandidatesQuery)
 		}
-		if index == 200 {
-			fmt.Print(index)
-		}
-		list = append(list, copyPaths)
-	}
-	return nil, nil
+		return send(candidate)
+	})
 }
 
 func NewCheckerRepository(db *pgxpool.Pool) CheckerRepository {
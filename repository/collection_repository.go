@@ -1,20 +1,28 @@
 package repository
 
 import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
 	"github.com/ocfl-archive/dlza-manager/models"
 )
 
 type CollectionRepository interface {
-	CreateCollection(collection models.Collection) (string, error)
-	DeleteCollectionById(id string) error
-	GetCollectionsByTenantIdPaginated(pagination models.Pagination) ([]models.Collection, int, error)
-	GetCollectionIdByAlias(alias string) (string, error)
-	GetCollectionByAlias(alias string) (models.Collection, error)
-	UpdateCollection(collection models.Collection) error
-	GetCollectionsByTenantId(tenantId string) ([]models.Collection, error)
-	GetCollectionById(id string) (models.Collection, error)
-	GetCollectionByIdFromMv(id string) (models.Collection, error)
-	GetSizeForAllObjectInstancesByCollectionId(id string) (int64, error)
-	GetExistingStorageLocationsCombinationsForCollectionId(id string) ([]models.CollectionWithExistingStorageLocationsCombinations, error)
-	GetAmountOfObjectsInCollection(id string) (int64, error)
+	CreateCollection(ctx context.Context, collection models.Collection) (string, error)
+	DeleteCollectionById(ctx context.Context, id string) error
+	GetCollectionsByTenantIdPaginated(ctx context.Context, pagination models.Pagination, fresh bool) ([]models.Collection, int, error)
+	GetCollectionIdByAlias(ctx context.Context, alias string) (string, error)
+	GetCollectionByAlias(ctx context.Context, alias string) (models.Collection, error)
+	UpdateCollection(ctx context.Context, collection models.Collection) error
+	GetCollectionsByTenantId(ctx context.Context, tenantId string) ([]models.Collection, error)
+	GetCollectionById(ctx context.Context, id string) (models.Collection, error)
+	GetCollectionByIdFromMv(ctx context.Context, id string, fresh bool) (models.Collection, error)
+	GetSizeForAllObjectInstancesByCollectionId(ctx context.Context, id string) (int64, error)
+	GetExistingStorageLocationsCombinationsForCollectionId(ctx context.Context, id string) ([]models.CollectionWithExistingStorageLocationsCombinations, error)
+	GetAmountOfObjectsInCollection(ctx context.Context, id string) (int64, error)
+	// GetCollectionByIdTx returns the collection and locks it until the transaction ends.
+	GetCollectionByIdTx(ctx context.Context, tx pgx.Tx, id string) (models.Collection, error)
+	CreateCollectionTx(ctx context.Context, tx pgx.Tx, collection models.Collection) (string, error)
+	UpdateCollectionTx(ctx context.Context, tx pgx.Tx, collection models.Collection) error
+	DeleteCollectionByIdTx(ctx context.Context, tx pgx.Tx, id string) error
 }
@@ -3,13 +3,10 @@ package repository
 import (
 	"context"
 	"emperror.dev/errors"
-	"fmt"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype/zeronull"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/ocfl-archive/dlza-manager/models"
-	"strconv"
-	"strings"
 )
 
 const (
@@ -21,11 +18,15 @@ const (
 	GetCollectionByAlias                                   = "GetCollectionByAlias"
 	GetCollectionByIdFromMv                                = "GetCollectionByIdFromMv"
 	GetCollectionById                                      = "GetCollectionById"
+	GetCollectionByIdForUpdate                             = "GetCollectionByIdForUpdate"
 	GetSizeForAllObjectInstancesByCollectionId             = "GetSizeForAllObjectInstancesByCollectionId"
 	GetAmountOfObjectsInCollection                         = "GetAmountOfObjectsInCollection"
 	GetExistingStorageLocationsCombinationsForCollectionId = "GetExistingStorageLocationsCombinationsForCollectionId"
 )
 
+var collectionSortColumns = newSortColumns("alias", "id", columnsWithPrefix("", "alias", "description", "owner", "owner_mail",
+	"name", "quality", "tenant_id", "id", "total_file_size", "total_file_count", "total_object_count"))
+
 type CollectionRepositoryImpl struct {
 	Db *pgxpool.Pool
 }
@@ -34,43 +35,41 @@ func NewCollectionRepository(db *pgxpool.Pool) CollectionRepository {
 	return &CollectionRepositoryImpl{Db: db}
 }
 
+var collectionPreparedStatements = map[string]string{
+	GetCollectionsByTenantId: "SELECT * FROM collection where tenant_id = $1",
+	GetCollectionIdByAlias:   "SELECT id FROM collection where alias = $1",
+	GetCollectionByAlias:     "SELECT * FROM collection where alias = $1",
+	GetCollectionByIdFromMv:  "SELECT * FROM mat_coll_obj_file where id = $1",
+	GetCollectionById:        "SELECT * FROM collection where id = $1",
+	// NO KEY UPDATE does not block the objects referencing the collection
+	GetCollectionByIdForUpdate: "SELECT * FROM collection where id = $1 FOR NO KEY UPDATE",
+	DeleteCollectionById:       "DELETE FROM collection WHERE id = $1",
+	UpdateCollection:           "UPDATE collection SET description = $1, owner = $2, owner_mail= $3, name = $4, quality = $5, tenant_id= $6 where id = $7",
+	CreateCollection: "INSERT INTO collection(alias, description, owner, owner_mail, name, quality, tenant_id)" +
+		" VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id",
+	GetSizeForAllObjectInstancesByCollectionId: "select sum(oi.size) from object o" +
+		" left join  object_instance oi" +
+		" on o.id = oi.object_id" +
+		" where o.collection_id = $1",
+	GetAmountOfObjectsInCollection: `SELECT count(*) FROM object where collection_id = $1`,
+	GetExistingStorageLocationsCombinationsForCollectionId: `SELECT col.id, col.alias, ol.locations, ol.quality, ol.price, SUM(ol.size) AS size 
+	FROM 
+		collection col, 
+		obj_loc ol
+	WHERE 
+		col.id=ol.collection_id
+	AND
+	    col.id=$1
+	GROUP BY col.id, col.alias, ol.locations, ol.quality, ol.price
+	ORDER BY size DESC`,
+}
+
 func CreateCollectionPreparedStatements(ctx context.Context, conn *pgx.Conn) error {
-	preparedStatements := map[string]string{
-		GetCollectionsByTenantId: "SELECT * FROM collection where tenant_id = $1",
-		GetCollectionIdByAlias:   "SELECT id FROM collection where alias = $1",
-		GetCollectionByAlias:     "SELECT * FROM collection where alias = $1",
-		GetCollectionByIdFromMv:  "SELECT * FROM mat_coll_obj_file where id = $1",
-		GetCollectionById:        "SELECT * FROM collection where id = $1",
-		DeleteCollectionById:     "DELETE FROM collection WHERE id = $1",
-		UpdateCollection:         "UPDATE collection SET description = $1, owner = $2, owner_mail= $3, name = $4, quality = $5, tenant_id= $6 where id = $7",
-		CreateCollection: "INSERT INTO collection(alias, description, owner, owner_mail, name, quality, tenant_id)" +
-			" VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id",
-		GetSizeForAllObjectInstancesByCollectionId: "select sum(oi.size) from object o" +
-			" left join  object_instance oi" +
-			" on o.id = oi.object_id" +
-			" where o.collection_id = $1",
-		GetAmountOfObjectsInCollection: `SELECT count(*) FROM object where collection_id = $1`,
-		GetExistingStorageLocationsCombinationsForCollectionId: `SELECT col.id, col.alias, ol.locations, ol.quality, ol.price, SUM(ol.size) AS size 
-		FROM 
-			collection col, 
-			obj_loc ol
-		WHERE 
-			col.id=ol.collection_id
-		AND
-		    col.id=$1
-		GROUP BY col.id, col.alias, ol.locations, ol.quality, ol.price
-		ORDER BY size DESC`,
-	}
-	for name, sqlStm := range preparedStatements {
-		if _, err := conn.Prepare(ctx, name, sqlStm); err != nil {
-			return errors.Wrapf(err, "cannot prepare statement '%s' - '%s'", name, sqlStm)
-		}
-	}
-	return nil
+	return prepareStatements(ctx, conn, collectionPreparedStatements)
 }
 
-func (c *CollectionRepositoryImpl) GetSizeForAllObjectInstancesByCollectionId(id string) (int64, error) {
-	row := c.Db.QueryRow(context.Background(), GetSizeForAllObjectInstancesByCollectionId, id)
+func (c *CollectionRepositoryImpl) GetSizeForAllObjectInstancesByCollectionId(ctx context.Context, id string) (int64, error) {
+	row := c.Db.QueryRow(ctx, GetSizeForAllObjectInstancesByCollectionId, id)
 	var size zeronull.Int8
 	err := row.Scan(&size)
 	if err != nil {
@@ -79,9 +78,17 @@ func (c *CollectionRepositoryImpl) GetSizeForAllObjectInstancesByCollectionId(id
 	return int64(size), nil
 }
 
-func (c *CollectionRepositoryImpl) CreateCollection(collection models.Collection) (string, error) {
+func (c *CollectionRepositoryImpl) CreateCollection(ctx context.Context, collection models.Collection) (string, error) {
+	return createCollection(ctx, c.Db, collection)
+}
 
-	row := c.Db.QueryRow(context.Background(), CreateCollection, collection.Alias, collection.Description, collection.Owner, collection.OwnerMail, collection.Name,
+func (c *CollectionRepositoryImpl) CreateCollectionTx(ctx context.Context, tx pgx.Tx, collection models.Collection) (string, error) {
+	return createCollection(ctx, tx, collection)
+}
+
+func createCollection(ctx context.Context, db querier, collection models.Collection) (string, error) {
+
+	row := db.QueryRow(ctx, CreateCollection, collection.Alias, collection.Description, collection.Owner, collection.OwnerMail, collection.Name,
 		collection.Quality, collection.TenantId)
 
 	var id string
@@ -92,16 +99,32 @@ func (c *CollectionRepositoryImpl) CreateCollection(collection models.Collection
 	return id, nil
 }
 
-func (c *CollectionRepositoryImpl) DeleteCollectionById(id string) error {
-	_, err := c.Db.Exec(context.Background(), DeleteCollectionById, id)
+func (c *CollectionRepositoryImpl) DeleteCollectionById(ctx context.Context, id string) error {
+	return deleteCollectionById(ctx, c.Db, id)
+}
+
+func (c *CollectionRepositoryImpl) DeleteCollectionByIdTx(ctx context.Context, tx pgx.Tx, id string) error {
+	return deleteCollectionById(ctx, tx, id)
+}
+
+func deleteCollectionById(ctx context.Context, db querier, id string) error {
+	_, err := db.Exec(ctx, DeleteCollectionById, id)
 	if err != nil {
 		return errors.Wrapf(err, "Could not execute query in method: %v", DeleteCollectionById)
 	}
 	return nil
 }
 
-func (c *CollectionRepositoryImpl) UpdateCollection(collection models.Collection) error {
-	_, err := c.Db.Exec(context.Background(), UpdateCollection, collection.Description, collection.Owner, collection.OwnerMail, collection.Name,
+func (c *CollectionRepositoryImpl) UpdateCollection(ctx context.Context, collection models.Collection) error {
+	return updateCollection(ctx, c.Db, collection)
+}
+
+func (c *CollectionRepositoryImpl) UpdateCollectionTx(ctx context.Context, tx pgx.Tx, collection models.Collection) error {
+	return updateCollection(ctx, tx, collection)
+}
+
+func updateCollection(ctx context.Context, db querier, collection models.Collection) error {
+	_, err := db.Exec(ctx, UpdateCollection, collection.Description, collection.Owner, collection.OwnerMail, collection.Name,
 		collection.Quality, collection.TenantId, collection.Id)
 	if err != nil {
 		return errors.Wrapf(err, "Could not execute query in method: %v", UpdateCollection)
@@ -109,8 +132,8 @@ func (c *CollectionRepositoryImpl) UpdateCollection(collection models.Collection
 	return nil
 }
 
-func (c *CollectionRepositoryImpl) GetCollectionsByTenantId(tenantId string) ([]models.Collection, error) {
-	rows, err := c.Db.Query(context.Background(), GetCollectionsByTenantId, tenantId)
+func (c *CollectionRepositoryImpl) GetCollectionsByTenantId(ctx context.Context, tenantId string) ([]models.Collection, error) {
+	rows, err := c.Db.Query(ctx, GetCollectionsByTenantId, tenantId)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Could not execute query for method: %v", GetCollectionsByTenantId)
 	}
@@ -129,8 +152,8 @@ func (c *CollectionRepositoryImpl) GetCollectionsByTenantId(tenantId string) ([]
 	return collections, nil
 }
 
-func (c *CollectionRepositoryImpl) GetAmountOfObjectsInCollection(id string) (int64, error) {
-	row := c.Db.QueryRow(context.Background(), GetAmountOfObjectsInCollection, id)
+func (c *CollectionRepositoryImpl) GetAmountOfObjectsInCollection(ctx context.Context, id string) (int64, error) {
+	row := c.Db.QueryRow(ctx, GetAmountOfObjectsInCollection, id)
 	var amount zeronull.Int8
 	err := row.Scan(&amount)
 	if err != nil {
@@ -139,8 +162,8 @@ func (c *CollectionRepositoryImpl) GetAmountOfObjectsInCollection(id string) (in
 	return int64(amount), nil
 }
 
-func (c *CollectionRepositoryImpl) GetExistingStorageLocationsCombinationsForCollectionId(id string) ([]models.CollectionWithExistingStorageLocationsCombinations, error) {
-	rows, err := c.Db.Query(context.Background(), GetExistingStorageLocationsCombinationsForCollectionId, id)
+func (c *CollectionRepositoryImpl) GetExistingStorageLocationsCombinationsForCollectionId(ctx context.Context, id string) ([]models.CollectionWithExistingStorageLocationsCombinations, error) {
+	rows, err := c.Db.Query(ctx, GetExistingStorageLocationsCombinationsForCollectionId, id)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Could not execute query for method: %v", GetExistingStorageLocationsCombinationsForCollectionId)
 	}
@@ -158,8 +181,8 @@ func (c *CollectionRepositoryImpl) GetExistingStorageLocationsCombinationsForCol
 	return collections, nil
 }
 
-func (c *CollectionRepositoryImpl) GetCollectionIdByAlias(alias string) (string, error) {
-	row := c.Db.QueryRow(context.Background(), GetCollectionIdByAlias, alias)
+func (c *CollectionRepositoryImpl) GetCollectionIdByAlias(ctx context.Context, alias string) (string, error) {
+	row := c.Db.QueryRow(ctx, GetCollectionIdByAlias, alias)
 	var id string
 
 	err := row.Scan(&id)
@@ -170,19 +193,31 @@ func (c *CollectionRepositoryImpl) GetCollectionIdByAlias(alias string) (string,
 	return id, nil
 }
 
-func (c *CollectionRepositoryImpl) GetCollectionById(id string) (models.Collection, error) {
-	row := c.Db.QueryRow(context.Background(), GetCollectionById, id)
+func (c *CollectionRepositoryImpl) GetCollectionById(ctx context.Context, id string) (models.Collection, error) {
+	return getCollectionById(ctx, c.Db, GetCollectionById, id)
+}
+
+func (c *CollectionRepositoryImpl) GetCollectionByIdTx(ctx context.Context, tx pgx.Tx, id string) (models.Collection, error) {
+	return getCollectionById(ctx, tx, GetCollectionByIdForUpdate, id)
+}
+
+func getCollectionById(ctx context.Context, db querier, statement string, id string) (models.Collection, error) {
+	row := db.QueryRow(ctx, statement, id)
 	collection := models.Collection{}
 	err := row.Scan(&collection.Alias, &collection.Description, &collection.Owner, &collection.OwnerMail, &collection.Name,
 		&collection.Quality, &collection.TenantId, &collection.Id)
 	if err != nil {
-		return collection, errors.Wrapf(err, "Could not execute query in method: %v", GetCollectionById)
+		return collection, errors.Wrapf(err, "Could not execute query in method: %v", statement)
 	}
 	return collection, nil
 }
 
-func (c *CollectionRepositoryImpl) GetCollectionByIdFromMv(id string) (models.Collection, error) {
-	row := c.Db.QueryRow(context.Background(), GetCollectionByIdFromMv, id)
+func (c *CollectionRepositoryImpl) GetCollectionByIdFromMv(ctx context.Context, id string, fresh bool) (models.Collection, error) {
+	query := GetCollectionByIdFromMv
+	if fresh {
+		query = "SELECT * FROM " + materializedView(MatCollObjFile, "", true) + " where id = $1"
+	}
+	row := c.Db.QueryRow(ctx, query, id)
 	collection := models.Collection{}
 	var totalFileSize zeronull.Int8
 	var totalFileCount zeronull.Int8
@@ -198,8 +233,8 @@ func (c *CollectionRepositoryImpl) GetCollectionByIdFromMv(id string) (models.Co
 	return collection, nil
 }
 
-func (c *CollectionRepositoryImpl) GetCollectionByAlias(alias string) (models.Collection, error) {
-	row := c.Db.QueryRow(context.Background(), GetCollectionByAlias, alias)
+func (c *CollectionRepositoryImpl) GetCollectionByAlias(ctx context.Context, alias string) (models.Collection, error) {
+	row := c.Db.QueryRow(ctx, GetCollectionByAlias, alias)
 	collection := models.Collection{}
 	err := row.Scan(&collection.Alias, &collection.Description, &collection.Owner, &collection.OwnerMail, &collection.Name,
 		&collection.Quality, &collection.TenantId, &collection.Id)
@@ -209,63 +244,50 @@ func (c *CollectionRepositoryImpl) GetCollectionByAlias(alias string) (models.Co
 	return collection, nil
 }
 
-func (c *CollectionRepositoryImpl) GetCollectionsByTenantIdPaginated(pagination models.Pagination) ([]models.Collection, int, error) {
-	firstCondition := ""
-	secondCondition := ""
-	if pagination.Id == "" {
-		if len(pagination.AllowedTenants) != 0 {
-			tenants := strings.Join(pagination.AllowedTenants, "','")
-			firstCondition = fmt.Sprintf("where tenant_id in ('%s')", tenants)
-		}
-	} else {
-		firstCondition = fmt.Sprintf("where tenant_id = '%s'", pagination.Id)
-		if len(pagination.AllowedTenants) != 0 {
-			tenants := strings.Join(pagination.AllowedTenants, "','")
-			secondCondition = fmt.Sprintf("and tenant_id in ('%s')", tenants)
-		}
+func (c *CollectionRepositoryImpl) GetCollectionsByTenantIdPaginated(ctx context.Context, pagination models.Pagination, fresh bool) ([]models.Collection, int, error) {
+	var builder queryBuilder
+	if pagination.Id != "" {
+		builder.whereEquals("tenant_id", pagination.Id)
 	}
+	if len(pagination.AllowedTenants) != 0 {
+		builder.whereIn("tenant_id", pagination.AllowedTenants)
+	}
+	getLikeQueryForCollection(&builder, pagination.SearchField)
 
-	query := fmt.Sprintf("SELECT *, count(*) over() FROM mat_coll_obj_file"+
-		" %s %s %s order by %s %s limit %s OFFSET %s ", firstCondition, secondCondition, getLikeQueryForCollection(pagination.SearchField, firstCondition, secondCondition),
-		pagination.SortKey, pagination.SortDirection, strconv.Itoa(pagination.Take), strconv.Itoa(pagination.Skip))
-
-	rows, err := c.Db.Query(context.Background(), query)
+	query := "SELECT * FROM " + materializedView(MatCollObjFile, "", fresh) + builder.whereClause()
+	page, args, err := builder.page(pagination, collectionSortColumns)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "Could not build query for method: GetCollectionsByTenantIdPaginated")
+	}
+	rows, err := c.Db.Query(ctx, query+page, args...)
 	if err != nil {
-		return nil, 0, errors.Wrapf(err, "Could not execute query: %v", query)
+		return nil, 0, errors.Wrapf(err, "Could not execute query: %v", query+page)
 	}
 	defer rows.Close()
 	var collections []models.Collection
-	var totalItems int
 	for rows.Next() {
 		var collection models.Collection
 		var totalFileSize zeronull.Int8
 		var totalFileCount zeronull.Int8
 		var totalObjectCount zeronull.Int8
 		err = rows.Scan(&collection.Alias, &collection.Description, &collection.Owner, &collection.OwnerMail, &collection.Name,
-			&collection.Quality, &collection.TenantId, &collection.Id, &totalFileSize, &totalFileCount, &totalObjectCount, &totalItems)
+			&collection.Quality, &collection.TenantId, &collection.Id, &totalFileSize, &totalFileCount, &totalObjectCount)
 
 		if err != nil {
-			return nil, 0, errors.Wrapf(err, "Could not scan rows for query: %v", query)
+			return nil, 0, errors.Wrapf(err, "Could not scan rows for query: %v", query+page)
 		}
 		collection.TotalFileSize = int64(totalFileSize)
 		collection.TotalFileCount = int64(totalFileCount)
 		collection.TotalObjectCount = int64(totalObjectCount)
 		collections = append(collections, collection)
 	}
+	totalItems, err := builder.count(ctx, c.Db, query)
+	if err != nil {
+		return nil, 0, err
+	}
 	return collections, totalItems, nil
 }
 
-func getLikeQueryForCollection(searchKey string, firstCondition string, secondCondition string) string {
-	if searchKey != "" {
-		condition := ""
-		if firstCondition == "" && secondCondition == "" {
-			condition = "where"
-		} else {
-			condition = "and"
-		}
-		return condition + strings.Replace(" (id::text like '_search_key_%' or lower(alias) like '%_search_key_%'"+
-			" or lower(name) like '%_search_key_%' or lower(owner_mail) like '%_search_key_%' or lower(owner) like '%_search_key_%' or lower(description) like '%_search_key_%')",
-			"_search_key_", searchKey, -1)
-	}
-	return ""
+func getLikeQueryForCollection(builder *queryBuilder, searchKey string) {
+	builder.whereSearch(searchKey, []string{"id"}, []string{"alias", "name", "owner_mail", "owner", "description"})
 }
@@ -1,5 +1,7 @@
 package repository
 
+import "context"
+
 type DispatcherRepository interface {
-	GetLowQualityCollectionsWithObjectIds() (map[string][]string, error)
+	GetLowQualityCollectionsWithObjectIds(ctx context.Context) (map[string][]string, error)
 }
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"net"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Kinds of errors the handlers report with their own status code. They are wrapped with
+// the details of the failing call, errors.Is finds them in the chain.
+const (
+	ErrNotFound           = errors.Sentinel("not found")
+	ErrAlreadyExists      = errors.Sentinel("already exists")
+	ErrInvalidArgument    = errors.Sentinel("invalid argument")
+	ErrFailedPrecondition = errors.Sentinel("failed precondition")
+	ErrUnavailable        = errors.Sentinel("database unavailable")
+	// ErrAborted is a transaction rolled back by a serialization failure or a deadlock, the
+	// call can be retried as a whole.
+	ErrAborted = errors.Sentinel("transaction aborted")
+)
+
+var errorKinds = []error{ErrNotFound, ErrAlreadyExists, ErrInvalidArgument, ErrFailedPrecondition, ErrUnavailable, ErrAborted}
+
+// ErrorKind returns the kind of the error, derived from the errors of postgres and of
+// the driver if no kind is wrapped explicitly. It returns nil for all other errors.
+func ErrorKind(err error) error {
+	if err == nil {
+		return nil
+	}
+	for _, kind := range errorKinds {
+		if errors.Is(err, kind) {
+			return kind
+		}
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrNotFound
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch {
+		case pgErr.Code == "23505":
+			return ErrAlreadyExists
+		case pgErr.Code == "23503":
+			return ErrFailedPrecondition
+		case pgErr.Code == "23502", pgErr.Code == "23514", strings.HasPrefix(pgErr.Code, "22"):
+			return ErrInvalidArgument
+		case strings.HasPrefix(pgErr.Code, "40"):
+			return ErrAborted
+		case strings.HasPrefix(pgErr.Code, "08"), strings.HasPrefix(pgErr.Code, "53"), strings.HasPrefix(pgErr.Code, "57P"):
+			return ErrUnavailable
+		}
+		return nil
+	}
+	var connectErr *pgconn.ConnectError
+	var netErr net.Error
+	if errors.As(err, &connectErr) || errors.As(err, &netErr) || pgconn.SafeToRetry(err) {
+		return ErrUnavailable
+	}
+	return nil
+}
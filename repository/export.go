@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"strconv"
+)
+
+// exportFetchSize is the number of rows fetched from an export cursor at once. It
+// bounds the memory an export holds, independently of the size of the collection.
+const exportFetchSize = 1000
+
+// exportRows declares a cursor for the query in a read only transaction and calls
+// scan for every row, fetching exportFetchSize rows at a time. An error returned by
+// scan stops the export and closes the cursor.
+func exportRows(ctx context.Context, db *pgxpool.Pool, query string, args []any, scan func(rows pgx.Rows) error) error {
+	tx, err := db.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return errors.Wrapf(err, "Could not begin transaction for export")
+	}
+	defer tx.Rollback(context.Background())
+
+	if _, err := tx.Exec(ctx, "DECLARE export NO SCROLL CURSOR FOR "+query, args...); err != nil {
+		return errors.Wrapf(err, "Could not declare cursor for query: %s", query)
+	}
+	fetch := "FETCH FORWARD " + strconv.Itoa(exportFetchSize) + " FROM export"
+	for {
+		rows, err := tx.Query(ctx, fetch)
+		if err != nil {
+			return errors.Wrapf(err, "Could not fetch from cursor for query: %s", query)
+		}
+		fetched := 0
+		for rows.Next() {
+			fetched++
+			if err := scan(rows); err != nil {
+				rows.Close()
+				return err
+			}
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return errors.Wrapf(err, "Could not fetch from cursor for query: %s", query)
+		}
+		if fetched < exportFetchSize {
+			break
+		}
+	}
+	return tx.Commit(ctx)
+}
@@ -1,13 +1,19 @@
 package repository
 
-import "github.com/ocfl-archive/dlza-manager/models"
+import (
+	"context"
+	"github.com/ocfl-archive/dlza-manager/models"
+)
 
 type FileRepository interface {
-	CreateFile(file models.File) error
-	DeleteFile(id string) error
-	GetFileById(id string) (models.File, error)
-	GetFilesByObjectIdPaginated(pagination models.Pagination) ([]models.File, int, error)
-	GetFilesByCollectionIdPaginated(pagination models.Pagination) ([]models.File, int, error)
-	GetMimeTypesForCollectionId(pagination models.Pagination) ([]models.MimeType, int, error)
-	GetPronomsForCollectionId(pagination models.Pagination) ([]models.Pronom, int, error)
+	CreateFile(ctx context.Context, file models.File) error
+	DeleteFile(ctx context.Context, id string) error
+	GetFileById(ctx context.Context, id string) (models.File, error)
+	GetFilesByObjectIdPaginated(ctx context.Context, pagination models.Pagination) ([]models.File, int, error)
+	GetFilesByCollectionIdPaginated(ctx context.Context, pagination models.Pagination) ([]models.File, int, error)
+	GetFilesByObjectIdKeyset(ctx context.Context, pagination KeysetPagination) ([]models.File, string, int, error)
+	GetFilesByCollectionIdKeyset(ctx context.Context, pagination KeysetPagination) ([]models.File, string, int, error)
+	ExportFilesByCollectionId(ctx context.Context, collectionId string, send func(models.File) error) error
+	GetMimeTypesForCollectionId(ctx context.Context, pagination models.Pagination, fresh bool) ([]models.MimeType, int, error)
+	GetPronomsForCollectionId(ctx context.Context, pagination models.Pagination, fresh bool) ([]models.Pronom, int, error)
 }
@@ -3,13 +3,10 @@ package repository
 import (
 	"context"
 	"emperror.dev/errors"
-	"fmt"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype/zeronull"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/ocfl-archive/dlza-manager/models"
-	"slices"
-	"strconv"
 	"strings"
 )
 
@@ -20,6 +17,34 @@ const (
 	UNKNOWN     = "UNKNOWN"
 )
 
+// fileListing selects the files together with the tables their tenant is checked on.
+const fileListing = "SELECT f.* FROM FILE f" +
+	" inner join object o on f.object_id = o.id" +
+	" inner join collection c on c.id = o.collection_id" +
+	" inner join tenant t on t.id = c.tenant_id"
+
+var fileSortColumns = newSortColumns("name", "f.id", columnsWithPrefix("f.", "checksum", "name", "size", "mime_type",
+	"pronom", "width", "height", "duration", "id", "object_id"))
+
+// fileKeysetColumns refer to the output columns of fileListing. Nullable columns are
+// coalesced, because the seek compares row values.
+var fileKeysetColumns = keysetColumns{
+	columns: map[string]keysetColumn{
+		"name":      {expression: "coalesce(name::text, '')", sqlType: "text"},
+		"checksum":  {expression: "coalesce(checksum, '')", sqlType: "text"},
+		"size":      {expression: "size", sqlType: "bigint"},
+		"mime_type": {expression: "coalesce(mime_type, '')", sqlType: "text"},
+		"pronom":    {expression: "coalesce(pronom, '')", sqlType: "text"},
+		"id":        {expression: "id", sqlType: "uuid"},
+	},
+	fallback: "name",
+	id:       "id",
+}
+
+// fileFormatSortColumns are the sort keys of the mime type and pronom statistics,
+// where id is the mime type or pronom itself.
+var fileFormatSortColumns = newSortColumns("id", "id", columnsWithPrefix("", "id", "file_count", "files_size"))
+
 type FileRepositoryImpl struct {
 	Db *pgxpool.Pool
 }
@@ -30,27 +55,22 @@ func NewFileRepository(db *pgxpool.Pool) FileRepository {
 	}
 }
 
-func CreateFilePreparedStatements(ctx context.Context, conn *pgx.Conn) error {
+var filePreparedStatements = map[string]string{
+	CreateFile:  "insert into File(checksum, \"name\", \"size\", mime_type, pronom, width, height, duration, object_id) values($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+	DeleteFile:  "DELETE FROM File WHERE id = $1",
+	GetFileById: "SELECT * FROM FILE WHERE id = $1",
+}
 
-	preparedStatements := map[string]string{
-		CreateFile:  "insert into File(checksum, \"name\", \"size\", mime_type, pronom, width, height, duration, object_id) values($1, $2, $3, $4, $5, $6, $7, $8, $9)",
-		DeleteFile:  "DELETE FROM File WHERE id = $1",
-		GetFileById: "SELECT * FROM FILE WHERE id = $1",
-	}
-	for name, sqlStm := range preparedStatements {
-		if _, err := conn.Prepare(ctx, name, sqlStm); err != nil {
-			return errors.Wrapf(err, "cannot prepare statement '%s' - '%s'", name, sqlStm)
-		}
-	}
-	return nil
+func CreateFilePreparedStatements(ctx context.Context, conn *pgx.Conn) error {
+	return prepareStatements(ctx, conn, filePreparedStatements)
 }
 
-func (f *FileRepositoryImpl) GetFileById(id string) (models.File, error) {
+func (f *FileRepositoryImpl) GetFileById(ctx context.Context, id string) (models.File, error) {
 	var file models.File
 	var width zeronull.Int8
 	var height zeronull.Int8
 	var duration zeronull.Int8
-	err := f.Db.QueryRow(context.Background(), GetFileById, id).Scan(&file.Checksum, &file.Name, &file.Size, &file.MimeType,
+	err := f.Db.QueryRow(ctx, GetFileById, id).Scan(&file.Checksum, &file.Name, &file.Size, &file.MimeType,
 		&file.Pronom, &width, &height, &duration, &file.Id, &file.ObjectId)
 	if err != nil {
 		return file, errors.Wrapf(err, "Could not execute query for method: %v", GetFileById)
@@ -61,54 +81,45 @@ func (f *FileRepositoryImpl) GetFileById(id string) (models.File, error) {
 	return file, nil
 }
 
-func (f *FileRepositoryImpl) DeleteFile(id string) error {
-	_, err := f.Db.Exec(context.Background(), DeleteFile, id)
+func (f *FileRepositoryImpl) DeleteFile(ctx context.Context, id string) error {
+	_, err := f.Db.Exec(ctx, DeleteFile, id)
 	if err != nil {
 		return errors.Wrapf(err, "Could not execute query for method: %v", DeleteFile)
 	}
 	return nil
 }
 
-func (f *FileRepositoryImpl) CreateFile(file models.File) error {
-	_, err := f.Db.Exec(context.Background(), CreateFile, file.Checksum, file.Name, file.Size, file.MimeType, file.Pronom, file.Width, file.Height, file.Duration, file.ObjectId)
+func (f *FileRepositoryImpl) CreateFile(ctx context.Context, file models.File) error {
+	_, err := f.Db.Exec(ctx, CreateFile, file.Checksum, file.Name, file.Size, file.MimeType, file.Pronom, file.Width, file.Height, file.Duration, file.ObjectId)
 	if err != nil {
 		return errors.Wrapf(err, "Could not execute query for method: %v", CreateFile)
 	}
 	return nil
 }
 
-func (f *FileRepositoryImpl) GetFilesByObjectIdPaginated(pagination models.Pagination) ([]models.File, int, error) {
-	firstCondition := ""
-	secondCondition := ""
-	if pagination.SecondId != "" {
-		if len(pagination.AllowedTenants) != 0 && slices.Contains(pagination.AllowedTenants, pagination.SecondId) {
-			pagination.AllowedTenants = []string{pagination.SecondId}
-		}
-		if len(pagination.AllowedTenants) == 0 {
-			pagination.AllowedTenants = []string{pagination.SecondId}
-		}
-	}
-	if pagination.Id == "" {
-		if len(pagination.AllowedTenants) != 0 {
-			tenants := strings.Join(pagination.AllowedTenants, "','")
-			firstCondition = fmt.Sprintf("where t.id in ('%s')", tenants)
-		}
-	} else {
-		firstCondition = fmt.Sprintf("where f.object_id = '%s'", pagination.Id)
-		if len(pagination.AllowedTenants) != 0 {
-			tenants := strings.Join(pagination.AllowedTenants, "','")
-			secondCondition = fmt.Sprintf("and t.id in ('%s')", tenants)
-		}
-	}
+func (f *FileRepositoryImpl) GetFilesByObjectIdPaginated(ctx context.Context, pagination models.Pagination) ([]models.File, int, error) {
+	var builder queryBuilder
+	builder.whereTenantScope(pagination, "f.object_id", "t.id")
+	getLikeQueryForFile(&builder, pagination.SearchField)
+	return f.getFilesPaginated(ctx, builder, pagination)
+}
+
+func (f *FileRepositoryImpl) GetFilesByCollectionIdPaginated(ctx context.Context, pagination models.Pagination) ([]models.File, int, error) {
+	var builder queryBuilder
+	builder.whereTenantScope(pagination, "c.id", "t.id")
+	getLikeQueryForFile(&builder, pagination.SearchField)
+	return f.getFilesPaginated(ctx, builder, pagination)
+}
 
-	query := fmt.Sprintf("SELECT f.* FROM FILE f"+
-		" inner join object o on f.object_id = o.id"+
-		" inner join collection c on c.id = o.collection_id"+
-		" inner join tenant t on t.id = c.tenant_id"+
-		" %s %s %s order by %s %s limit %s OFFSET %s ", firstCondition, secondCondition, getLikeQueryForFile(pagination.SearchField, firstCondition, secondCondition), "f."+pagination.SortKey, pagination.SortDirection, strconv.Itoa(pagination.Take), strconv.Itoa(pagination.Skip))
-	rows, err := f.Db.Query(context.Background(), query)
+func (f *FileRepositoryImpl) getFilesPaginated(ctx context.Context, builder queryBuilder, pagination models.Pagination) ([]models.File, int, error) {
+	query := fileListing + builder.whereClause()
+	page, args, err := builder.page(pagination, fileSortColumns)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "Could not build query for files")
+	}
+	rows, err := f.Db.Query(ctx, query+page, args...)
 	if err != nil {
-		return nil, 0, errors.Wrapf(err, "Could not execute query: %s", query)
+		return nil, 0, errors.Wrapf(err, "Could not execute query: %s", query+page)
 	}
 	defer rows.Close()
 	var files []models.File
@@ -120,133 +131,109 @@ func (f *FileRepositoryImpl) GetFilesByObjectIdPaginated(pagination models.Pagin
 		err := rows.Scan(&file.Checksum, &file.Name, &file.Size, &file.MimeType,
 			&file.Pronom, &width, &height, &duration, &file.Id, &file.ObjectId)
 		if err != nil {
-			return nil, 0, errors.Wrapf(err, "Could not scan rows for query: %s", query)
+			return nil, 0, errors.Wrapf(err, "Could not scan rows for query: %s", query+page)
 		}
 		file.Width = int64(width)
 		file.Height = int64(height)
 		file.Duration = int64(duration)
 		files = append(files, file)
 	}
-	countQuery := fmt.Sprintf("SELECT count(*) as total_items FROM FILE f"+
-		" inner join object o on f.object_id = o.id"+
-		" inner join collection c on c.id = o.collection_id"+
-		" inner join tenant t on t.id = c.tenant_id"+
-		" %s %s %s ", firstCondition, secondCondition, getLikeQueryForFile(pagination.SearchField, firstCondition, secondCondition))
-	var totalItems int
-	countRow := f.Db.QueryRow(context.Background(), countQuery)
-	err = countRow.Scan(&totalItems)
+	totalItems, err := builder.count(ctx, f.Db, query)
 	if err != nil {
-		return nil, 0, errors.Wrapf(err, "Could not scan countRow for query: %s", countQuery)
+		return nil, 0, err
 	}
 	return files, totalItems, nil
 }
 
-func (f *FileRepositoryImpl) GetFilesByCollectionIdPaginated(pagination models.Pagination) ([]models.File, int, error) {
-	firstCondition := ""
-	secondCondition := ""
-	if pagination.SecondId != "" {
-		if len(pagination.AllowedTenants) != 0 && slices.Contains(pagination.AllowedTenants, pagination.SecondId) {
-			pagination.AllowedTenants = []string{pagination.SecondId}
-		}
-		if len(pagination.AllowedTenants) == 0 {
-			pagination.AllowedTenants = []string{pagination.SecondId}
-		}
-	}
-	if pagination.Id == "" {
-		if len(pagination.AllowedTenants) != 0 {
-			tenants := strings.Join(pagination.AllowedTenants, "','")
-			firstCondition = fmt.Sprintf("where t.id in ('%s')", tenants)
-		}
-	} else {
-		firstCondition = fmt.Sprintf("where c.id = '%s'", pagination.Id)
-		if len(pagination.AllowedTenants) != 0 {
-			tenants := strings.Join(pagination.AllowedTenants, "','")
-			secondCondition = fmt.Sprintf("and t.id in ('%s')", tenants)
-		}
-	}
+func (f *FileRepositoryImpl) GetFilesByObjectIdKeyset(ctx context.Context, pagination KeysetPagination) ([]models.File, string, int, error) {
+	var builder queryBuilder
+	builder.whereTenantScope(pagination.Pagination, "f.object_id", "t.id")
+	getLikeQueryForFile(&builder, pagination.SearchField)
+	return f.getFilesKeyset(ctx, builder, pagination)
+}
+
+func (f *FileRepositoryImpl) GetFilesByCollectionIdKeyset(ctx context.Context, pagination KeysetPagination) ([]models.File, string, int, error) {
+	var builder queryBuilder
+	builder.whereTenantScope(pagination.Pagination, "c.id", "t.id")
+	getLikeQueryForFile(&builder, pagination.SearchField)
+	return f.getFilesKeyset(ctx, builder, pagination)
+}
 
-	query := fmt.Sprintf("SELECT f.* FROM FILE f"+
-		" inner join object o on f.object_id = o.id"+
-		" inner join collection c on c.id = o.collection_id"+
-		" inner join tenant t on t.id = c.tenant_id"+
-		" %s %s %s order by %s %s limit %s OFFSET %s ", firstCondition, secondCondition, getLikeQueryForFile(pagination.SearchField, firstCondition, secondCondition), "f."+pagination.SortKey, pagination.SortDirection, strconv.Itoa(pagination.Take), strconv.Itoa(pagination.Skip))
-	rows, err := f.Db.Query(context.Background(), query)
+func (f *FileRepositoryImpl) getFilesKeyset(ctx context.Context, builder queryBuilder, pagination KeysetPagination) ([]models.File, string, int, error) {
+	query := fileListing + builder.whereClause()
+	keyset, err := builder.keyset(query, pagination, fileKeysetColumns)
+	if err != nil {
+		return nil, "", 0, errors.Wrapf(err, "Could not build keyset query for files")
+	}
+	rows, err := f.Db.Query(ctx, keyset.sql, keyset.args...)
 	if err != nil {
-		return nil, 0, errors.Wrapf(err, "Could not execute query: %s", query)
+		return nil, "", 0, errors.Wrapf(err, "Could not execute query: %s", keyset.sql)
 	}
 	defer rows.Close()
 	var files []models.File
+	var cursorValue string
+	fetched := 0
 	for rows.Next() {
+		fetched++
+		if keyset.hasNext(fetched) {
+			break
+		}
 		var file models.File
 		var width zeronull.Int8
 		var height zeronull.Int8
 		var duration zeronull.Int8
 		err := rows.Scan(&file.Checksum, &file.Name, &file.Size, &file.MimeType,
-			&file.Pronom, &width, &height, &duration, &file.Id, &file.ObjectId)
+			&file.Pronom, &width, &height, &duration, &file.Id, &file.ObjectId, &cursorValue)
 		if err != nil {
-			return nil, 0, errors.Wrapf(err, "Could not scan rows for query: %s", query)
+			return nil, "", 0, errors.Wrapf(err, "Could not scan rows for query: %s", keyset.sql)
 		}
 		file.Width = int64(width)
 		file.Height = int64(height)
 		file.Duration = int64(duration)
 		files = append(files, file)
 	}
-
-	countQuery := fmt.Sprintf("SELECT count(*) as total_items FROM FILE f"+
-		" inner join object o on f.object_id = o.id"+
-		" inner join collection c on c.id = o.collection_id"+
-		" inner join tenant t on t.id = c.tenant_id"+
-		" %s %s %s ", firstCondition, secondCondition, getLikeQueryForFile(pagination.SearchField, firstCondition, secondCondition))
-	var totalItems int
-	countRow := f.Db.QueryRow(context.Background(), countQuery)
-	err = countRow.Scan(&totalItems)
-	if err != nil {
-		return nil, 0, errors.Wrapf(err, "Could not scan countRow for query: %s", countQuery)
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, "", 0, errors.Wrapf(err, "Could not read rows for query: %s", keyset.sql)
 	}
-	return files, totalItems, nil
-}
-
-func (f *FileRepositoryImpl) GetMimeTypesForCollectionId(pagination models.Pagination) ([]models.MimeType, int, error) {
-	firstCondition := ""
-	secondCondition := ""
-	if pagination.SecondId != "" {
-		if len(pagination.AllowedTenants) != 0 && slices.Contains(pagination.AllowedTenants, pagination.SecondId) {
-			pagination.AllowedTenants = []string{pagination.SecondId}
-		}
-		if len(pagination.AllowedTenants) == 0 {
-			pagination.AllowedTenants = []string{pagination.SecondId}
-		}
+	var nextCursor string
+	if keyset.hasNext(fetched) {
+		nextCursor = keyset.cursorAfter(cursorValue, files[len(files)-1].Id)
 	}
-	if pagination.Id == "" {
-		if len(pagination.AllowedTenants) != 0 {
-			tenants := strings.Join(pagination.AllowedTenants, "','")
-			firstCondition = fmt.Sprintf("where tenant_id in ('%s')", tenants)
-		}
-	} else {
-		firstCondition = fmt.Sprintf("where collection_id = '%s'", pagination.Id)
-		if len(pagination.AllowedTenants) != 0 {
-			tenants := strings.Join(pagination.AllowedTenants, "','")
-			secondCondition = fmt.Sprintf("and tenant_id in ('%s')", tenants)
+	var totalItems int
+	if pagination.WithTotalItems {
+		totalItems, err = builder.count(ctx, f.Db, query)
+		if err != nil {
+			return nil, "", 0, err
 		}
 	}
+	return files, nextCursor, totalItems, nil
+}
+
+func (f *FileRepositoryImpl) GetMimeTypesForCollectionId(ctx context.Context, pagination models.Pagination, fresh bool) ([]models.MimeType, int, error) {
+	var builder queryBuilder
+	builder.whereTenantScope(pagination, "collection_id", "tenant_id")
 
-	query := fmt.Sprintf("SELECT mtfj.mime_type as id, count(mtfj.*) as file_count, sum(mtfj.size) as files_size,  count(*) OVER() FROM mat_tenant_file_join mtfj"+
-		" %s %s group by mtfj.mime_type order by %s %s limit %s OFFSET %s ", firstCondition, secondCondition, pagination.SortKey, pagination.SortDirection, strconv.Itoa(pagination.Take), strconv.Itoa(pagination.Skip))
-	rows, err := f.Db.Query(context.Background(), query)
+	query := "SELECT mtfj.mime_type as id, count(mtfj.*) as file_count, sum(mtfj.size) as files_size FROM " + materializedView(MatTenantFileJoin, "mtfj", fresh) +
+		builder.whereClause() + " group by mtfj.mime_type"
+	page, args, err := builder.page(pagination, fileFormatSortColumns)
 	if err != nil {
-		return nil, 0, errors.Wrapf(err, "Could not execute query: %v", query)
+		return nil, 0, errors.Wrapf(err, "Could not build query for method: GetMimeTypesForCollectionId")
+	}
+	rows, err := f.Db.Query(ctx, query+page, args...)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "Could not execute query: %v", query+page)
 	}
 	defer rows.Close()
-	var totalItems int
 	mimeTypes := make([]models.MimeType, 0)
 	emptyMimeType := models.MimeType{}
 	notLast := rows.Next()
 	for notLast {
 		mimeType := models.MimeType{}
 		var id zeronull.Text
-		err := rows.Scan(&id, &mimeType.FileCount, &mimeType.FilesSize, &totalItems)
+		err := rows.Scan(&id, &mimeType.FileCount, &mimeType.FilesSize)
 		if err != nil {
-			return nil, 0, errors.Wrapf(err, "Could not scan rows for query: %s", query)
+			return nil, 0, errors.Wrapf(err, "Could not scan rows for query: %s", query+page)
 		}
 		mimeType.Id = string(id)
 		if mimeType.Id == "" {
@@ -268,50 +255,37 @@ func (f *FileRepositoryImpl) GetMimeTypesForCollectionId(pagination models.Pagin
 			mimeTypes = append(mimeTypes, emptyMimeType)
 		}
 	}
+	totalItems, err := builder.count(ctx, f.Db, query)
+	if err != nil {
+		return nil, 0, err
+	}
 	return mimeTypes, totalItems, nil
 }
 
-func (f *FileRepositoryImpl) GetPronomsForCollectionId(pagination models.Pagination) ([]models.Pronom, int, error) {
-	firstCondition := ""
-	secondCondition := ""
-	if pagination.SecondId != "" {
-		if len(pagination.AllowedTenants) != 0 && slices.Contains(pagination.AllowedTenants, pagination.SecondId) {
-			pagination.AllowedTenants = []string{pagination.SecondId}
-		}
-		if len(pagination.AllowedTenants) == 0 {
-			pagination.AllowedTenants = []string{pagination.SecondId}
-		}
-	}
-	if pagination.Id == "" {
-		if len(pagination.AllowedTenants) != 0 {
-			tenants := strings.Join(pagination.AllowedTenants, "','")
-			firstCondition = fmt.Sprintf("where tenant_id in ('%s')", tenants)
-		}
-	} else {
-		firstCondition = fmt.Sprintf("where collection_id = '%s'", pagination.Id)
-		if len(pagination.AllowedTenants) != 0 {
-			tenants := strings.Join(pagination.AllowedTenants, "','")
-			secondCondition = fmt.Sprintf("and tenant_id in ('%s')", tenants)
-		}
-	}
+func (f *FileRepositoryImpl) GetPronomsForCollectionId(ctx context.Context, pagination models.Pagination, fresh bool) ([]models.Pronom, int, error) {
+	var builder queryBuilder
+	builder.whereTenantScope(pagination, "collection_id", "tenant_id")
 
-	query := fmt.Sprintf("SELECT mtfj.pronom as id, count(mtfj.*) as file_count, sum(mtfj.size) as files_size, count(*) OVER() FROM mat_tenant_file_join mtfj"+
-		" %s %s group by mtfj.pronom order by %s %s limit %s OFFSET %s ", firstCondition, secondCondition, pagination.SortKey, pagination.SortDirection, strconv.Itoa(pagination.Take), strconv.Itoa(pagination.Skip))
-	rows, err := f.Db.Query(context.Background(), query)
+	query := "SELECT mtfj.pronom as id, count(mtfj.*) as file_count, sum(mtfj.size) as files_size FROM " + materializedView(MatTenantFileJoin, "mtfj", fresh) +
+		builder.whereClause() + " group by mtfj.pronom"
+	page, args, err := builder.page(pagination, fileFormatSortColumns)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "Could not build query for method: GetPronomsForCollectionId")
+	}
+	rows, err := f.Db.Query(ctx, query+page, args...)
 	if err != nil {
-		return nil, 0, errors.Wrapf(err, "Could not execute query: %s", query)
+		return nil, 0, errors.Wrapf(err, "Could not execute query: %s", query+page)
 	}
 	defer rows.Close()
-	var totalItems int
 	pronoms := make([]models.Pronom, 0)
 	emptyPronom := models.Pronom{}
 	notLast := rows.Next()
 	for notLast {
 		pronom := models.Pronom{}
 		var id zeronull.Text
-		err := rows.Scan(&id, &pronom.FileCount, &pronom.FilesSize, &totalItems)
+		err := rows.Scan(&id, &pronom.FileCount, &pronom.FilesSize)
 		if err != nil {
-			return nil, 0, errors.Wrapf(err, "Could not scan rows for query: %s", query)
+			return nil, 0, errors.Wrapf(err, "Could not scan rows for query: %s", query+page)
 		}
 		pronomWithoutSpaces := strings.Replace(string(id), " ", "", -1)
 		pronom.Id = pronomWithoutSpaces
@@ -334,20 +308,32 @@ func (f *FileRepositoryImpl) GetPronomsForCollectionId(pagination models.Paginat
 			pronoms = append(pronoms, emptyPronom)
 		}
 	}
+	totalItems, err := builder.count(ctx, f.Db, query)
+	if err != nil {
+		return nil, 0, err
+	}
 	return pronoms, totalItems, nil
 }
 
-func getLikeQueryForFile(searchKey string, firstCondition string, secondCondition string) string {
-	if searchKey != "" {
-		condition := ""
-		if firstCondition == "" && secondCondition == "" {
-			condition = "where"
-		} else {
-			condition = "and"
+func getLikeQueryForFile(builder *queryBuilder, searchKey string) {
+	builder.whereSearch(searchKey, []string{"f.id"}, []string{"f.name", "f.checksum", "f.pronom", "f.mime_type"})
+}
+
+func (f *FileRepositoryImpl) ExportFilesByCollectionId(ctx context.Context, collectionId string, send func(models.File) error) error {
+	query := "SELECT f.* FROM FILE f inner join object o on o.id = f.object_id where o.collection_id = $1"
+	return exportRows(ctx, f.Db, query, []any{collectionId}, func(rows pgx.Rows) error {
+		var file models.File
+		var width zeronull.Int8
+		var height zeronull.Int8
+		var duration zeronull.Int8
+		err := rows.Scan(&file.Checksum, &file.Name, &file.Size, &file.MimeType,
+			&file.Pronom, &width, &height, &duration, &file.Id, &file.ObjectId)
+		if err != nil {
+			return errors.Wrapf(err, "Could not scan rows for query: %s", query)
 		}
-		return condition + strings.Replace(" (f.id::text like '_search_key_%' or lower(f.name::text) like '%_search_key_%' or f.checksum like '%_search_key_%'"+
-			" or lower(f.pronom) like '%_search_key_%' or lower(f.mime_type) like '%_search_key_%')",
-			"_search_key_", searchKey, -1)
-	}
-	return ""
+		file.Width = int64(width)
+		file.Height = int64(height)
+		file.Duration = int64(duration)
+		return send(file)
+	})
 }
@@ -0,0 +1,33 @@
+package repository
+
+// freshMaterializedViews are queries on the base tables returning the same columns as
+// the materialized views, for the reads that cannot wait for the next refresh.
+var freshMaterializedViews = map[string]string{
+	MatCollObj: "SELECT o.*, totals.total_file_size, totals.total_file_count, c.tenant_id FROM object o" +
+		" inner join collection c on c.id = o.collection_id" +
+		" left join lateral (SELECT sum(f.size) as total_file_size, count(f.*) as total_file_count FROM file f" +
+		" where f.object_id = o.id) totals on true",
+	MatCollObjFile: "SELECT c.*, files.total_file_size, files.total_file_count, objects.total_object_count FROM collection c" +
+		" left join lateral (SELECT sum(f.size) as total_file_size, count(f.*) as total_file_count FROM file f" +
+		" inner join object o on o.id = f.object_id where o.collection_id = c.id) files on true" +
+		" left join lateral (SELECT count(o.*) as total_object_count FROM object o where o.collection_id = c.id) objects on true",
+	MatTenantFileJoin: "SELECT f.*, o.collection_id, c.tenant_id FROM file f" +
+		" inner join object o on o.id = f.object_id" +
+		" inner join collection c on c.id = o.collection_id",
+}
+
+// materializedView returns the relation to read the view from with the alias, which
+// defaults to the name of the view. If fresh is set, the relation is the query on the
+// base tables.
+func materializedView(view string, alias string, fresh bool) string {
+	relation := view
+	if fresh {
+		relation = "(" + freshMaterializedViews[view] + ")"
+	} else if alias == "" {
+		return relation
+	}
+	if alias == "" {
+		alias = view
+	}
+	return relation + " " + alias
+}
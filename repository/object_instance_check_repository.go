@@ -1,10 +1,13 @@
 package repository
 
-import "github.com/ocfl-archive/dlza-manager/models"
+import (
+	"context"
+	"github.com/ocfl-archive/dlza-manager/models"
+)
 
 type ObjectInstanceCheckRepository interface {
-	GetObjectInstanceCheckById(id string) (models.ObjectInstanceCheck, error)
-	CreateObjectInstanceCheck(models.ObjectInstanceCheck) (string, error)
-	GetObjectInstanceChecksByObjectInstanceId(id string) ([]models.ObjectInstanceCheck, error)
-	GetObjectInstanceChecksByObjectInstanceIdPaginated(pagination models.Pagination) ([]models.ObjectInstanceCheck, int, error)
+	GetObjectInstanceCheckById(ctx context.Context, id string) (models.ObjectInstanceCheck, error)
+	CreateObjectInstanceCheck(ctx context.Context, objectInstanceCheck models.ObjectInstanceCheck) (string, error)
+	GetObjectInstanceChecksByObjectInstanceId(ctx context.Context, id string) ([]models.ObjectInstanceCheck, error)
+	GetObjectInstanceChecksByObjectInstanceIdPaginated(ctx context.Context, pagination models.Pagination) ([]models.ObjectInstanceCheck, int, error)
 }
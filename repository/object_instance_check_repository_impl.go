@@ -3,13 +3,9 @@ package repository
 import (
 	"context"
 	"emperror.dev/errors"
-	"fmt"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/ocfl-archive/dlza-manager/models"
-	"slices"
-	"strconv"
-	"strings"
 	"time"
 )
 
@@ -19,35 +15,33 @@ const (
 	GetObjectInstanceChecksByObjectInstanceId = "GetObjectInstanceChecksByObjectInstanceId"
 )
 
+var objectInstanceCheckSortColumns = newSortColumns("checktime", "oic.id", columnsWithPrefix("oic.", "checktime", "error", "message",
+	"id", "object_instance_id", "check_type"))
+
 type ObjectInstanceCheckRepositoryImpl struct {
 	Db *pgxpool.Pool
 }
 
-func CreateObjectInstanceCheckPreparedStatements(ctx context.Context, conn *pgx.Conn) error {
+var objectInstanceCheckPreparedStatements = map[string]string{
+	GetObjectInstanceCheckById: "SELECT * FROM OBJECT_INSTANCE_CHECK WHERE ID = $1",
+	CreateObjectInstanceCheck: "INSERT INTO OBJECT_INSTANCE_CHECK(error, message, object_instance_id, check_type)" +
+		" VALUES ($1, $2, $3, $4) RETURNING id",
+	GetObjectInstanceChecksByObjectInstanceId: `SELECT oic.checktime, oic.error, oic.message, oic.id, oic.object_instance_id, oic.check_type
+												FROM (
+												SELECT ROW_NUMBER() over(PARTITION BY object_instance_id ORDER BY checktime DESC) AS number_of_row, *
+												FROM object_instance_check
+												) AS oic 
+												WHERE OBJECT_INSTANCE_ID = $1
+												AND oic.number_of_row <= 3`,
+}
 
-	preparedStatements := map[string]string{
-		GetObjectInstanceCheckById: "SELECT * FROM OBJECT_INSTANCE_CHECK WHERE ID = $1",
-		CreateObjectInstanceCheck: "INSERT INTO OBJECT_INSTANCE_CHECK(error, message, object_instance_id, check_type)" +
-			" VALUES ($1, $2, $3, $4) RETURNING id",
-		GetObjectInstanceChecksByObjectInstanceId: `SELECT oic.checktime, oic.error, oic.message, oic.id, oic.object_instance_id, oic.check_type
-													FROM (
-													SELECT ROW_NUMBER() over(PARTITION BY object_instance_id ORDER BY checktime DESC) AS number_of_row, *
-													FROM object_instance_check
-													) AS oic 
-													WHERE OBJECT_INSTANCE_ID = $1
-													AND oic.number_of_row <= 3`,
-	}
-	for name, sqlStm := range preparedStatements {
-		if _, err := conn.Prepare(ctx, name, sqlStm); err != nil {
-			return errors.Wrapf(err, "cannot prepare statement '%s' - '%s'", name, sqlStm)
-		}
-	}
-	return nil
+func CreateObjectInstanceCheckPreparedStatements(ctx context.Context, conn *pgx.Conn) error {
+	return prepareStatements(ctx, conn, objectInstanceCheckPreparedStatements)
 }
 
-func (o *ObjectInstanceCheckRepositoryImpl) CreateObjectInstanceCheck(objectInstanceCheck models.ObjectInstanceCheck) (string, error) {
+func (o *ObjectInstanceCheckRepositoryImpl) CreateObjectInstanceCheck(ctx context.Context, objectInstanceCheck models.ObjectInstanceCheck) (string, error) {
 
-	row := o.Db.QueryRow(context.Background(), CreateObjectInstanceCheck, objectInstanceCheck.Error, objectInstanceCheck.Message, objectInstanceCheck.ObjectInstanceId, objectInstanceCheck.CheckType)
+	row := o.Db.QueryRow(ctx, CreateObjectInstanceCheck, objectInstanceCheck.Error, objectInstanceCheck.Message, objectInstanceCheck.ObjectInstanceId, objectInstanceCheck.CheckType)
 
 	var id string
 	err := row.Scan(&id)
@@ -57,10 +51,10 @@ func (o *ObjectInstanceCheckRepositoryImpl) CreateObjectInstanceCheck(objectInst
 	return id, nil
 }
 
-func (o *ObjectInstanceCheckRepositoryImpl) GetObjectInstanceCheckById(id string) (models.ObjectInstanceCheck, error) {
+func (o *ObjectInstanceCheckRepositoryImpl) GetObjectInstanceCheckById(ctx context.Context, id string) (models.ObjectInstanceCheck, error) {
 	objectInstanceCheck := models.ObjectInstanceCheck{}
 	var checkTime time.Time
-	err := o.Db.QueryRow(context.Background(), GetObjectInstanceCheckById, id).Scan(&checkTime, &objectInstanceCheck.Error, &objectInstanceCheck.Message, &objectInstanceCheck.Id, &objectInstanceCheck.ObjectInstanceId, &objectInstanceCheck.CheckType)
+	err := o.Db.QueryRow(ctx, GetObjectInstanceCheckById, id).Scan(&checkTime, &objectInstanceCheck.Error, &objectInstanceCheck.Message, &objectInstanceCheck.Id, &objectInstanceCheck.ObjectInstanceId, &objectInstanceCheck.CheckType)
 	if err != nil {
 		return models.ObjectInstanceCheck{}, errors.Wrapf(err, "Could not execute query for method: %v", GetObjectInstanceCheckById)
 	}
@@ -68,9 +62,9 @@ func (o *ObjectInstanceCheckRepositoryImpl) GetObjectInstanceCheckById(id string
 	return objectInstanceCheck, err
 }
 
-func (o *ObjectInstanceCheckRepositoryImpl) GetObjectInstanceChecksByObjectInstanceId(id string) ([]models.ObjectInstanceCheck, error) {
+func (o *ObjectInstanceCheckRepositoryImpl) GetObjectInstanceChecksByObjectInstanceId(ctx context.Context, id string) ([]models.ObjectInstanceCheck, error) {
 
-	rows, err := o.Db.Query(context.Background(), GetObjectInstanceChecksByObjectInstanceId, id)
+	rows, err := o.Db.Query(ctx, GetObjectInstanceChecksByObjectInstanceId, id)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Could not execute query for method: %s", GetObjectInstanceChecksByObjectInstanceId)
 	}
@@ -91,39 +85,23 @@ func (o *ObjectInstanceCheckRepositoryImpl) GetObjectInstanceChecksByObjectInsta
 	return objectInstanceChecks, nil
 }
 
-func (o *ObjectInstanceCheckRepositoryImpl) GetObjectInstanceChecksByObjectInstanceIdPaginated(pagination models.Pagination) ([]models.ObjectInstanceCheck, int, error) {
-	firstCondition := ""
-	secondCondition := ""
-	if pagination.SecondId != "" {
-		if len(pagination.AllowedTenants) != 0 && slices.Contains(pagination.AllowedTenants, pagination.SecondId) {
-			pagination.AllowedTenants = []string{pagination.SecondId}
-		}
-		if len(pagination.AllowedTenants) == 0 {
-			pagination.AllowedTenants = []string{pagination.SecondId}
-		}
-	}
-	if pagination.Id == "" {
-		if len(pagination.AllowedTenants) != 0 {
-			tenants := strings.Join(pagination.AllowedTenants, "','")
-			firstCondition = fmt.Sprintf("where t.id in ('%s')", tenants)
-		}
-	} else {
-		firstCondition = fmt.Sprintf("where oic.object_instance_id = '%s'", pagination.Id)
-		if len(pagination.AllowedTenants) != 0 {
-			tenants := strings.Join(pagination.AllowedTenants, "','")
-			secondCondition = fmt.Sprintf("and t.id in ('%s')", tenants)
-		}
+func (o *ObjectInstanceCheckRepositoryImpl) GetObjectInstanceChecksByObjectInstanceIdPaginated(ctx context.Context, pagination models.Pagination) ([]models.ObjectInstanceCheck, int, error) {
+	var builder queryBuilder
+	builder.whereTenantScope(pagination, "oic.object_instance_id", "t.id")
+	getLikeQueryForObjectInstanceCheck(&builder, pagination.SearchField)
+
+	query := "SELECT oic.* FROM OBJECT_INSTANCE_CHECK oic" +
+		" inner join object_instance oi on oic.object_instance_id = oi.id" +
+		" inner join object o on oi.object_id = o.id" +
+		" inner join collection c on c.id = o.collection_id" +
+		" inner join tenant t on t.id = c.tenant_id" + builder.whereClause()
+	page, args, err := builder.page(pagination, objectInstanceCheckSortColumns)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "Could not build query for method: GetObjectInstanceChecksByObjectInstanceIdPaginated")
 	}
-
-	query := fmt.Sprintf("SELECT oic.* FROM OBJECT_INSTANCE_CHECK oic"+
-		" inner join object_instance oi on oic.object_instance_id = oi.id"+
-		" inner join object o on oi.object_id = o.id"+
-		" inner join collection c on c.id = o.collection_id"+
-		" inner join tenant t on t.id = c.tenant_id"+
-		" %s %s %s order by %s %s limit %s OFFSET %s ", firstCondition, secondCondition, getLikeQueryForObjectInstanceCheck(pagination.SearchField, firstCondition, secondCondition), "oic."+pagination.SortKey, pagination.SortDirection, strconv.Itoa(pagination.Take), strconv.Itoa(pagination.Skip))
-	rows, err := o.Db.Query(context.Background(), query)
+	rows, err := o.Db.Query(ctx, query+page, args...)
 	if err != nil {
-		return nil, 0, errors.Wrapf(err, "Could not execute query: %s", query)
+		return nil, 0, errors.Wrapf(err, "Could not execute query: %s", query+page)
 	}
 	defer rows.Close()
 	var objectInstanceChecks []models.ObjectInstanceCheck
@@ -133,22 +111,14 @@ func (o *ObjectInstanceCheckRepositoryImpl) GetObjectInstanceChecksByObjectInsta
 		var checkTime time.Time
 		err := rows.Scan(&checkTime, &objectInstanceCheck.Error, &objectInstanceCheck.Message, &objectInstanceCheck.Id, &objectInstanceCheck.ObjectInstanceId, &objectInstanceCheck.CheckType)
 		if err != nil {
-			return nil, 0, errors.Wrapf(err, "Could not scan rows for query: %s", query)
+			return nil, 0, errors.Wrapf(err, "Could not scan rows for query: %s", query+page)
 		}
 		objectInstanceCheck.CheckTime = checkTime.Format(Layout)
 		objectInstanceChecks = append(objectInstanceChecks, objectInstanceCheck)
 	}
-	countQuery := fmt.Sprintf("SELECT count(*) as total_items FROM OBJECT_INSTANCE_CHECK oic"+
-		" inner join object_instance oi on oic.object_instance_id = oi.id"+
-		" inner join object o on oi.object_id = o.id"+
-		" inner join collection c on c.id = o.collection_id"+
-		" inner join tenant t on t.id = c.tenant_id"+
-		" %s %s %s ", firstCondition, secondCondition, getLikeQueryForObjectInstanceCheck(pagination.SearchField, firstCondition, secondCondition))
-	var totalItems int
-	countRow := o.Db.QueryRow(context.Background(), countQuery)
-	err = countRow.Scan(&totalItems)
+	totalItems, err := builder.count(ctx, o.Db, query)
 	if err != nil {
-		return nil, 0, errors.Wrapf(err, "Could not scan countRow for query: %s", countQuery)
+		return nil, 0, err
 	}
 	return objectInstanceChecks, totalItems, nil
 }
@@ -157,16 +127,6 @@ func NewObjectInstanceCheckRepository(db *pgxpool.Pool) ObjectInstanceCheckRepos
 	return &ObjectInstanceCheckRepositoryImpl{Db: db}
 }
 
-func getLikeQueryForObjectInstanceCheck(searchKey string, firstCondition string, secondCondition string) string {
-	if searchKey != "" {
-		condition := ""
-		if firstCondition == "" && secondCondition == "" {
-			condition = "where"
-		} else {
-			condition = "and"
-		}
-		return condition + strings.Replace(" (oic.id::text like '_search_key_%' or lower(oic.message) like '%_search_key_%')",
-			"_search_key_", searchKey, -1)
-	}
-	return ""
+func getLikeQueryForObjectInstanceCheck(builder *queryBuilder, searchKey string) {
+	builder.whereSearch(searchKey, []string{"oic.id"}, []string{"oic.message"})
 }
@@ -1,20 +1,26 @@
 package repository
 
-import "github.com/ocfl-archive/dlza-manager/models"
+import (
+	"context"
+	"github.com/jackc/pgx/v5"
+	"github.com/ocfl-archive/dlza-manager/models"
+)
 
 type ObjectInstanceRepository interface {
-	CreateObjectInstance(models.ObjectInstance) (string, error)
-	UpdateObjectInstance(models.ObjectInstance) error
-	DeleteObjectInstance(id string) error
-	GetObjectInstanceById(id string) (models.ObjectInstance, error)
-	GetObjectInstancesByObjectId(id string) ([]models.ObjectInstance, error)
-	GetObjectInstancesByObjectIdPositive(id string) ([]models.ObjectInstance, error)
-	GetObjectInstancesByObjectIdPaginated(pagination models.Pagination) ([]models.ObjectInstance, int, error)
-	GetObjectInstancesByPartitionIdPaginated(pagination models.Pagination) ([]models.ObjectInstance, int, error)
-	GetAllObjectInstances() ([]models.ObjectInstance, error)
-	GetAmountOfErrorsByCollectionId(id string) (int, error)
-	GetObjectInstancesByName(name string) ([]models.ObjectInstance, error)
-	GetObjectInstancesBySignatureAndLocationsPathName(signature string, locationsName string) (models.ObjectInstance, error)
-	GetObjectInstanceExceptListOlderThanWithChecks(ids []string, timeBefore string, timeToWaitAvailability string) (models.ObjectInstance, error)
-	GetObjectInstanceByFileNameAndPartitionId(fileName string, partitionId string) (models.ObjectInstance, error)
+	CreateObjectInstance(ctx context.Context, objectInstance models.ObjectInstance) (string, error)
+	CreateObjectInstanceTx(ctx context.Context, tx pgx.Tx, objectInstance models.ObjectInstance) (string, error)
+	UpdateObjectInstance(ctx context.Context, objectInstance models.ObjectInstance) error
+	DeleteObjectInstance(ctx context.Context, id string) error
+	GetObjectInstanceById(ctx context.Context, id string) (models.ObjectInstance, error)
+	GetObjectInstancesByObjectId(ctx context.Context, id string) ([]models.ObjectInstance, error)
+	GetObjectInstancesByObjectIdPositive(ctx context.Context, id string) ([]models.ObjectInstance, error)
+	GetObjectInstancesByObjectIdPaginated(ctx context.Context, pagination models.Pagination) ([]models.ObjectInstance, int, error)
+	GetObjectInstancesByPartitionIdPaginated(ctx context.Context, pagination models.Pagination) ([]models.ObjectInstance, int, error)
+	ExportObjectInstancesByCollectionId(ctx context.Context, collectionId string, send func(models.ObjectInstance) error) error
+	GetAllObjectInstances(ctx context.Context) ([]models.ObjectInstance, error)
+	GetAmountOfErrorsByCollectionId(ctx context.Context, id string) (int, error)
+	GetObjectInstancesByName(ctx context.Context, name string) ([]models.ObjectInstance, error)
+	GetObjectInstancesBySignatureAndLocationsPathName(ctx context.Context, signature string, locationsName string) (models.ObjectInstance, error)
+	GetObjectInstanceExceptListOlderThanWithChecks(ctx context.Context, ids []string, timeBefore string, timeToWaitAvailability string) (models.ObjectInstance, error)
+	GetObjectInstanceByFileNameAndPartitionId(ctx context.Context, fileName string, partitionId string) (models.ObjectInstance, error)
 }
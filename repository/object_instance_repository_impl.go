@@ -3,8 +3,6 @@ package repository
 import (
 	"context"
 	"fmt"
-	"slices"
-	"strconv"
 	"strings"
 	"time"
 
@@ -25,39 +23,37 @@ const (
 	GetObjectInstancesByObjectIdPositive = "GetObjectInstancesByObjectIdPositive"
 )
 
+var objectInstanceSortColumns = newSortColumns("created", "oi.id", columnsWithPrefix("oi.", "path", "size", "created", "status",
+	"id", "storage_partition_id", "object_id"))
+
 type objectInstanceRepositoryImpl struct {
 	Db *pgxpool.Pool
 }
 
-func CreateObjectInstancePreparedStatements(ctx context.Context, conn *pgx.Conn) error {
+var objectInstancePreparedStatements = map[string]string{
+	GetObjectInstance:                    "SELECT * FROM OBJECT_INSTANCE o WHERE ID = $1",
+	CreateObjectInstance:                 "INSERT INTO OBJECT_INSTANCE(\"path\", \"size\", status, storage_partition_id, object_id) VALUES ($1, $2, $3, $4, $5) RETURNING id",
+	UpdateObjectInstance:                 "UPDATE OBJECT_INSTANCE set status = $1 where id = $2 RETURNING \"path\", storage_partition_id, object_id",
+	DeleteObjectInstance:                 "DELETE FROM OBJECT_INSTANCE  where id =$1",
+	GetObjectInstancesByObjectId:         "SELECT * FROM OBJECT_INSTANCE where object_id = $1",
+	GetObjectInstancesByObjectIdPositive: "SELECT * FROM OBJECT_INSTANCE where object_id = $1 AND status = 'ok'",
+	GetAllObjectInstances:                "SELECT * FROM OBJECT_INSTANCE",
+	GetAmountOfErrorsByCollectionId: "select count(oi.*) from collection c," +
+		" object o, object_instance oi" +
+		" where c.id = o.collection_id" +
+		" and o.id = oi.object_id" +
+		" and (oi.status = 'error' or oi.status = 'not available')" +
+		" and o.collection_id = $1",
+}
 
-	preparedStatements := map[string]string{
-		GetObjectInstance:                    "SELECT * FROM OBJECT_INSTANCE o WHERE ID = $1",
-		CreateObjectInstance:                 "INSERT INTO OBJECT_INSTANCE(\"path\", \"size\", status, storage_partition_id, object_id) VALUES ($1, $2, $3, $4, $5) RETURNING id",
-		UpdateObjectInstance:                 "UPDATE OBJECT_INSTANCE set status = $1 where id = $2",
-		DeleteObjectInstance:                 "DELETE FROM OBJECT_INSTANCE  where id =$1",
-		GetObjectInstancesByObjectId:         "SELECT * FROM OBJECT_INSTANCE where object_id = $1",
-		GetObjectInstancesByObjectIdPositive: "SELECT * FROM OBJECT_INSTANCE where object_id = $1 AND status = 'ok'",
-		GetAllObjectInstances:                "SELECT * FROM OBJECT_INSTANCE",
-		GetAmountOfErrorsByCollectionId: "select count(oi.*) from collection c," +
-			" object o, object_instance oi" +
-			" where c.id = o.collection_id" +
-			" and o.id = oi.object_id" +
-			" and (oi.status = 'error' or oi.status = 'not available')" +
-			" and o.collection_id = $1",
-	}
-	for name, sqlStm := range preparedStatements {
-		if _, err := conn.Prepare(ctx, name, sqlStm); err != nil {
-			return errors.Wrapf(err, "cannot prepare statement '%s' - '%s'", name, sqlStm)
-		}
-	}
-	return nil
+func CreateObjectInstancePreparedStatements(ctx context.Context, conn *pgx.Conn) error {
+	return prepareStatements(ctx, conn, objectInstancePreparedStatements)
 }
 
-func (o *objectInstanceRepositoryImpl) GetObjectInstanceByFileNameAndPartitionId(fileName string, partitionId string) (models.ObjectInstance, error) {
+func (o *objectInstanceRepositoryImpl) GetObjectInstanceByFileNameAndPartitionId(ctx context.Context, fileName string, partitionId string) (models.ObjectInstance, error) {
 	objectInstance := models.ObjectInstance{}
 	var created time.Time
-	err := o.Db.QueryRow(context.Background(), fmt.Sprintf("select * from object_instance where path like '%%%s' and storage_partition_id = '%s'", fileName, partitionId)).Scan(&objectInstance.Path, &objectInstance.Size, &created, &objectInstance.Status, &objectInstance.Id, &objectInstance.StoragePartitionId, &objectInstance.ObjectId)
+	err := o.Db.QueryRow(ctx, fmt.Sprintf("select * from object_instance where path like '%%%s' and storage_partition_id = '%s'", fileName, partitionId)).Scan(&objectInstance.Path, &objectInstance.Size, &created, &objectInstance.Status, &objectInstance.Id, &objectInstance.StoragePartitionId, &objectInstance.ObjectId)
 	if err != nil {
 		return models.ObjectInstance{}, errors.Wrapf(err, "Could not execute query: GetObjectInstanceByObjectSignatureAndPartitionId")
 	}
@@ -65,11 +61,11 @@ func (o *objectInstanceRepositoryImpl) GetObjectInstanceByFileNameAndPartitionId
 	return objectInstance, err
 }
 
-func (o *objectInstanceRepositoryImpl) GetObjectInstancesBySignatureAndLocationsPathName(signature string, locationsName string) (models.ObjectInstance, error) {
+func (o *objectInstanceRepositoryImpl) GetObjectInstancesBySignatureAndLocationsPathName(ctx context.Context, signature string, locationsName string) (models.ObjectInstance, error) {
 	signature = strings.Replace(signature, ":", "_", -1)
 	objectInstance := models.ObjectInstance{}
 	var created time.Time
-	err := o.Db.QueryRow(context.Background(), fmt.Sprintf("select * from object_instance where path like '%%/%s/%%%s%%'", locationsName, signature)).Scan(&objectInstance.Path, &objectInstance.Size, &created, &objectInstance.Status, &objectInstance.Id, &objectInstance.StoragePartitionId, &objectInstance.ObjectId)
+	err := o.Db.QueryRow(ctx, fmt.Sprintf("select * from object_instance where path like '%%/%s/%%%s%%'", locationsName, signature)).Scan(&objectInstance.Path, &objectInstance.Size, &created, &objectInstance.Status, &objectInstance.Id, &objectInstance.StoragePartitionId, &objectInstance.ObjectId)
 	if err != nil {
 		return models.ObjectInstance{}, errors.Wrapf(err, "Could not execute query: GetObjectInstancesBySignatureAndLocationsPathName")
 	}
@@ -77,8 +73,8 @@ func (o *objectInstanceRepositoryImpl) GetObjectInstancesBySignatureAndLocations
 	return objectInstance, err
 }
 
-func (o *objectInstanceRepositoryImpl) GetAmountOfErrorsByCollectionId(id string) (int, error) {
-	row := o.Db.QueryRow(context.Background(), GetAmountOfErrorsByCollectionId, id)
+func (o *objectInstanceRepositoryImpl) GetAmountOfErrorsByCollectionId(ctx context.Context, id string) (int, error) {
+	row := o.Db.QueryRow(ctx, GetAmountOfErrorsByCollectionId, id)
 	var amount int
 	err := row.Scan(&amount)
 	if err != nil {
@@ -87,17 +83,24 @@ func (o *objectInstanceRepositoryImpl) GetAmountOfErrorsByCollectionId(id string
 	return amount, nil
 }
 
-func (o *objectInstanceRepositoryImpl) UpdateObjectInstance(objectInstance models.ObjectInstance) error {
-	_, err := o.Db.Exec(context.Background(), UpdateObjectInstance, objectInstance.Status, objectInstance.Id)
-
-	if err != nil {
-		return errors.Wrapf(err, "Could not execute query for method: %s", UpdateObjectInstance)
-	}
-	return nil
+// UpdateObjectInstance sets the status of the object instance and appends the event of
+// the change. An unknown id changes nothing and is not reported.
+func (o *objectInstanceRepositoryImpl) UpdateObjectInstance(ctx context.Context, objectInstance models.ObjectInstance) error {
+	return inTransaction(ctx, o.Db, func(tx pgx.Tx) error {
+		event := ObjectInstanceEvent{Id: objectInstance.Id, Status: objectInstance.Status}
+		err := tx.QueryRow(ctx, UpdateObjectInstance, objectInstance.Status, objectInstance.Id).Scan(&event.Path, &event.StoragePartitionId, &event.ObjectId)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "Could not execute query for method: %s", UpdateObjectInstance)
+		}
+		return appendObjectInstanceEvent(ctx, tx, EventObjectInstanceStatusChanged, event)
+	})
 }
 
-func (o *objectInstanceRepositoryImpl) GetAllObjectInstances() ([]models.ObjectInstance, error) {
-	rows, err := o.Db.Query(context.Background(), GetAllObjectInstances)
+func (o *objectInstanceRepositoryImpl) GetAllObjectInstances(ctx context.Context) ([]models.ObjectInstance, error) {
+	rows, err := o.Db.Query(ctx, GetAllObjectInstances)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Could not execute query for method: %s", GetAllObjectInstances)
 	}
@@ -118,29 +121,49 @@ func (o *objectInstanceRepositoryImpl) GetAllObjectInstances() ([]models.ObjectI
 	return objectInstances, nil
 }
 
-func (o *objectInstanceRepositoryImpl) CreateObjectInstance(objectInstance models.ObjectInstance) (string, error) {
-	row := o.Db.QueryRow(context.Background(), CreateObjectInstance, objectInstance.Path, objectInstance.Size, objectInstance.Status, objectInstance.StoragePartitionId, objectInstance.ObjectId)
+func (o *objectInstanceRepositoryImpl) CreateObjectInstance(ctx context.Context, objectInstance models.ObjectInstance) (string, error) {
+	var id string
+	err := inTransaction(ctx, o.Db, func(tx pgx.Tx) error {
+		var err error
+		id, err = createObjectInstance(ctx, tx, objectInstance)
+		return err
+	})
+	return id, err
+}
+
+func (o *objectInstanceRepositoryImpl) CreateObjectInstanceTx(ctx context.Context, tx pgx.Tx, objectInstance models.ObjectInstance) (string, error) {
+	return createObjectInstance(ctx, tx, objectInstance)
+}
+
+// createObjectInstance inserts the object instance and appends the event of its creation.
+func createObjectInstance(ctx context.Context, tx pgx.Tx, objectInstance models.ObjectInstance) (string, error) {
+	row := tx.QueryRow(ctx, CreateObjectInstance, objectInstance.Path, objectInstance.Size, objectInstance.Status, objectInstance.StoragePartitionId, objectInstance.ObjectId)
 
 	var id string
 	err := row.Scan(&id)
 	if err != nil {
 		return "", errors.Wrapf(err, "Could not execute query for method: %s", CreateObjectInstance)
 	}
+	err = appendObjectInstanceEvent(ctx, tx, EventObjectInstanceCreated, ObjectInstanceEvent{Id: id, ObjectId: objectInstance.ObjectId,
+		StoragePartitionId: objectInstance.StoragePartitionId, Path: objectInstance.Path, Status: objectInstance.Status})
+	if err != nil {
+		return "", err
+	}
 	return id, nil
 }
 
-func (o *objectInstanceRepositoryImpl) DeleteObjectInstance(id string) error {
-	_, err := o.Db.Exec(context.Background(), DeleteObjectInstance, id)
+func (o *objectInstanceRepositoryImpl) DeleteObjectInstance(ctx context.Context, id string) error {
+	_, err := o.Db.Exec(ctx, DeleteObjectInstance, id)
 	if err != nil {
 		return errors.Wrapf(err, "Could not execute query: %s", DeleteObjectInstance)
 	}
 	return nil
 }
 
-func (o *objectInstanceRepositoryImpl) GetObjectInstanceById(id string) (models.ObjectInstance, error) {
+func (o *objectInstanceRepositoryImpl) GetObjectInstanceById(ctx context.Context, id string) (models.ObjectInstance, error) {
 	objectInstance := models.ObjectInstance{}
 	var created time.Time
-	err := o.Db.QueryRow(context.Background(), GetObjectInstance, id).Scan(&objectInstance.Path, &objectInstance.Size, &created, &objectInstance.Status, &objectInstance.Id, &objectInstance.StoragePartitionId, &objectInstance.ObjectId)
+	err := o.Db.QueryRow(ctx, GetObjectInstance, id).Scan(&objectInstance.Path, &objectInstance.Size, &created, &objectInstance.Status, &objectInstance.Id, &objectInstance.StoragePartitionId, &objectInstance.ObjectId)
 	if err != nil {
 		return models.ObjectInstance{}, errors.Wrapf(err, "Could not execute query: %s", DeleteObjectInstance)
 	}
@@ -148,8 +171,8 @@ func (o *objectInstanceRepositoryImpl) GetObjectInstanceById(id string) (models.
 	return objectInstance, err
 }
 
-func (o *objectInstanceRepositoryImpl) GetObjectInstancesByObjectId(id string) ([]models.ObjectInstance, error) {
-	rows, err := o.Db.Query(context.Background(), GetObjectInstancesByObjectId, id)
+func (o *objectInstanceRepositoryImpl) GetObjectInstancesByObjectId(ctx context.Context, id string) ([]models.ObjectInstance, error) {
+	rows, err := o.Db.Query(ctx, GetObjectInstancesByObjectId, id)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Could not execute query for method: %s", GetObjectInstancesByObjectId)
 	}
@@ -170,8 +193,8 @@ func (o *objectInstanceRepositoryImpl) GetObjectInstancesByObjectId(id string) (
 	return objectInstances, nil
 }
 
-func (o *objectInstanceRepositoryImpl) GetObjectInstancesByObjectIdPositive(id string) ([]models.ObjectInstance, error) {
-	rows, err := o.Db.Query(context.Background(), GetObjectInstancesByObjectIdPositive, id)
+func (o *objectInstanceRepositoryImpl) GetObjectInstancesByObjectIdPositive(ctx context.Context, id string) ([]models.ObjectInstance, error) {
+	rows, err := o.Db.Query(ctx, GetObjectInstancesByObjectIdPositive, id)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Could not execute query for method: %s", GetObjectInstancesByObjectIdPositive)
 	}
@@ -192,10 +215,10 @@ func (o *objectInstanceRepositoryImpl) GetObjectInstancesByObjectIdPositive(id s
 	return objectInstances, nil
 }
 
-func (o *objectInstanceRepositoryImpl) GetObjectInstancesByName(name string) ([]models.ObjectInstance, error) {
+func (o *objectInstanceRepositoryImpl) GetObjectInstancesByName(ctx context.Context, name string) ([]models.ObjectInstance, error) {
 	query := fmt.Sprintf("SELECT * FROM OBJECT_INSTANCE where path like "+"'%s/%s'", "%", name)
 	var objectInstances []models.ObjectInstance
-	rows, err := o.Db.Query(context.Background(), query)
+	rows, err := o.Db.Query(ctx, query)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Could not execute query: %s", query)
 	}
@@ -214,107 +237,60 @@ func (o *objectInstanceRepositoryImpl) GetObjectInstancesByName(name string) ([]
 	return objectInstances, nil
 }
 
-func (o *objectInstanceRepositoryImpl) GetObjectInstancesByObjectIdPaginated(pagination models.Pagination) ([]models.ObjectInstance, int, error) {
-	firstCondition := ""
-	secondCondition := ""
-	if pagination.SecondId != "" {
-		if len(pagination.AllowedTenants) != 0 && slices.Contains(pagination.AllowedTenants, pagination.SecondId) {
-			pagination.AllowedTenants = []string{pagination.SecondId}
-		}
-		if len(pagination.AllowedTenants) == 0 {
-			pagination.AllowedTenants = []string{pagination.SecondId}
-		}
-	}
-	if pagination.Id == "" {
-		if len(pagination.AllowedTenants) != 0 {
-			tenants := strings.Join(pagination.AllowedTenants, "','")
-			firstCondition = fmt.Sprintf("where t.id in ('%s')", tenants)
-		}
-	} else {
-		firstCondition = fmt.Sprintf("where oi.object_id = '%s'", pagination.Id)
-		if len(pagination.AllowedTenants) != 0 {
-			tenants := strings.Join(pagination.AllowedTenants, "','")
-			secondCondition = fmt.Sprintf("and t.id in ('%s')", tenants)
-		}
-	}
+func (o *objectInstanceRepositoryImpl) GetObjectInstancesByObjectIdPaginated(ctx context.Context, pagination models.Pagination) ([]models.ObjectInstance, int, error) {
+	var builder queryBuilder
+	builder.whereTenantScope(pagination, "oi.object_id", "t.id")
+	getLikeQueryForObjectInstance(&builder, pagination.SearchField)
 
-	query := fmt.Sprintf("SELECT oi.*, count(*) over() as total_items FROM OBJECT_INSTANCE oi"+
-		" inner join object o on oi.object_id = o.id"+
-		" inner join collection c on c.id = o.collection_id"+
-		" inner join tenant t on t.id = c.tenant_id"+
-		" %s %s %s order by %s %s limit %s OFFSET %s ", firstCondition, secondCondition, getLikeQueryForObjectInstance(pagination.SearchField, firstCondition, secondCondition), "oi."+pagination.SortKey, pagination.SortDirection, strconv.Itoa(pagination.Take), strconv.Itoa(pagination.Skip))
-	rows, err := o.Db.Query(context.Background(), query)
-	if err != nil {
-		return nil, 0, errors.Wrapf(err, "Could not execute query: %s", query)
-	}
-	defer rows.Close()
-	var objectInstances []models.ObjectInstance
-	var totalItems int
-	for rows.Next() {
-		var objectInstance models.ObjectInstance
-		var created time.Time
-		err := rows.Scan(&objectInstance.Path, &objectInstance.Size, &created, &objectInstance.Status,
-			&objectInstance.Id, &objectInstance.StoragePartitionId, &objectInstance.ObjectId, &totalItems)
-		if err != nil {
-			return nil, 0, errors.Wrapf(err, "Could not scan rows for query: %s", query)
-		}
-		objectInstance.Created = created.Format(Layout)
-		objectInstances = append(objectInstances, objectInstance)
-	}
-	return objectInstances, totalItems, nil
+	query := "SELECT oi.* FROM OBJECT_INSTANCE oi" +
+		" inner join object o on oi.object_id = o.id" +
+		" inner join collection c on c.id = o.collection_id" +
+		" inner join tenant t on t.id = c.tenant_id" + builder.whereClause()
+	return o.getObjectInstancesPaginated(ctx, builder, query, pagination)
 }
 
-func (o *objectInstanceRepositoryImpl) GetObjectInstancesByPartitionIdPaginated(pagination models.Pagination) ([]models.ObjectInstance, int, error) {
-	firstCondition := ""
-	secondCondition := ""
-	if pagination.SecondId != "" {
-		if len(pagination.AllowedTenants) != 0 && slices.Contains(pagination.AllowedTenants, pagination.SecondId) {
-			pagination.AllowedTenants = []string{pagination.SecondId}
-		}
-		if len(pagination.AllowedTenants) == 0 {
-			pagination.AllowedTenants = []string{pagination.SecondId}
-		}
-	}
-	if pagination.Id == "" {
-		if len(pagination.AllowedTenants) != 0 {
-			tenants := strings.Join(pagination.AllowedTenants, "','")
-			firstCondition = fmt.Sprintf("where t.id in ('%s')", tenants)
-		}
-	} else {
-		firstCondition = fmt.Sprintf("where oi.storage_partition_id = '%s'", pagination.Id)
-		if len(pagination.AllowedTenants) != 0 {
-			tenants := strings.Join(pagination.AllowedTenants, "','")
-			secondCondition = fmt.Sprintf("and t.id in ('%s')", tenants)
-		}
-	}
+func (o *objectInstanceRepositoryImpl) GetObjectInstancesByPartitionIdPaginated(ctx context.Context, pagination models.Pagination) ([]models.ObjectInstance, int, error) {
+	var builder queryBuilder
+	builder.whereTenantScope(pagination, "oi.storage_partition_id", "t.id")
+	getLikeQueryForObjectInstance(&builder, pagination.SearchField)
 
-	query := fmt.Sprintf("SELECT oi.*, count(*) over() as total_items FROM OBJECT_INSTANCE oi"+
-		" inner join storage_partition sp on oi.storage_partition_id = sp.id"+
-		" inner join storage_location sl on sl.id = sp.storage_location_id"+
-		" inner join tenant t on t.id = sl.tenant_id"+
-		" %s %s %s order by %s %s limit %s OFFSET %s ", firstCondition, secondCondition, getLikeQueryForObjectInstance(pagination.SearchField, firstCondition, secondCondition), "oi."+pagination.SortKey, pagination.SortDirection, strconv.Itoa(pagination.Take), strconv.Itoa(pagination.Skip))
-	rows, err := o.Db.Query(context.Background(), query)
+	query := "SELECT oi.* FROM OBJECT_INSTANCE oi" +
+		" inner join storage_partition sp on oi.storage_partition_id = sp.id" +
+		" inner join storage_location sl on sl.id = sp.storage_location_id" +
+		" inner join tenant t on t.id = sl.tenant_id" + builder.whereClause()
+	return o.getObjectInstancesPaginated(ctx, builder, query, pagination)
+}
+
+func (o *objectInstanceRepositoryImpl) getObjectInstancesPaginated(ctx context.Context, builder queryBuilder, query string, pagination models.Pagination) ([]models.ObjectInstance, int, error) {
+	page, args, err := builder.page(pagination, objectInstanceSortColumns)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "Could not build query for object instances")
+	}
+	rows, err := o.Db.Query(ctx, query+page, args...)
 	if err != nil {
-		return nil, 0, errors.Wrapf(err, "Could not execute query: %s", query)
+		return nil, 0, errors.Wrapf(err, "Could not execute query: %s", query+page)
 	}
 	defer rows.Close()
 	var objectInstances []models.ObjectInstance
-	var totalItems int
 	for rows.Next() {
 		var objectInstance models.ObjectInstance
 		var created time.Time
 		err := rows.Scan(&objectInstance.Path, &objectInstance.Size, &created, &objectInstance.Status,
-			&objectInstance.Id, &objectInstance.StoragePartitionId, &objectInstance.ObjectId, &totalItems)
+			&objectInstance.Id, &objectInstance.StoragePartitionId, &objectInstance.ObjectId)
 		if err != nil {
-			return nil, 0, errors.Wrapf(err, "Could not scan rows for query: %s", query)
+			return nil, 0, errors.Wrapf(err, "Could not scan rows for query: %s", query+page)
 		}
 		objectInstance.Created = created.Format(Layout)
 		objectInstances = append(objectInstances, objectInstance)
 	}
+	totalItems, err := builder.count(ctx, o.Db, query)
+	if err != nil {
+		return nil, 0, err
+	}
 	return objectInstances, totalItems, nil
 }
 
-func (o *objectInstanceRepositoryImpl) GetObjectInstanceExceptListOlderThanWithChecks(ids []string, timeBefore string, timeToWaitAvailability string) (models.ObjectInstance, error) {
+func (o *objectInstanceRepositoryImpl) GetObjectInstanceExceptListOlderThanWithChecks(ctx context.Context, ids []string, timeBefore string, timeToWaitAvailability string) (models.ObjectInstance, error) {
 	firstCondition := ""
 	if len(ids) != 0 {
 		firstCondition = fmt.Sprintf("and oi.id not in ('%s')", strings.Join(ids, "','"))
@@ -331,7 +307,7 @@ func (o *objectInstanceRepositoryImpl) GetObjectInstanceExceptListOlderThanWithC
 	AND (oicf.checktime < (now() - INTERVAL %s) OR (oicf.check_type = 'exists' AND oicf.checktime < (now() - INTERVAL %s)) OR oicf.id IS NULL)
 	limit 1`, firstCondition, timeBefore, timeToWaitAvailability)
 
-	rows, err := o.Db.Query(context.Background(), query)
+	rows, err := o.Db.Query(ctx, query)
 	if err != nil {
 		return objectInstance, errors.Wrapf(err, "cannot get object GetObjectExceptListOlderThanWithChecks")
 	}
@@ -352,17 +328,21 @@ func NewObjectInstanceRepository(db *pgxpool.Pool) ObjectInstanceRepository {
 	return &objectInstanceRepositoryImpl{Db: db}
 }
 
-func getLikeQueryForObjectInstance(searchKey string, firstCondition string, secondCondition string) string {
-	if searchKey != "" {
-		condition := ""
-		if firstCondition == "" && secondCondition == "" {
-			condition = "where"
-		} else {
-			condition = "and"
+func getLikeQueryForObjectInstance(builder *queryBuilder, searchKey string) {
+	builder.whereSearch(searchKey, []string{"oi.id"}, []string{"oi.path", "oi.status"})
+}
+
+func (o *objectInstanceRepositoryImpl) ExportObjectInstancesByCollectionId(ctx context.Context, collectionId string, send func(models.ObjectInstance) error) error {
+	query := "SELECT oi.* FROM OBJECT_INSTANCE oi inner join object o on o.id = oi.object_id where o.collection_id = $1"
+	return exportRows(ctx, o.Db, query, []any{collectionId}, func(rows pgx.Rows) error {
+		var objectInstance models.ObjectInstance
+		var created time.Time
+		err := rows.Scan(&objectInstance.Path, &objectInstance.Size, &created, &objectInstance.Status,
+			&objectInstance.Id, &objectInstance.StoragePartitionId, &objectInstance.ObjectId)
+		if err != nil {
+			return errors.Wrapf(err, "Could not scan rows for query: %s", query)
 		}
-		return condition + strings.Replace(" (oi.id::text like '_search_key_%' or lower(oi.path) like '%_search_key_%'"+
-			" or lower(oi.status::text) like '%_search_key_%')",
-			"_search_key_", searchKey, -1)
-	}
-	return ""
+		objectInstance.Created = created.Format(Layout)
+		return send(objectInstance)
+	})
 }
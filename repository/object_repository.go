@@ -1,20 +1,26 @@
 package repository
 
 import (
+	"context"
+	"github.com/jackc/pgx/v5"
 	"github.com/ocfl-archive/dlza-manager/models"
 )
 
 type ObjectRepository interface {
-	GetObjectById(id string) (models.Object, error)
-	GetObjectBySignature(signature string) (models.Object, error)
-	GetObjectByIdMv(id string) (models.Object, error)
-	GetObjectsByChecksum(checksum string) ([]models.Object, error)
-	CreateObject(object models.Object) (string, error)
-	UpdateObject(object models.Object) error
-	GetObjectsByCollectionId(id string) ([]models.Object, error)
-	GetObjectsByCollectionIdPaginated(pagination models.Pagination) ([]models.Object, int, error)
-	GetResultingQualityForObject(id string) (int, error)
-	GetNeededQualityForObject(id string) (int, error)
-	GetObjectExceptListOlderThan(collectionId string, ids []string, collectionsNeeded []string) (models.Object, error)
-	GetObjectBySignatureAndStorageLocationGroup(signature string, locationGroup string) (models.Object, error)
+	GetObjectById(ctx context.Context, id string) (models.Object, error)
+	GetObjectByIdTx(ctx context.Context, tx pgx.Tx, id string) (models.Object, error)
+	GetObjectBySignature(ctx context.Context, signature string) (models.Object, error)
+	GetObjectByIdMv(ctx context.Context, id string, fresh bool) (models.Object, error)
+	GetObjectsByChecksum(ctx context.Context, checksum string) ([]models.Object, error)
+	CreateObject(ctx context.Context, object models.Object) (string, error)
+	CreateObjectTx(ctx context.Context, tx pgx.Tx, object models.Object) (string, error)
+	UpdateObject(ctx context.Context, object models.Object) error
+	GetObjectsByCollectionId(ctx context.Context, id string) ([]models.Object, error)
+	GetObjectsByCollectionIdPaginated(ctx context.Context, pagination models.Pagination, fresh bool) ([]models.Object, int, error)
+	GetObjectsByCollectionIdKeyset(ctx context.Context, pagination KeysetPagination) ([]models.Object, string, int, error)
+	ExportObjectsByCollectionId(ctx context.Context, collectionId string, send func(models.Object) error) error
+	GetResultingQualityForObject(ctx context.Context, id string) (int, error)
+	GetNeededQualityForObject(ctx context.Context, id string) (int, error)
+	GetObjectExceptListOlderThan(ctx context.Context, collectionId string, ids []string, collectionsNeeded []string) (models.Object, error)
+	GetObjectBySignatureAndStorageLocationGroup(ctx context.Context, signature string, locationGroup string) (models.Object, error)
 }
@@ -3,8 +3,6 @@ package repository
 import (
 	"context"
 	"fmt"
-	"slices"
-	"strconv"
 	"strings"
 	"time"
 
@@ -31,53 +29,82 @@ const (
 	Layout                                      = "2006-01-02 15:04:05"
 )
 
+// objectSortColumns refer to the output columns, so they apply to the plain and to the
+// grouped status query alike.
+var objectSortColumns = newSortColumns("signature", "id", map[string]string{
+	"signature": "signature", "sets": "sets", "identifiers": "identifiers", "title": "title",
+	"alternative_titles": "alternative_titles", "description": "description", "keywords": "keywords",
+	"references": "\"references\"", "ingest_workflow": "ingest_workflow", "user": "\"user\"", "address": "address",
+	"created": "created", "last_changed": "last_changed", "size": "size", "id": "id", "collection_id": "collection_id",
+	"checksum": "checksum", "total_file_size": "total_file_size", "total_file_count": "total_file_count",
+	"authors": "authors", "holding": "holding", "expiration": "expiration", "head": "head", "versions": "versions",
+})
+
+// objectKeysetColumns refer to the output columns of objectListing.
+var objectKeysetColumns = keysetColumns{
+	columns: map[string]keysetColumn{
+		"signature":        {expression: "signature", sqlType: "text"},
+		"title":            {expression: "coalesce(title, '')", sqlType: "text"},
+		"created":          {expression: "created", sqlType: "timestamptz"},
+		"last_changed":     {expression: "last_changed", sqlType: "timestamptz"},
+		"size":             {expression: "size", sqlType: "bigint"},
+		"total_file_size":  {expression: "coalesce(total_file_size, 0)", sqlType: "bigint"},
+		"total_file_count": {expression: "coalesce(total_file_count, 0)", sqlType: "bigint"},
+		"id":               {expression: "id", sqlType: "uuid"},
+	},
+	fallback: "signature",
+	id:       "id",
+}
+
 type ObjectRepositoryImpl struct {
 	Db     *pgxpool.Pool
 	Logger zLogger.ZLogger
 }
 
-func CreateObjectPreparedStatements(ctx context.Context, conn *pgx.Conn) error {
-
-	preparedStatements := map[string]string{
-		GetObjectById: `SELECT signature, sets, identifiers, title, alternative_titles, description, keywords,"references", ingest_workflow,"user",
+var objectPreparedStatements = map[string]string{
+	GetObjectById: `SELECT signature, sets, identifiers, title, alternative_titles, description, keywords,"references", ingest_workflow,"user",
        address, created, last_changed, "size", id, collection_id, checksum, authors, holding, expiration, head, versions, "binary" FROM OBJECT o WHERE ID = $1`,
-		GetObjectBySignature: `SELECT signature, sets, identifiers, title, alternative_titles, description, keywords,"references", ingest_workflow,"user",
+	GetObjectBySignature: `SELECT signature, sets, identifiers, title, alternative_titles, description, keywords,"references", ingest_workflow,"user",
        address, created, last_changed, "size", id, collection_id, checksum, authors, holding, expiration, head, versions, "binary" FROM OBJECT o WHERE signature = $1`,
-		GetObjectByIdMv: "SELECT signature, sets, identifiers, title, alternative_titles, description, keywords, \"references\", ingest_workflow," +
-			" \"user\", address, created, last_changed, size, id, collection_id, checksum, authors, holding, expiration, head, versions, total_file_size, total_file_count FROM mat_coll_obj o WHERE ID = $1",
-		CreateObject: "INSERT INTO OBJECT(signature, \"sets\", identifiers, title, alternative_titles, description, keywords, \"references\"," +
-			" ingest_workflow, \"user\", address, \"size\", collection_id, checksum, authors, holding, expiration, head, versions, \"binary\")" +
-			" VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20) RETURNING id",
-		UpdateObject: "UPDATE OBJECT set signature = $1, sets = $2, identifiers = $3, title = $4," +
-			" alternative_titles = $5, description = $6, keywords = $7, \"references\" = $8, ingest_workflow = $9," +
-			" \"user\" = $10, address = $11, last_changed = $12, size = $13," +
-			" collection_id = $14, checksum = $15, authors = $16, holding = $17, expiration = $18, head = $19, versions = $20, \"binary\" = $21" +
-			" where id =$22",
-		GetObjectsByCollectionAlias: "SELECT signature, sets, identifiers, title, alternative_titles, description, keywords, \"references\", ingest_workflow,\"user\", address, created, last_changed, \"size\", id, collection_id, checksum, authors, holding, expiration, head, versions, \"binary\" FROM OBJECT where collection_id = $1",
-		GetResultingQualityForObject: "select sum(quality) from object o " +
-			" inner join object_instance oi on oi.object_id = o.id " +
-			" inner join storage_partition sp on sp.id = oi.storage_partition_id" +
-			" inner join storage_location sl on sl.id = sp.storage_location_id " +
-			" where o.id = $1",
-		GetNeededQualityForObject: "select quality from collection c " +
-			" inner join object o on c.id = o.collection_id" +
-			" where o.id = $1",
-		GetObjectBySignatureAndStorageLocationGroup: `select o.* from object o
-			inner join object_instance oi on o.id = oi.object_id
-			inner join storage_partition sp on sp.id = oi.storage_partition_id
+	GetObjectByIdMv: "SELECT signature, sets, identifiers, title, alternative_titles, description, keywords, \"references\", ingest_workflow," +
+		" \"user\", address, created, last_changed, size, id, collection_id, checksum, authors, holding, expiration, head, versions, total_file_size, total_file_count FROM mat_coll_obj o WHERE ID = $1",
+	CreateObject: "INSERT INTO OBJECT(signature, \"sets\", identifiers, title, alternative_titles, description, keywords, \"references\"," +
+		" ingest_workflow, \"user\", address, \"size\", collection_id, checksum, authors, holding, expiration, head, versions, \"binary\")" +
+		" VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20) RETURNING id",
+	UpdateObject: "UPDATE OBJECT set signature = $1, sets = $2, identifiers = $3, title = $4," +
+		" alternative_titles = $5, description = $6, keywords = $7, \"references\" = $8, ingest_workflow = $9," +
+		" \"user\" = $10, address = $11, last_changed = $12, size = $13," +
+		" collection_id = $14, checksum = $15, authors = $16, holding = $17, expiration = $18, head = $19, versions = $20, \"binary\" = $21" +
+		" where id =$22",
+	GetObjectsByCollectionAlias: "SELECT signature, sets, identifiers, title, alternative_titles, description, keywords, \"references\", ingest_workflow,\"user\", address, created, last_changed, \"size\", id, collection_id, checksum, authors, holding, expiration, head, versions, \"binary\" FROM OBJECT where collection_id = $1",
+	GetResultingQualityForObject: "select sum(quality) from object o " +
+		" inner join object_instance oi on oi.object_id = o.id " +
+		" inner join storage_partition sp on sp.id = oi.storage_partition_id" +
+		" inner join storage_location sl on sl.id = sp.storage_location_id " +
+		" where o.id = $1",
+	GetNeededQualityForObject: "select quality from collection c " +
+		" inner join object o on c.id = o.collection_id" +
+		" where o.id = $1",
+	GetObjectBySignatureAndStorageLocationGroup: `select o.* from object o
+		inner join object_instance oi on o.id = oi.object_id
+		inner join storage_partition sp on sp.id = oi.storage_partition_id
          	inner join storage_location sl on sl.id = sp.storage_location_id
-			where oi.status in ('ok', 'new') and signature = $1  and sl.group = $2`,
-	}
-	for name, sqlStm := range preparedStatements {
-		if _, err := conn.Prepare(ctx, name, sqlStm); err != nil {
-			return errors.Wrapf(err, "cannot prepare statement '%s' - '%s'", name, sqlStm)
-		}
-	}
-	return nil
+		where oi.status in ('ok', 'new') and signature = $1  and sl.group = $2`,
 }
 
-func (o *ObjectRepositoryImpl) CreateObject(object models.Object) (string, error) {
+func CreateObjectPreparedStatements(ctx context.Context, conn *pgx.Conn) error {
+	return prepareStatements(ctx, conn, objectPreparedStatements)
+}
 
+func (o *ObjectRepositoryImpl) CreateObject(ctx context.Context, object models.Object) (string, error) {
+	return createObject(ctx, o.Db, object)
+}
+
+func (o *ObjectRepositoryImpl) CreateObjectTx(ctx context.Context, tx pgx.Tx, object models.Object) (string, error) {
+	return createObject(ctx, tx, object)
+}
+
+func createObject(ctx context.Context, db querier, object models.Object) (string, error) {
 	var id string
 	expiration, err := time.Parse(Layout, object.Expiration)
 	date := pgtype.Date{Time: expiration}
@@ -86,7 +113,7 @@ func (o *ObjectRepositoryImpl) CreateObject(object models.Object) (string, error
 	} else {
 		date.Valid = true
 	}
-	err = o.Db.QueryRow(context.Background(), CreateObject, object.Signature, object.Sets, object.Identifiers, object.Title, object.AlternativeTitles, object.Description,
+	err = db.QueryRow(ctx, CreateObject, object.Signature, object.Sets, object.Identifiers, object.Title, object.AlternativeTitles, object.Description,
 		object.Keywords, object.References, object.IngestWorkflow, object.User, object.Address, object.Size, object.CollectionId, object.Checksum, object.Authors, object.Holding, date, object.Head, object.Versions, object.Binary).Scan(&id)
 	if err != nil {
 		return "", errors.Wrapf(err, "Could not execute query for method: %s", CreateObject)
@@ -94,13 +121,13 @@ func (o *ObjectRepositoryImpl) CreateObject(object models.Object) (string, error
 	return id, nil
 }
 
-func (o *ObjectRepositoryImpl) GetObjectBySignatureAndStorageLocationGroup(signature string, locationGroup string) (models.Object, error) {
+func (o *ObjectRepositoryImpl) GetObjectBySignatureAndStorageLocationGroup(ctx context.Context, signature string, locationGroup string) (models.Object, error) {
 	object := models.Object{}
 	var holding zeronull.Text
 	var expiration pgtype.Date
 	var lastChanged time.Time
 	var created time.Time
-	err := o.Db.QueryRow(context.Background(), GetObjectBySignatureAndStorageLocationGroup, signature, locationGroup).Scan(&object.Signature, &object.Sets, &object.Identifiers, &object.Title,
+	err := o.Db.QueryRow(ctx, GetObjectBySignatureAndStorageLocationGroup, signature, locationGroup).Scan(&object.Signature, &object.Sets, &object.Identifiers, &object.Title,
 		&object.AlternativeTitles, &object.Description, &object.Keywords, &object.References, &object.IngestWorkflow, &object.User,
 		&object.Address, &created, &lastChanged, &object.Size, &object.Id, &object.CollectionId, &object.Checksum, &object.Authors, &holding, &expiration, &object.Head, &object.Versions, &object.Binary)
 	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
@@ -118,13 +145,21 @@ func (o *ObjectRepositoryImpl) GetObjectBySignatureAndStorageLocationGroup(signa
 	return object, nil
 }
 
-func (o *ObjectRepositoryImpl) GetObjectById(id string) (models.Object, error) {
+func (o *ObjectRepositoryImpl) GetObjectById(ctx context.Context, id string) (models.Object, error) {
+	return getObjectById(ctx, o.Db, id)
+}
+
+func (o *ObjectRepositoryImpl) GetObjectByIdTx(ctx context.Context, tx pgx.Tx, id string) (models.Object, error) {
+	return getObjectById(ctx, tx, id)
+}
+
+func getObjectById(ctx context.Context, db querier, id string) (models.Object, error) {
 	var object models.Object
 	var holding zeronull.Text
 	var expiration pgtype.Date
 	var lastChanged time.Time
 	var created time.Time
-	err := o.Db.QueryRow(context.Background(), GetObjectById, id).Scan(&object.Signature, &object.Sets, &object.Identifiers, &object.Title,
+	err := db.QueryRow(ctx, GetObjectById, id).Scan(&object.Signature, &object.Sets, &object.Identifiers, &object.Title,
 		&object.AlternativeTitles, &object.Description, &object.Keywords, &object.References, &object.IngestWorkflow, &object.User,
 		&object.Address, &created, &lastChanged, &object.Size, &object.Id, &object.CollectionId, &object.Checksum, &object.Authors, &holding, &expiration, &object.Head, &object.Versions, &object.Binary)
 	if err != nil {
@@ -142,13 +177,13 @@ func (o *ObjectRepositoryImpl) GetObjectById(id string) (models.Object, error) {
 	return object, nil
 }
 
-func (o *ObjectRepositoryImpl) GetObjectBySignature(signature string) (models.Object, error) {
+func (o *ObjectRepositoryImpl) GetObjectBySignature(ctx context.Context, signature string) (models.Object, error) {
 	var object models.Object
 	var holding zeronull.Text
 	var expiration pgtype.Date
 	var lastChanged time.Time
 	var created time.Time
-	rows, err := o.Db.Query(context.Background(), GetObjectBySignature, signature)
+	rows, err := o.Db.Query(ctx, GetObjectBySignature, signature)
 	if err != nil {
 		return object, errors.Wrapf(err, "Could not execute query: %s", GetObjectBySignature)
 	}
@@ -174,7 +209,7 @@ func (o *ObjectRepositoryImpl) GetObjectBySignature(signature string) (models.Ob
 	return object, nil
 }
 
-func (o *ObjectRepositoryImpl) GetObjectExceptListOlderThan(collectionId string, ids []string, collectionsNeeded []string) (models.Object, error) {
+func (o *ObjectRepositoryImpl) GetObjectExceptListOlderThan(ctx context.Context, collectionId string, ids []string, collectionsNeeded []string) (models.Object, error) {
 	firstCondition := ""
 	if len(ids) != 0 {
 		firstCondition = fmt.Sprintf("and objf.id not in ('%s')", strings.Join(ids, "','"))
@@ -197,7 +232,7 @@ func (o *ObjectRepositoryImpl) GetObjectExceptListOlderThan(collectionId string,
 	AND oi.status = 'ok'
 	limit 1`, firstCondition, collectionsNeededString, collectionsNeededString)
 
-	rows, err := o.Db.Query(context.Background(), query, collectionId)
+	rows, err := o.Db.Query(ctx, query, collectionId)
 	if err != nil {
 		return object, errors.Wrapf(err, "cannot get object GetObjectExceptListOlderThan")
 	}
@@ -223,7 +258,7 @@ func (o *ObjectRepositoryImpl) GetObjectExceptListOlderThan(collectionId string,
 	return object, nil
 }
 
-func (o *ObjectRepositoryImpl) GetObjectByIdMv(id string) (models.Object, error) {
+func (o *ObjectRepositoryImpl) GetObjectByIdMv(ctx context.Context, id string, fresh bool) (models.Object, error) {
 	var object models.Object
 	var expiration pgtype.Date
 	var holding zeronull.Text
@@ -231,7 +266,13 @@ func (o *ObjectRepositoryImpl) GetObjectByIdMv(id string) (models.Object, error)
 	var created time.Time
 	var totalFileSize zeronull.Int8
 	var totalFileCount zeronull.Int8
-	err := o.Db.QueryRow(context.Background(), GetObjectByIdMv, id).Scan(&object.Signature, &object.Sets, &object.Identifiers, &object.Title,
+	query := GetObjectByIdMv
+	if fresh {
+		query = "SELECT signature, sets, identifiers, title, alternative_titles, description, keywords, \"references\", ingest_workflow," +
+			" \"user\", address, created, last_changed, size, id, collection_id, checksum, authors, holding, expiration, head, versions, total_file_size, total_file_count FROM " +
+			materializedView(MatCollObj, "", true) + " WHERE ID = $1"
+	}
+	err := o.Db.QueryRow(ctx, query, id).Scan(&object.Signature, &object.Sets, &object.Identifiers, &object.Title,
 		&object.AlternativeTitles, &object.Description, &object.Keywords, &object.References, &object.IngestWorkflow, &object.User,
 		&object.Address, &created, &lastChanged, &object.Size, &object.Id, &object.CollectionId, &object.Checksum, &object.Authors, &holding, &expiration, &object.Head, &object.Versions, &totalFileSize, &totalFileCount)
 	if err != nil {
@@ -251,8 +292,8 @@ func (o *ObjectRepositoryImpl) GetObjectByIdMv(id string) (models.Object, error)
 	return object, nil
 }
 
-func (o *ObjectRepositoryImpl) UpdateObject(object models.Object) error {
-	_, err := o.Db.Exec(context.Background(), UpdateObject, object.Signature, object.Sets, object.Identifiers, object.Title, object.AlternativeTitles, object.Description,
+func (o *ObjectRepositoryImpl) UpdateObject(ctx context.Context, object models.Object) error {
+	_, err := o.Db.Exec(ctx, UpdateObject, object.Signature, object.Sets, object.Identifiers, object.Title, object.AlternativeTitles, object.Description,
 		object.Keywords, object.References, object.IngestWorkflow, object.User, object.Address, time.Now(), object.Size, object.CollectionId, object.Checksum, object.Authors, object.Holding, object.Expiration, object.Head, object.Versions, object.Binary, object.Id)
 	if err != nil {
 		return errors.Wrapf(err, "cannot update object")
@@ -260,8 +301,8 @@ func (o *ObjectRepositoryImpl) UpdateObject(object models.Object) error {
 	return nil
 }
 
-func (o *ObjectRepositoryImpl) GetObjectsByCollectionId(id string) ([]models.Object, error) {
-	rows, err := o.Db.Query(context.Background(), GetObjectsByCollectionAlias, id)
+func (o *ObjectRepositoryImpl) GetObjectsByCollectionId(ctx context.Context, id string) ([]models.Object, error) {
+	rows, err := o.Db.Query(ctx, GetObjectsByCollectionAlias, id)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Could not execute query for method: %s", GetObjectsByCollectionAlias)
 	}
@@ -289,11 +330,11 @@ func (o *ObjectRepositoryImpl) GetObjectsByCollectionId(id string) ([]models.Obj
 	return objects, nil
 }
 
-func (o *ObjectRepositoryImpl) GetObjectsByChecksum(checksum string) ([]models.Object, error) {
+func (o *ObjectRepositoryImpl) GetObjectsByChecksum(ctx context.Context, checksum string) ([]models.Object, error) {
 	query := fmt.Sprintf("SELECT signature, sets, identifiers, title, alternative_titles, description, keywords, \"references\", ingest_workflow,"+
 		"\"user\", address, created, last_changed, \"size\", id, collection_id, checksum, authors, holding, expiration, head, versions FROM OBJECT where checksum like "+"'%s%s'", "%", checksum)
 	var objects []models.Object
-	rows, err := o.Db.Query(context.Background(), query)
+	rows, err := o.Db.Query(ctx, query)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Could not execute query: %s", query)
 	}
@@ -319,72 +360,91 @@ func (o *ObjectRepositoryImpl) GetObjectsByChecksum(checksum string) ([]models.O
 	return objects, nil
 }
 
-func (o *ObjectRepositoryImpl) GetResultingQualityForObject(id string) (int, error) {
+func (o *ObjectRepositoryImpl) GetResultingQualityForObject(ctx context.Context, id string) (int, error) {
 	var quality int
-	err := o.Db.QueryRow(context.Background(), GetResultingQualityForObject, id).Scan(&quality)
+	err := o.Db.QueryRow(ctx, GetResultingQualityForObject, id).Scan(&quality)
 	if err != nil {
 		return 0, errors.Wrapf(err, "cannot GetResultingQualityForObject")
 	}
 	return quality, nil
 }
 
-func (o *ObjectRepositoryImpl) GetNeededQualityForObject(id string) (int, error) {
+func (o *ObjectRepositoryImpl) GetNeededQualityForObject(ctx context.Context, id string) (int, error) {
 	var quality int
-	err := o.Db.QueryRow(context.Background(), GetNeededQualityForObject, id).Scan(&quality)
+	err := o.Db.QueryRow(ctx, GetNeededQualityForObject, id).Scan(&quality)
 	if err != nil {
 		return 0, errors.Wrapf(err, "cannot GetNeededQualityForObject")
 	}
 	return quality, nil
 }
 
-func (o *ObjectRepositoryImpl) GetObjectsByCollectionIdPaginated(pagination models.Pagination) ([]models.Object, int, error) {
-	firstCondition := ""
-	secondCondition := ""
-	if pagination.SecondId != "" {
-		if len(pagination.AllowedTenants) != 0 && slices.Contains(pagination.AllowedTenants, pagination.SecondId) {
-			pagination.AllowedTenants = []string{pagination.SecondId}
-		}
-		if len(pagination.AllowedTenants) == 0 {
-			pagination.AllowedTenants = []string{pagination.SecondId}
-		}
+func (o *ObjectRepositoryImpl) GetObjectsByCollectionIdPaginated(ctx context.Context, pagination models.Pagination, fresh bool) ([]models.Object, int, error) {
+	var builder queryBuilder
+	builder.whereTenantScope(pagination, "collection_id", "tenant_id")
+
+	query := objectListing(&builder, pagination.SearchField, fresh)
+	page, args, err := builder.page(pagination, objectSortColumns)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "Could not build query for method: GetObjectsByCollectionIdPaginated")
 	}
-	if pagination.Id == "" {
-		if len(pagination.AllowedTenants) != 0 {
-			tenants := strings.Join(pagination.AllowedTenants, "','")
-			firstCondition = fmt.Sprintf("where tenant_id in ('%s')", tenants)
-		}
-	} else {
-		firstCondition = fmt.Sprintf("where collection_id = '%s'", pagination.Id)
-		if len(pagination.AllowedTenants) != 0 {
-			tenants := strings.Join(pagination.AllowedTenants, "','")
-			secondCondition = fmt.Sprintf("and tenant_id in ('%s')", tenants)
+	rows, err := o.Db.Query(ctx, query+page, args...)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "Could not execute query: %s", query+page)
+	}
+	defer rows.Close()
+	var objects []models.Object
+	for rows.Next() {
+		var object models.Object
+		var holding zeronull.Text
+		var totalFileSize zeronull.Int8
+		var totalFileCount zeronull.Int8
+		var expiration pgtype.Date
+		var lastChanged time.Time
+		var created time.Time
+		err := rows.Scan(&object.Signature, &object.Sets, &object.Identifiers, &object.Title,
+			&object.AlternativeTitles, &object.Description, &object.Keywords, &object.References, &object.IngestWorkflow, &object.User,
+			&object.Address, &created, &lastChanged, &object.Size, &object.Id, &object.CollectionId, &object.Checksum, &totalFileSize, &totalFileCount, &object.Authors, &holding, &expiration, &object.Head, &object.Versions)
+		if err != nil {
+			return nil, 0, errors.Wrapf(err, "Could not scan rows for query: %s", query+page)
 		}
+		object.Holding = string(holding)
+		object.TotalFileSize = int64(totalFileSize)
+		object.TotalFileCount = int64(totalFileCount)
+		object.Expiration = expiration.Time.Format(Layout)
+		object.LastChanged = lastChanged.Format(Layout)
+		object.Created = created.Format(Layout)
+		objects = append(objects, object)
+	}
+	totalItems, err := builder.count(ctx, o.Db, query)
+	if err != nil {
+		return nil, 0, err
 	}
+	o.Logger.Debug().Msgf("Repository GetObjectsByCollectionIdPaginated function returned objects %s", time.Now())
+	return objects, totalItems, nil
+}
 
-	query := ""
-	if strings.Contains(pagination.SearchField, Status) {
-		status := strings.SplitAfter(pagination.SearchField, Status)[1]
-		query = fmt.Sprintf("select mo.signature, mo.sets, mo.identifiers, mo.title, mo.alternative_titles, mo.description, mo.keywords, mo.references, mo.ingest_workflow,"+
-			" mo.user, mo.address, mo.created, mo.last_changed, mo.size, mo.id, mo.collection_id, mo.checksum, mo.total_file_size, mo.total_file_count,"+
-			" mo.authors, mo.holding, mo.expiration, mo.head, mo.versions, count(*) over() from col_obj_inst coi"+
-			" inner join mat_coll_obj mo"+
-			" on mo.id = coi.id"+
-			" %s %s and status = '%s' "+
-			" group by mo.id,mo.signature, mo.sets, mo.identifiers, mo.title, mo.alternative_titles, mo.description, mo.keywords, mo.references, mo.ingest_workflow, mo.user, mo.address, mo.created, mo.last_changed, mo.size, mo.expiration, mo.authors, mo.holding, mo.collection_id, mo.checksum, mo.head, mo.versions, mo.total_file_size, mo.total_file_count, mo.tenant_id"+
-			" order by %s %s limit %s OFFSET %s ", firstCondition, secondCondition, status, pagination.SortKey, pagination.SortDirection, strconv.Itoa(pagination.Take), strconv.Itoa(pagination.Skip))
-	} else {
-		query = fmt.Sprintf("SELECT signature, sets, identifiers, title, alternative_titles, description, keywords, \"references\", ingest_workflow,"+
-			"\"user\", address, created, last_changed, size, id, collection_id, checksum, total_file_size, total_file_count, authors, holding, expiration, head, versions, count(*) over() FROM mat_coll_obj"+
-			" %s %s %s order by %s %s limit %s OFFSET %s ", firstCondition, secondCondition, getLikeQueryForObject(pagination.SearchField, firstCondition, secondCondition), pagination.SortKey, pagination.SortDirection, strconv.Itoa(pagination.Take), strconv.Itoa(pagination.Skip))
+func (o *ObjectRepositoryImpl) GetObjectsByCollectionIdKeyset(ctx context.Context, pagination KeysetPagination) ([]models.Object, string, int, error) {
+	var builder queryBuilder
+	builder.whereTenantScope(pagination.Pagination, "collection_id", "tenant_id")
+
+	query := objectListing(&builder, pagination.SearchField, pagination.Fresh)
+	keyset, err := builder.keyset(query, pagination, objectKeysetColumns)
+	if err != nil {
+		return nil, "", 0, errors.Wrapf(err, "Could not build keyset query for method: GetObjectsByCollectionIdKeyset")
 	}
-	rows, err := o.Db.Query(context.Background(), query)
+	rows, err := o.Db.Query(ctx, keyset.sql, keyset.args...)
 	if err != nil {
-		return nil, 0, errors.Wrapf(err, "Could not execute query: %s", query)
+		return nil, "", 0, errors.Wrapf(err, "Could not execute query: %s", keyset.sql)
 	}
 	defer rows.Close()
 	var objects []models.Object
-	var totalItems int
+	var cursorValue string
+	fetched := 0
 	for rows.Next() {
+		fetched++
+		if keyset.hasNext(fetched) {
+			break
+		}
 		var object models.Object
 		var holding zeronull.Text
 		var totalFileSize zeronull.Int8
@@ -394,9 +454,9 @@ func (o *ObjectRepositoryImpl) GetObjectsByCollectionIdPaginated(pagination mode
 		var created time.Time
 		err := rows.Scan(&object.Signature, &object.Sets, &object.Identifiers, &object.Title,
 			&object.AlternativeTitles, &object.Description, &object.Keywords, &object.References, &object.IngestWorkflow, &object.User,
-			&object.Address, &created, &lastChanged, &object.Size, &object.Id, &object.CollectionId, &object.Checksum, &totalFileSize, &totalFileCount, &object.Authors, &holding, &expiration, &object.Head, &object.Versions, &totalItems)
+			&object.Address, &created, &lastChanged, &object.Size, &object.Id, &object.CollectionId, &object.Checksum, &totalFileSize, &totalFileCount, &object.Authors, &holding, &expiration, &object.Head, &object.Versions, &cursorValue)
 		if err != nil {
-			return nil, 0, errors.Wrapf(err, "Could not scan rows for query: %s", query)
+			return nil, "", 0, errors.Wrapf(err, "Could not scan rows for query: %s", keyset.sql)
 		}
 		object.Holding = string(holding)
 		object.TotalFileSize = int64(totalFileSize)
@@ -406,26 +466,75 @@ func (o *ObjectRepositoryImpl) GetObjectsByCollectionIdPaginated(pagination mode
 		object.Created = created.Format(Layout)
 		objects = append(objects, object)
 	}
-	o.Logger.Debug().Msgf("Repository GetObjectsByCollectionIdPaginated function returned objects %s", time.Now())
-	return objects, totalItems, nil
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, "", 0, errors.Wrapf(err, "Could not read rows for query: %s", keyset.sql)
+	}
+	var nextCursor string
+	if keyset.hasNext(fetched) {
+		nextCursor = keyset.cursorAfter(cursorValue, objects[len(objects)-1].Id)
+	}
+	var totalItems int
+	if pagination.WithTotalItems {
+		totalItems, err = builder.count(ctx, o.Db, query)
+		if err != nil {
+			return nil, "", 0, err
+		}
+	}
+	return objects, nextCursor, totalItems, nil
+}
+
+// objectListing returns the query for the objects of a collection. A search field with
+// the Status prefix selects the objects having an instance with that status instead
+// of searching the object columns.
+func objectListing(builder *queryBuilder, searchField string, fresh bool) string {
+	query := ""
+	if strings.Contains(searchField, Status) {
+		status := strings.SplitAfter(searchField, Status)[1]
+		builder.whereEquals("status", status)
+		query = "select mo.signature, mo.sets, mo.identifiers, mo.title, mo.alternative_titles, mo.description, mo.keywords, mo.references, mo.ingest_workflow," +
+			" mo.user, mo.address, mo.created, mo.last_changed, mo.size, mo.id, mo.collection_id, mo.checksum, mo.total_file_size, mo.total_file_count," +
+			" mo.authors, mo.holding, mo.expiration, mo.head, mo.versions from col_obj_inst coi" +
+			" inner join " + materializedView(MatCollObj, "mo", fresh) +
+			" on mo.id = coi.id" + builder.whereClause() +
+			" group by mo.id,mo.signature, mo.sets, mo.identifiers, mo.title, mo.alternative_titles, mo.description, mo.keywords, mo.references, mo.ingest_workflow, mo.user, mo.address, mo.created, mo.last_changed, mo.size, mo.expiration, mo.authors, mo.holding, mo.collection_id, mo.checksum, mo.head, mo.versions, mo.total_file_size, mo.total_file_count, mo.tenant_id"
+	} else {
+		getLikeQueryForObject(builder, searchField)
+		query = "SELECT signature, sets, identifiers, title, alternative_titles, description, keywords, \"references\", ingest_workflow," +
+			"\"user\", address, created, last_changed, size, id, collection_id, checksum, total_file_size, total_file_count, authors, holding, expiration, head, versions FROM " + materializedView(MatCollObj, "", fresh) +
+			builder.whereClause()
+	}
+	return query
 }
 
 func NewObjectRepository(db *pgxpool.Pool, logger zLogger.ZLogger) ObjectRepository {
 	return &ObjectRepositoryImpl{Db: db, Logger: logger}
 }
 
-func getLikeQueryForObject(searchKey string, firstCondition string, secondCondition string) string {
-	if searchKey != "" {
-		condition := ""
-		if firstCondition == "" && secondCondition == "" {
-			condition = "where"
-		} else {
-			condition = "and"
+func getLikeQueryForObject(builder *queryBuilder, searchKey string) {
+	builder.whereSearch(searchKey, []string{"id"}, []string{"signature", "title", "description", "ingest_workflow",
+		"\"user\"", "address", "checksum", "authors", "holding"})
+}
+
+func (o *ObjectRepositoryImpl) ExportObjectsByCollectionId(ctx context.Context, collectionId string, send func(models.Object) error) error {
+	query := "SELECT signature, sets, identifiers, title, alternative_titles, description, keywords, \"references\", ingest_workflow," +
+		"\"user\", address, created, last_changed, \"size\", id, collection_id, checksum, authors, holding, expiration, head, versions, \"binary\" FROM OBJECT where collection_id = $1"
+	return exportRows(ctx, o.Db, query, []any{collectionId}, func(rows pgx.Rows) error {
+		var object models.Object
+		var holding zeronull.Text
+		var expiration pgtype.Date
+		var lastChanged time.Time
+		var created time.Time
+		err := rows.Scan(&object.Signature, &object.Sets, &object.Identifiers, &object.Title,
+			&object.AlternativeTitles, &object.Description, &object.Keywords, &object.References, &object.IngestWorkflow, &object.User,
+			&object.Address, &created, &lastChanged, &object.Size, &object.Id, &object.CollectionId, &object.Checksum, &object.Authors, &holding, &expiration, &object.Head, &object.Versions, &object.Binary)
+		if err != nil {
+			return errors.Wrapf(err, "Could not scan rows for query: %s", query)
 		}
-		return condition + strings.Replace(" (id::text like '_search_key_%' or lower(signature) like '%_search_key_%'"+
-			" or lower(title) like '%_search_key_%' or lower(description) like '%_search_key_%' or lower(ingest_workflow) like '%_search_key_%'"+
-			" or lower(\"user\") like '%_search_key_%' or lower(address) like '%_search_key_%' or checksum like '%_search_key_%' or lower(authors::text) like '%_search_key_%' or lower(holding) like '%_search_key_%')",
-			"_search_key_", searchKey, -1)
-	}
-	return ""
+		object.Holding = string(holding)
+		object.Expiration = expiration.Time.Format(Layout)
+		object.LastChanged = lastChanged.Format(Layout)
+		object.Created = created.Format(Layout)
+		return send(object)
+	})
 }
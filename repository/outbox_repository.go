@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// Types of the object lifecycle events.
+const (
+	EventObjectInstanceCreated       = "object_instance.created"
+	EventObjectInstanceStatusChanged = "object_instance.status_changed"
+	EventObjectInstanceDeprecated    = "object_instance.deprecated"
+	EventArchivingStatusChanged      = "archiving_status.changed"
+)
+
+// OutboxEvent is a published lifecycle event. Payload is the changed entity as JSON.
+type OutboxEvent struct {
+	Offset   int64
+	Created  time.Time
+	Type     string
+	EntityId string
+	Payload  []byte
+}
+
+// ObjectInstanceEvent is the payload of the object instance events.
+type ObjectInstanceEvent struct {
+	Id                 string `json:"id"`
+	ObjectId           string `json:"objectId"`
+	StoragePartitionId string `json:"storagePartitionId"`
+	Path               string `json:"path"`
+	Status             string `json:"status"`
+}
+
+// ArchivingStatusEvent is the payload of the archiving status events.
+type ArchivingStatusEvent struct {
+	Id     string `json:"id"`
+	Status string `json:"status"`
+}
+
+type OutboxRepository interface {
+	// PublishOutboxEvents numbers up to limit committed events not published yet with the
+	// offsets following the last published one and returns how many it has numbered.
+	PublishOutboxEvents(ctx context.Context, limit int) (int, error)
+	// GetOutboxEvents returns up to limit published events with an offset greater than
+	// afterOffset, in the order of the offsets.
+	GetOutboxEvents(ctx context.Context, afterOffset int64, limit int) ([]OutboxEvent, error)
+	// GetOutboxOffsets returns the offset of the oldest published event still stored, the
+	// offset following the latest one if all are deleted, and the offset of the latest
+	// published event, zero if none has been published. The offsets are not reused after
+	// the events have been deleted.
+	GetOutboxOffsets(ctx context.Context) (int64, int64, error)
+	// DeleteOutboxEventsBefore deletes the published events created before the time.
+	DeleteOutboxEventsBefore(ctx context.Context, before time.Time) (int64, error)
+	// ListenOutboxEvents returns a listener woken by the commit of new events.
+	ListenOutboxEvents(ctx context.Context) (OutboxListener, error)
+}
+
+// OutboxListener holds a connection of its own listening for new events.
+type OutboxListener interface {
+	// Wait returns when a transaction inserting events has been committed or ctx is done.
+	Wait(ctx context.Context) error
+	Close()
+}
@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	CreateOutboxEvent        = "CreateOutboxEvent"
+	LockOutboxPublisher      = "LockOutboxPublisher"
+	PublishOutboxEvents      = "PublishOutboxEvents"
+	AdvanceOutboxOffset      = "AdvanceOutboxOffset"
+	GetOutboxEvents          = "GetOutboxEvents"
+	GetOutboxOffsets         = "GetOutboxOffsets"
+	DeleteOutboxEventsBefore = "DeleteOutboxEventsBefore"
+)
+
+// outboxChannel is the channel notified by the trigger on outbox_event.
+const outboxChannel = "outbox_event"
+
+// outboxPublisherLock is the key of the advisory lock serializing the numbering of the
+// events between the handler instances.
+const outboxPublisherLock = 0x6f7574626f78
+
+func NewOutboxRepository(db *pgxpool.Pool) OutboxRepository {
+	return &OutboxRepositoryImpl{Db: db}
+}
+
+type OutboxRepositoryImpl struct {
+	Db *pgxpool.Pool
+}
+
+var outboxPreparedStatements = map[string]string{
+	CreateOutboxEvent:   "INSERT INTO outbox_event(event_type, entity_id, payload) VALUES ($1, $2, $3)",
+	LockOutboxPublisher: "SELECT pg_advisory_xact_lock($1)",
+	// the statement runs after the lock has been taken, so its snapshot contains the
+	// offset advanced by the transaction holding the lock before
+	PublishOutboxEvents: "UPDATE outbox_event e SET event_offset = n.last + n.rn" +
+		" FROM (SELECT id, row_number() OVER (ORDER BY id) AS rn," +
+		" (SELECT last_offset FROM outbox_offset) AS last" +
+		" FROM outbox_event WHERE event_offset IS NULL ORDER BY id LIMIT $1) n" +
+		" WHERE e.id = n.id",
+	AdvanceOutboxOffset: "UPDATE outbox_offset SET last_offset = last_offset + $1",
+	GetOutboxEvents: "SELECT event_offset, created, event_type, entity_id, payload FROM outbox_event" +
+		" WHERE event_offset > $1 ORDER BY event_offset LIMIT $2",
+	GetOutboxOffsets: "SELECT coalesce((SELECT min(event_offset) FROM outbox_event), last_offset + 1), last_offset" +
+		" FROM outbox_offset",
+	DeleteOutboxEventsBefore: "DELETE FROM outbox_event WHERE event_offset IS NOT NULL AND created < $1",
+}
+
+func CreateOutboxPreparedStatements(ctx context.Context, conn *pgx.Conn) error {
+	return prepareStatements(ctx, conn, outboxPreparedStatements)
+}
+
+// appendOutboxEvent inserts an event with the payload as JSON. It has to run in the
+// transaction of the change, so that the event is published if and only if the change is
+// committed.
+func appendOutboxEvent(ctx context.Context, tx pgx.Tx, eventType string, entityId string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrapf(err, "Could not marshal payload of event '%s' for '%s'", eventType, entityId)
+	}
+	if _, err = tx.Exec(ctx, CreateOutboxEvent, eventType, entityId, string(data)); err != nil {
+		return errors.Wrapf(err, "Could not execute query for method: %v", CreateOutboxEvent)
+	}
+	return nil
+}
+
+// appendObjectInstanceEvent inserts the event of an object instance having got the
+// status of the payload.
+func appendObjectInstanceEvent(ctx context.Context, tx pgx.Tx, eventType string, objectInstance ObjectInstanceEvent) error {
+	if eventType == EventObjectInstanceStatusChanged && objectInstance.Status == "deprecated" {
+		eventType = EventObjectInstanceDeprecated
+	}
+	return appendOutboxEvent(ctx, tx, eventType, objectInstance.Id, objectInstance)
+}
+
+func (o *OutboxRepositoryImpl) PublishOutboxEvents(ctx context.Context, limit int) (int, error) {
+	var published int
+	err := inTransaction(ctx, o.Db, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, LockOutboxPublisher, int64(outboxPublisherLock)); err != nil {
+			return errors.Wrapf(err, "Could not execute query for method: %v", LockOutboxPublisher)
+		}
+		tag, err := tx.Exec(ctx, PublishOutboxEvents, limit)
+		if err != nil {
+			return errors.Wrapf(err, "Could not execute query for method: %v", PublishOutboxEvents)
+		}
+		published = int(tag.RowsAffected())
+		if published == 0 {
+			return nil
+		}
+		if _, err := tx.Exec(ctx, AdvanceOutboxOffset, published); err != nil {
+			return errors.Wrapf(err, "Could not execute query for method: %v", AdvanceOutboxOffset)
+		}
+		return nil
+	})
+	return published, err
+}
+
+func (o *OutboxRepositoryImpl) GetOutboxEvents(ctx context.Context, afterOffset int64, limit int) ([]OutboxEvent, error) {
+	rows, err := o.Db.Query(ctx, GetOutboxEvents, afterOffset, limit)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Could not execute query for method: %v", GetOutboxEvents)
+	}
+	defer rows.Close()
+	var events []OutboxEvent
+	for rows.Next() {
+		var event OutboxEvent
+		if err := rows.Scan(&event.Offset, &event.Created, &event.Type, &event.EntityId, &event.Payload); err != nil {
+			return nil, errors.Wrapf(err, "Could not scan rows for method: %v", GetOutboxEvents)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "Could not read rows for method: %v", GetOutboxEvents)
+	}
+	return events, nil
+}
+
+func (o *OutboxRepositoryImpl) GetOutboxOffsets(ctx context.Context) (int64, int64, error) {
+	var first, last int64
+	if err := o.Db.QueryRow(ctx, GetOutboxOffsets).Scan(&first, &last); err != nil {
+		return 0, 0, errors.Wrapf(err, "Could not execute query for method: %v", GetOutboxOffsets)
+	}
+	return first, last, nil
+}
+
+func (o *OutboxRepositoryImpl) DeleteOutboxEventsBefore(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := o.Db.Exec(ctx, DeleteOutboxEventsBefore, before)
+	if err != nil {
+		return 0, errors.Wrapf(err, "Could not execute query for method: %v", DeleteOutboxEventsBefore)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// ListenOutboxEvents takes a connection out of the pool, because the notifications are
+// delivered to the connection that has issued the LISTEN.
+func (o *OutboxRepositoryImpl) ListenOutboxEvents(ctx context.Context) (OutboxListener, error) {
+	pooled, err := o.Db.Acquire(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Could not acquire connection to listen for outbox events")
+	}
+	conn := pooled.Hijack()
+	if _, err := conn.Exec(ctx, "LISTEN "+outboxChannel); err != nil {
+		conn.Close(context.Background())
+		return nil, errors.Wrapf(err, "Could not listen for outbox events")
+	}
+	return &outboxListener{conn: conn}, nil
+}
+
+type outboxListener struct {
+	conn *pgx.Conn
+}
+
+func (l *outboxListener) Wait(ctx context.Context) error {
+	_, err := l.conn.WaitForNotification(ctx)
+	return err
+}
+
+func (l *outboxListener) Close() {
+	l.conn.Close(context.Background())
+}
@@ -0,0 +1,275 @@
+package repository
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/ocfl-archive/dlza-manager/models"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// sortColumns whitelists the sort keys a listing accepts. Keys are the values the
+// clients send in models.Pagination.SortKey, values are the SQL expressions used in
+// the order by clause. Tiebreak is a unique expression appended to every order by so
+// that rows with equal sort values always come in the same order across pages.
+type sortColumns struct {
+	columns  map[string]string
+	fallback string
+	tiebreak string
+}
+
+func (s sortColumns) orderBy(sortKey string, sortDirection string) (string, error) {
+	if sortKey == "" {
+		sortKey = s.fallback
+	}
+	column, ok := s.columns[sortKey]
+	if !ok {
+		return "", errors.Wrapf(ErrInvalidArgument, "sort key '%s' is not allowed", sortKey)
+	}
+	direction := "asc"
+	switch strings.ToLower(sortDirection) {
+	case "", "asc":
+	case "desc":
+		direction = "desc"
+	default:
+		return "", errors.Wrapf(ErrInvalidArgument, "sort direction '%s' is not allowed", sortDirection)
+	}
+	if column == s.tiebreak {
+		return column + " " + direction, nil
+	}
+	return column + " " + direction + ", " + s.tiebreak + " " + direction, nil
+}
+
+func newSortColumns(fallback string, tiebreak string, columns map[string]string) sortColumns {
+	return sortColumns{columns: columns, fallback: fallback, tiebreak: tiebreak}
+}
+
+// columnsWithPrefix maps every column name to itself qualified with the table alias.
+func columnsWithPrefix(prefix string, columns ...string) map[string]string {
+	qualified := make(map[string]string, len(columns))
+	for _, column := range columns {
+		qualified[column] = prefix + column
+	}
+	return qualified
+}
+
+// queryBuilder collects the conditions of a paginated query and binds every value
+// supplied by the client as a query argument.
+type queryBuilder struct {
+	conditions []string
+	args       []any
+}
+
+// bind adds the value to the arguments and returns its placeholder.
+func (q *queryBuilder) bind(value any) string {
+	q.args = append(q.args, value)
+	return "$" + strconv.Itoa(len(q.args))
+}
+
+func (q *queryBuilder) where(condition string) {
+	q.conditions = append(q.conditions, condition)
+}
+
+func (q *queryBuilder) whereEquals(column string, value any) {
+	q.where(column + " = " + q.bind(value))
+}
+
+// whereIn binds the values as a text array, so that the same condition works for
+// uuid columns without relying on element wise conversion of the driver.
+func (q *queryBuilder) whereIn(column string, values []string) {
+	q.where(column + " = any((" + q.bind(values) + "::text[])::uuid[])")
+}
+
+// whereSearch matches the search key case-insensitively as a prefix of the id columns
+// and as a part of the text columns.
+func (q *queryBuilder) whereSearch(searchKey string, idColumns []string, textColumns []string) {
+	if searchKey == "" {
+		return
+	}
+	escaped := strings.ToLower(escapeLike(searchKey))
+	var alternatives []string
+	if len(idColumns) != 0 {
+		prefix := q.bind(escaped + "%")
+		for _, column := range idColumns {
+			alternatives = append(alternatives, "lower("+column+"::text) like "+prefix)
+		}
+	}
+	if len(textColumns) != 0 {
+		contains := q.bind("%" + escaped + "%")
+		for _, column := range textColumns {
+			alternatives = append(alternatives, "lower("+column+"::text) like "+contains)
+		}
+	}
+	q.where("(" + strings.Join(alternatives, " or ") + ")")
+}
+
+// whereTenantScope restricts the query to the entity with pagination.Id and to the
+// tenants the caller is allowed to see. A SecondId narrows the allowed tenants to
+// that single tenant.
+func (q *queryBuilder) whereTenantScope(pagination models.Pagination, idColumn string, tenantColumn string) {
+	allowedTenants := pagination.AllowedTenants
+	if pagination.SecondId != "" {
+		if len(allowedTenants) == 0 || slices.Contains(allowedTenants, pagination.SecondId) {
+			allowedTenants = []string{pagination.SecondId}
+		}
+	}
+	if pagination.Id != "" {
+		q.whereEquals(idColumn, pagination.Id)
+	}
+	if len(allowedTenants) != 0 {
+		q.whereIn(tenantColumn, allowedTenants)
+	}
+}
+
+func (q *queryBuilder) whereClause() string {
+	if len(q.conditions) == 0 {
+		return ""
+	}
+	return " where " + strings.Join(q.conditions, " and ")
+}
+
+// page returns the order by, limit and offset clause for the pagination together with
+// the arguments for the whole query. The arguments of the builder stay untouched, so
+// they can still be used for the count query.
+func (q *queryBuilder) page(pagination models.Pagination, sort sortColumns) (string, []any, error) {
+	orderBy, err := sort.orderBy(pagination.SortKey, pagination.SortDirection)
+	if err != nil {
+		return "", nil, err
+	}
+	args := slices.Clone(q.args)
+	args = append(args, pagination.Take, pagination.Skip)
+	return " order by " + orderBy + " limit $" + strconv.Itoa(len(args)-1) + " offset $" + strconv.Itoa(len(args)), args, nil
+}
+
+// count returns the number of rows the query yields without pagination, so that the
+// total does not depend on the page requested.
+func (q *queryBuilder) count(ctx context.Context, db *pgxpool.Pool, query string) (int, error) {
+	countQuery := "SELECT count(*) FROM (" + query + ") counted"
+	var totalItems int
+	err := db.QueryRow(ctx, countQuery, q.args...).Scan(&totalItems)
+	if err != nil {
+		return 0, errors.Wrapf(err, "Could not scan countRow for query: %s", countQuery)
+	}
+	return totalItems, nil
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
+// KeysetPagination selects the page following the row the cursor points to. Skip is
+// ignored, the total is only counted when WithTotalItems is set. Fresh reads listings
+// backed by a materialized view from the base tables.
+type KeysetPagination struct {
+	models.Pagination
+	Cursor         string
+	WithTotalItems bool
+	Fresh          bool
+}
+
+// keysetColumn is a sort column usable for keyset pagination. The expression refers
+// to the output columns of the listing and must not be null, sqlType is the type the
+// cursor value is cast back to.
+type keysetColumn struct {
+	expression string
+	sqlType    string
+}
+
+// keysetColumns whitelists the sort keys of a keyset listing. Id is the unique column
+// the seek falls back to for rows with equal sort values.
+type keysetColumns struct {
+	columns  map[string]keysetColumn
+	fallback string
+	id       string
+}
+
+// cursor is the position after the last row of a page. It is handed to the clients
+// base64 encoded and carries the sort order, so that it cannot be applied to a
+// listing sorted differently.
+type cursor struct {
+	SortKey       string `json:"k"`
+	SortDirection string `json:"d"`
+	Value         string `json:"v"`
+	Id            string `json:"i"`
+}
+
+func (c cursor) encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(encoded string) (cursor, error) {
+	var c cursor
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return c, errors.Wrapf(ErrInvalidArgument, "invalid cursor: %v", err)
+	}
+	if err := json.Unmarshal(data, &c); err != nil {
+		return c, errors.Wrapf(ErrInvalidArgument, "invalid cursor: %v", err)
+	}
+	return c, nil
+}
+
+// keysetQuery wraps the listing query, so that the seek and the order refer to its
+// output columns, and selects the cursor value of every row as the last column. The
+// query fetches one row more than requested to find out whether there is a next page.
+type keysetQuery struct {
+	sql       string
+	args      []any
+	sortKey   string
+	direction string
+	take      int
+}
+
+func (q *queryBuilder) keyset(query string, pagination KeysetPagination, columns keysetColumns) (keysetQuery, error) {
+	sortKey := pagination.SortKey
+	if sortKey == "" {
+		sortKey = columns.fallback
+	}
+	column, ok := columns.columns[sortKey]
+	if !ok {
+		return keysetQuery{}, errors.Wrapf(ErrInvalidArgument, "sort key '%s' is not allowed", sortKey)
+	}
+	direction, comparison := "asc", ">"
+	switch strings.ToLower(pagination.SortDirection) {
+	case "", "asc":
+	case "desc":
+		direction, comparison = "desc", "<"
+	default:
+		return keysetQuery{}, errors.Wrapf(ErrInvalidArgument, "sort direction '%s' is not allowed", pagination.SortDirection)
+	}
+	seek := queryBuilder{args: slices.Clone(q.args)}
+	if pagination.Cursor != "" {
+		after, err := decodeCursor(pagination.Cursor)
+		if err != nil {
+			return keysetQuery{}, err
+		}
+		if after.SortKey != sortKey || after.SortDirection != direction {
+			return keysetQuery{}, errors.Wrapf(ErrInvalidArgument, "cursor does not match sort key '%s' and direction '%s'", sortKey, direction)
+		}
+		seek.where(fmt.Sprintf("(%s, %s) %s (%s::%s, %s::uuid)", column.expression, columns.id, comparison,
+			seek.bind(after.Value), column.sqlType, seek.bind(after.Id)))
+	}
+	take := pagination.Take
+	if take < 1 {
+		take = 1
+	}
+	sql := fmt.Sprintf("SELECT listing.*, (%s)::text FROM (%s) listing%s order by %s %s, %s %s limit %s",
+		column.expression, query, seek.whereClause(), column.expression, direction, columns.id, direction, seek.bind(take+1))
+	return keysetQuery{sql: sql, args: seek.args, sortKey: sortKey, direction: direction, take: take}, nil
+}
+
+// hasNext tells whether the row fetched as number fetched belongs to the next page.
+func (k keysetQuery) hasNext(fetched int) bool {
+	return fetched > k.take
+}
+
+// cursorAfter returns the cursor pointing behind the row with the sort value and id.
+func (k keysetQuery) cursorAfter(value string, id string) string {
+	return cursor{SortKey: k.sortKey, SortDirection: k.direction, Value: value, Id: id}.encode()
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// PartitionProvisioning configures the partitions created on a storage location when no
+// partition has room for an object.
+type PartitionProvisioning struct {
+	StorageLocationId string
+	Enabled           bool
+	// MaxSize and MaxObjects are the limits of the partitions created.
+	MaxSize    int64
+	MaxObjects int64
+	// MaxPartitions is the number of partitions of the location up to which partitions are created.
+	MaxPartitions int
+}
+
+type PlacementRepository interface {
+	// GetPlacementStrategy returns the strategy configured for the location, empty if
+	// none is configured.
+	GetPlacementStrategy(ctx context.Context, storageLocationId string) (string, error)
+	SetPlacementStrategy(ctx context.Context, storageLocationId string, strategy string) error
+	// GetPartitionProvisioning returns the provisioning configured for the location,
+	// disabled if none is configured.
+	GetPartitionProvisioning(ctx context.Context, storageLocationId string) (PartitionProvisioning, error)
+	SetPartitionProvisioning(ctx context.Context, provisioning PartitionProvisioning) error
+	// GetPlacementStrategyTx and GetPartitionProvisioningTx lock the storage location until
+	// the transaction ends, so the configuration read stays the one replaced.
+	GetPlacementStrategyTx(ctx context.Context, tx pgx.Tx, storageLocationId string) (string, error)
+	SetPlacementStrategyTx(ctx context.Context, tx pgx.Tx, storageLocationId string, strategy string) error
+	GetPartitionProvisioningTx(ctx context.Context, tx pgx.Tx, storageLocationId string) (PartitionProvisioning, error)
+	SetPartitionProvisioningTx(ctx context.Context, tx pgx.Tx, provisioning PartitionProvisioning) error
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	GetPlacementStrategy     = "GetPlacementStrategy"
+	SetPlacementStrategy     = "SetPlacementStrategy"
+	GetPartitionProvisioning = "GetPartitionProvisioning"
+	SetPartitionProvisioning = "SetPartitionProvisioning"
+)
+
+func NewPlacementRepository(db *pgxpool.Pool) PlacementRepository {
+	return &PlacementRepositoryImpl{Db: db}
+}
+
+type PlacementRepositoryImpl struct {
+	Db *pgxpool.Pool
+}
+
+var placementPreparedStatements = map[string]string{
+	GetPlacementStrategy: "SELECT strategy FROM storage_location_placement WHERE storage_location_id = $1",
+	SetPlacementStrategy: "INSERT INTO storage_location_placement(storage_location_id, strategy) VALUES ($1, $2)" +
+		" ON CONFLICT (storage_location_id) DO UPDATE SET strategy = excluded.strategy",
+	GetPartitionProvisioning: "SELECT enabled, max_size, max_objects, max_partitions FROM storage_location_provisioning" +
+		" WHERE storage_location_id = $1",
+	SetPartitionProvisioning: "INSERT INTO storage_location_provisioning(storage_location_id, enabled, max_size, max_objects, max_partitions)" +
+		" VALUES ($1, $2, $3, $4, $5) ON CONFLICT (storage_location_id) DO UPDATE SET enabled = excluded.enabled," +
+		" max_size = excluded.max_size, max_objects = excluded.max_objects, max_partitions = excluded.max_partitions",
+}
+
+func CreatePlacementPreparedStatements(ctx context.Context, conn *pgx.Conn) error {
+	return prepareStatements(ctx, conn, placementPreparedStatements)
+}
+
+func (p *PlacementRepositoryImpl) GetPlacementStrategy(ctx context.Context, storageLocationId string) (string, error) {
+	return getPlacementStrategy(ctx, p.Db, storageLocationId)
+}
+
+func (p *PlacementRepositoryImpl) GetPlacementStrategyTx(ctx context.Context, tx pgx.Tx, storageLocationId string) (string, error) {
+	if err := lockStorageLocation(ctx, tx, storageLocationId); err != nil {
+		return "", err
+	}
+	return getPlacementStrategy(ctx, tx, storageLocationId)
+}
+
+func getPlacementStrategy(ctx context.Context, db querier, storageLocationId string) (string, error) {
+	var strategy string
+	err := db.QueryRow(ctx, GetPlacementStrategy, storageLocationId).Scan(&strategy)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return "", errors.Wrapf(err, "Could not execute query for method: %v", GetPlacementStrategy)
+	}
+	return strategy, nil
+}
+
+func (p *PlacementRepositoryImpl) SetPlacementStrategy(ctx context.Context, storageLocationId string, strategy string) error {
+	return setPlacementStrategy(ctx, p.Db, storageLocationId, strategy)
+}
+
+func (p *PlacementRepositoryImpl) SetPlacementStrategyTx(ctx context.Context, tx pgx.Tx, storageLocationId string, strategy string) error {
+	return setPlacementStrategy(ctx, tx, storageLocationId, strategy)
+}
+
+func setPlacementStrategy(ctx context.Context, db querier, storageLocationId string, strategy string) error {
+	if _, err := db.Exec(ctx, SetPlacementStrategy, storageLocationId, strategy); err != nil {
+		return errors.Wrapf(err, "Could not execute query for method: %v", SetPlacementStrategy)
+	}
+	return nil
+}
+
+func (p *PlacementRepositoryImpl) GetPartitionProvisioning(ctx context.Context, storageLocationId string) (PartitionProvisioning, error) {
+	return getPartitionProvisioning(ctx, p.Db, storageLocationId)
+}
+
+func (p *PlacementRepositoryImpl) GetPartitionProvisioningTx(ctx context.Context, tx pgx.Tx, storageLocationId string) (PartitionProvisioning, error) {
+	if err := lockStorageLocation(ctx, tx, storageLocationId); err != nil {
+		return PartitionProvisioning{StorageLocationId: storageLocationId}, err
+	}
+	return getPartitionProvisioning(ctx, tx, storageLocationId)
+}
+
+func getPartitionProvisioning(ctx context.Context, db querier, storageLocationId string) (PartitionProvisioning, error) {
+	provisioning := PartitionProvisioning{StorageLocationId: storageLocationId}
+	err := db.QueryRow(ctx, GetPartitionProvisioning, storageLocationId).Scan(&provisioning.Enabled, &provisioning.MaxSize,
+		&provisioning.MaxObjects, &provisioning.MaxPartitions)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return provisioning, errors.Wrapf(err, "Could not execute query for method: %v", GetPartitionProvisioning)
+	}
+	return provisioning, nil
+}
+
+func (p *PlacementRepositoryImpl) SetPartitionProvisioning(ctx context.Context, provisioning PartitionProvisioning) error {
+	return setPartitionProvisioning(ctx, p.Db, provisioning)
+}
+
+func (p *PlacementRepositoryImpl) SetPartitionProvisioningTx(ctx context.Context, tx pgx.Tx, provisioning PartitionProvisioning) error {
+	return setPartitionProvisioning(ctx, tx, provisioning)
+}
+
+func setPartitionProvisioning(ctx context.Context, db querier, provisioning PartitionProvisioning) error {
+	_, err := db.Exec(ctx, SetPartitionProvisioning, provisioning.StorageLocationId, provisioning.Enabled, provisioning.MaxSize,
+		provisioning.MaxObjects, provisioning.MaxPartitions)
+	if err != nil {
+		return errors.Wrapf(err, "Could not execute query for method: %v", SetPartitionProvisioning)
+	}
+	return nil
+}
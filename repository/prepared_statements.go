@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/jackc/pgx/v5"
+)
+
+func prepareStatements(ctx context.Context, conn *pgx.Conn, statements map[string]string) error {
+	for name, sqlStm := range statements {
+		if _, err := conn.Prepare(ctx, name, sqlStm); err != nil {
+			return errors.Wrapf(err, "cannot prepare statement '%s' - '%s'", name, sqlStm)
+		}
+	}
+	return nil
+}
+
+// columnType lists the postgres types a scan destination accepts.
+type columnType []string
+
+var (
+	textType      = columnType{"text", "varchar", "bpchar"}
+	textArrayType = columnType{"_text", "_varchar"}
+	integerType   = columnType{"int2", "int4", "int8", "numeric"}
+	uuidType      = columnType{"uuid"}
+	boolType      = columnType{"bool"}
+	timestampType = columnType{"timestamptz", "timestamp"}
+	dateType      = columnType{"date"}
+)
+
+type expectedColumn struct {
+	name     string
+	dataType columnType
+}
+
+func withColumns(columns []expectedColumn, additional ...expectedColumn) []expectedColumn {
+	return append(slices.Clone(columns), additional...)
+}
+
+// The columns the repositories scan by position, in the order of the scans.
+var (
+	tenantColumns = []expectedColumn{{"name", textType}, {"alias", textType}, {"person", textType}, {"email", textType},
+		{"id", uuidType}, {"api_key_id", uuidType}}
+	collectionColumns = []expectedColumn{{"alias", textType}, {"description", textType}, {"owner", textType},
+		{"owner_mail", textType}, {"name", textType}, {"quality", integerType}, {"tenant_id", uuidType}, {"id", uuidType}}
+	objectColumns = []expectedColumn{{"signature", textType}, {"sets", textArrayType}, {"identifiers", textArrayType},
+		{"title", textType}, {"alternative_titles", textArrayType}, {"description", textType}, {"keywords", textArrayType},
+		{"references", textArrayType}, {"ingest_workflow", textType}, {"user", textType}, {"address", textType},
+		{"created", timestampType}, {"last_changed", timestampType}, {"size", integerType}, {"id", uuidType},
+		{"collection_id", uuidType}, {"checksum", textType}, {"authors", textArrayType}, {"holding", textType},
+		{"expiration", dateType}, {"head", textType}, {"versions", textType}, {"binary", boolType}}
+	objectInstanceColumns = []expectedColumn{{"path", textType}, {"size", integerType}, {"created", timestampType},
+		{"status", textType}, {"id", uuidType}, {"storage_partition_id", uuidType}, {"object_id", uuidType}}
+	objectInstanceCheckColumns = []expectedColumn{{"checktime", timestampType}, {"error", boolType}, {"message", textType},
+		{"id", uuidType}, {"object_instance_id", uuidType}, {"check_type", textType}}
+	fileTableColumns = []expectedColumn{{"checksum", textType}, {"name", textArrayType}, {"size", integerType},
+		{"mime_type", textType}, {"pronom", textType}, {"width", integerType}, {"height", integerType},
+		{"duration", integerType}, {"id", uuidType}, {"object_id", uuidType}}
+	archivingStatusColumns = []expectedColumn{{"id", uuidType}, {"last_changed", timestampType}, {"status", textType}}
+	storageLocationColumns = []expectedColumn{{"alias", textType}, {"type", textType}, {"vault", textType},
+		{"connection", textType}, {"quality", integerType}, {"price", integerType}, {"security_compliency", textType},
+		{"fill_first", boolType}, {"ocfl_type", textType}, {"tenant_id", uuidType}, {"id", uuidType},
+		{"number_of_threads", integerType}, {"group", textType}}
+	storagePartitionColumns = []expectedColumn{{"alias", textType}, {"name", textType}, {"max_size", integerType},
+		{"max_objects", integerType}, {"current_size", integerType}, {"current_objects", integerType}, {"id", uuidType},
+		{"storage_location_id", uuidType}}
+	storagePartitionGroupElementColumns = []expectedColumn{{"partition_group_id", uuidType}, {"alias", textType},
+		{"id", uuidType}, {"name", textType}}
+)
+
+// statementColumns are the result columns of the prepared statements selecting whole
+// rows. The queries built at runtime on the same tables rely on the same order, so a
+// match of the prepared statements covers them as well.
+var statementColumns = map[string][]expectedColumn{
+	FindAllTenants:           tenantColumns,
+	FindTenantById:           tenantColumns,
+	GetCollectionsByTenantId: collectionColumns,
+	GetCollectionByAlias:     collectionColumns,
+	GetCollectionById:        collectionColumns,
+	GetCollectionByIdFromMv: withColumns(collectionColumns, expectedColumn{"total_file_size", integerType},
+		expectedColumn{"total_file_count", integerType}, expectedColumn{"total_object_count", integerType}),
+	GetObjectById:                               objectColumns,
+	GetObjectBySignature:                        objectColumns,
+	GetObjectsByCollectionAlias:                 objectColumns,
+	GetObjectBySignatureAndStorageLocationGroup: objectColumns,
+	GetObjectInstance:                           objectInstanceColumns,
+	GetObjectInstancesByObjectId:                objectInstanceColumns,
+	GetObjectInstancesByObjectIdPositive:        objectInstanceColumns,
+	GetAllObjectInstances:                       objectInstanceColumns,
+	GetObjectInstanceCheckById:                  objectInstanceCheckColumns,
+	GetFileById:                                 fileTableColumns,
+	CheckStatus:                                 archivingStatusColumns,
+	GetAllStorageLocations:                      storageLocationColumns,
+	GetStorageLocationsByTenantId:               storageLocationColumns,
+	GetStorageLocationsByTenantIdAndGroup:       storageLocationColumns,
+	GetStorageLocationByObjectInstanceId:        storageLocationColumns,
+	GetStorageLocationsByObjectId:               storageLocationColumns,
+	GetStorageLocationById: withColumns(storageLocationColumns, expectedColumn{"total_file_size", integerType},
+		expectedColumn{"total_existing_volume", integerType}),
+	GetStoragePartition:                                  storagePartitionColumns,
+	GetStoragePartitionsByLocationId:                     storagePartitionColumns,
+	GetStoragePartitionByObjectSignatureAndLocation:      storagePartitionColumns,
+	GetStoragePartitionGroupElementByAlias:               storagePartitionGroupElementColumns,
+	GetStoragePartitionGroupElementById:                  storagePartitionGroupElementColumns,
+	GetStoragePartitionGroupElementsByStoragePartitionId: storagePartitionGroupElementColumns,
+}
+
+// preparedStatements are the statements of all repositories by name.
+func preparedStatements() map[string]string {
+	statements := make(map[string]string)
+	for _, repositoryStatements := range []map[string]string{tenantPreparedStatements, collectionPreparedStatements,
+		objectPreparedStatements, objectInstancePreparedStatements, filePreparedStatements, objectInstanceCheckPreparedStatements,
+		storageLocationPreparedStatements, storagePartitionPreparedStatements, dispatcherPreparedStatements, statusPreparedStatements,
+		statisticsPreparedStatements, auditPreparedStatements, outboxPreparedStatements,
+		reservationPreparedStatements, placementPreparedStatements} {
+		for name, sqlStm := range repositoryStatements {
+			statements[name] = sqlStm
+		}
+	}
+	return statements
+}
+
+// VerifyPreparedStatements describes the prepared statements selecting whole rows and
+// compares their result columns with the columns the repositories scan. All
+// mismatches are reported in a single error, so that a schema change that would
+// corrupt the positional scans is found at startup instead of at the first request.
+func VerifyPreparedStatements(ctx context.Context, conn *pgx.Conn) error {
+	statements := preparedStatements()
+	names := make([]string, 0, len(statementColumns))
+	for name := range statementColumns {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	var mismatches []string
+	for _, name := range names {
+		sqlStm, ok := statements[name]
+		if !ok {
+			mismatches = append(mismatches, fmt.Sprintf("%s: statement is not prepared", name))
+			continue
+		}
+		description, err := conn.Prepare(ctx, name, sqlStm)
+		if err != nil {
+			mismatches = append(mismatches, fmt.Sprintf("%s: cannot describe statement: %v", name, err))
+			continue
+		}
+		expected := statementColumns[name]
+		if len(description.Fields) != len(expected) {
+			mismatches = append(mismatches, fmt.Sprintf("%s: statement returns %d columns, expected %d", name, len(description.Fields), len(expected)))
+			continue
+		}
+		for i, field := range description.Fields {
+			typeName := fmt.Sprintf("oid %d", field.DataTypeOID)
+			if dataType, ok := conn.TypeMap().TypeForOID(field.DataTypeOID); ok {
+				typeName = dataType.Name
+			}
+			if field.Name != expected[i].name {
+				mismatches = append(mismatches, fmt.Sprintf("%s: column %d is '%s', expected '%s'", name, i+1, field.Name, expected[i].name))
+			} else if !slices.Contains(expected[i].dataType, typeName) {
+				mismatches = append(mismatches, fmt.Sprintf("%s: column '%s' has type %s, expected one of %s", name, field.Name, typeName,
+					strings.Join(expected[i].dataType, ", ")))
+			}
+		}
+	}
+	if len(mismatches) != 0 {
+		return errors.Errorf("database schema does not match the repositories:\n\t%s", strings.Join(mismatches, "\n\t"))
+	}
+	return nil
+}
@@ -1,6 +1,23 @@
 package repository
 
+import (
+	"context"
+	"time"
+)
+
+// Materialized views refreshed by RefreshMaterializedView.
+const (
+	MatCollObj        = "mat_coll_obj"
+	MatCollObjFile    = "mat_coll_obj_file"
+	MatTenantFileJoin = "mat_tenant_file_join"
+)
+
+// MaterializedViews lists all materialized views in the order they have to be refreshed in.
+var MaterializedViews = []string{MatCollObj, MatCollObjFile, MatTenantFileJoin}
+
 type RefreshMaterializedViewsRepository interface {
-	RefreshMaterializedViews() error
-	RefreshMaterializedViewsFromCollectionToFile() error
+	RefreshMaterializedViews(ctx context.Context) error
+	RefreshMaterializedViewsFromCollectionToFile(ctx context.Context) error
+	RefreshMaterializedView(ctx context.Context, view string) (time.Time, error)
+	GetMaterializedViewRefreshes(ctx context.Context) (map[string]time.Time, error)
 }
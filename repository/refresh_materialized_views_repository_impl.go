@@ -4,35 +4,43 @@ import (
 	"context"
 	"emperror.dev/errors"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"time"
 )
 
+// refreshFunctions are the database functions refreshing the materialized views.
+var refreshFunctions = map[string]string{
+	MatCollObj:        "refresh_mvw1",
+	MatCollObjFile:    "refresh_mvw2",
+	MatTenantFileJoin: "refresh_mvw3",
+}
+
 type RefreshMaterializedViewsRepositoryImpl struct {
 	Db *pgxpool.Pool
 }
 
-func (r RefreshMaterializedViewsRepositoryImpl) RefreshMaterializedViews() error {
+func (r RefreshMaterializedViewsRepositoryImpl) RefreshMaterializedViews(ctx context.Context) error {
 	queryMatColObj := "select refresh_mvw1()"
-	_, err := r.Db.Exec(context.Background(), queryMatColObj)
+	_, err := r.Db.Exec(ctx, queryMatColObj)
 	if err != nil {
 		return errors.Wrapf(err, "Could not RefreshMaterializedViews query: '%s'", queryMatColObj)
 	}
 	queryMatColObjFile := "select refresh_mvw2()"
-	_, err = r.Db.Exec(context.Background(), queryMatColObjFile)
+	_, err = r.Db.Exec(ctx, queryMatColObjFile)
 	if err != nil {
 		return errors.Wrapf(err, "Could not RefreshMaterializedViews query: '%s'", queryMatColObjFile)
 	}
 	queryMatTenantFile := "select refresh_mvw3()"
-	_, err = r.Db.Exec(context.Background(), queryMatTenantFile)
+	_, err = r.Db.Exec(ctx, queryMatTenantFile)
 	if err != nil {
 		return errors.Wrapf(err, "Could not RefreshMaterializedViews query: '%s'", queryMatTenantFile)
 	}
 	return nil
 }
 
-func (r RefreshMaterializedViewsRepositoryImpl) RefreshMaterializedViewsFromCollectionToFile() error {
+func (r RefreshMaterializedViewsRepositoryImpl) RefreshMaterializedViewsFromCollectionToFile(ctx context.Context) error {
 
 	queryMatColObjFile := "select refresh_mvw2()"
-	_, err := r.Db.Exec(context.Background(), queryMatColObjFile)
+	_, err := r.Db.Exec(ctx, queryMatColObjFile)
 	if err != nil {
 		return errors.Wrapf(err, "Could not RefreshMaterializedViews query: '%s'", queryMatColObjFile)
 	}
@@ -40,6 +48,47 @@ func (r RefreshMaterializedViewsRepositoryImpl) RefreshMaterializedViewsFromColl
 	return nil
 }
 
+// RefreshMaterializedView refreshes the view and stores the time the refresh started,
+// which is returned as well.
+func (r RefreshMaterializedViewsRepositoryImpl) RefreshMaterializedView(ctx context.Context, view string) (time.Time, error) {
+	function, ok := refreshFunctions[view]
+	if !ok {
+		return time.Time{}, errors.Wrapf(ErrInvalidArgument, "unknown materialized view '%s'", view)
+	}
+	started := time.Now()
+	query := "select " + function + "()"
+	_, err := r.Db.Exec(ctx, query)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "Could not RefreshMaterializedView query: '%s'", query)
+	}
+	queryRefreshed := "INSERT INTO materialized_view_refresh(view, refreshed) VALUES ($1, $2)" +
+		" ON CONFLICT (view) DO UPDATE SET refreshed = excluded.refreshed"
+	_, err = r.Db.Exec(ctx, queryRefreshed, view, started)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "Could not execute query: '%s'", queryRefreshed)
+	}
+	return started, nil
+}
+
+func (r RefreshMaterializedViewsRepositoryImpl) GetMaterializedViewRefreshes(ctx context.Context) (map[string]time.Time, error) {
+	query := "SELECT view, refreshed FROM materialized_view_refresh"
+	rows, err := r.Db.Query(ctx, query)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Could not execute query: '%s'", query)
+	}
+	defer rows.Close()
+	refreshes := make(map[string]time.Time)
+	for rows.Next() {
+		var view string
+		var refreshed time.Time
+		if err := rows.Scan(&view, &refreshed); err != nil {
+			return nil, errors.Wrapf(err, "Could not scan rows for query: '%s'", query)
+		}
+		refreshes[view] = refreshed
+	}
+	return refreshes, nil
+}
+
 func NewRefreshMaterializedViewsRepository(db *pgxpool.Pool) RefreshMaterializedViewsRepository {
 	return RefreshMaterializedViewsRepositoryImpl{Db: db}
 }
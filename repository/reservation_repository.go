@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/ocfl-archive/dlza-manager/models"
+)
+
+// PartitionReservation claims size on a partition of the location for the object with
+// the signature, placed for the location group, for the time to live.
+type PartitionReservation struct {
+	StorageLocationId string
+	Signature         string
+	LocationGroup     string
+	Size              int64
+	TTL               time.Duration
+}
+
+type ReservationRepository interface {
+	// ReserveStoragePartition locks the location, so that concurrent placements on the
+	// location wait for each other, and passes its partitions to choose with the active
+	// reservations added to their current size and objects. If choose finds no partition
+	// and provision is not nil, the partition returned by provision is created with the
+	// next alias of the location, as by CreateStoragePartitionWithNextAlias. The
+	// reservation is stored on the partition chosen or created and replaces an earlier
+	// reservation of the object for the location group. The result is false if no
+	// partition has been found.
+	ReserveStoragePartition(ctx context.Context, reservation PartitionReservation,
+		choose func(partitions []models.StoragePartition) (models.StoragePartition, bool),
+		provision func(partitions []models.StoragePartition) (models.StoragePartition, bool)) (models.StoragePartition, bool, error)
+	// ReleaseStoragePartitionReservation deletes the reservation of the object on the partition.
+	ReleaseStoragePartitionReservation(ctx context.Context, storagePartitionId string, signature string) error
+	ReleaseStoragePartitionReservationTx(ctx context.Context, tx pgx.Tx, storagePartitionId string, signature string) error
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/ocfl-archive/dlza-manager/models"
+)
+
+const (
+	DeleteExpiredReservations    = "DeleteExpiredReservations"
+	LockStorageLocation          = "LockStorageLocation"
+	GetReservedStoragePartitions = "GetReservedStoragePartitions"
+	DeleteReservationsOfObject   = "DeleteReservationsOfObject"
+	CreateReservation            = "CreateReservation"
+	DeleteReservation            = "DeleteReservation"
+)
+
+func NewReservationRepository(db *pgxpool.Pool) ReservationRepository {
+	return &ReservationRepositoryImpl{Db: db}
+}
+
+type ReservationRepositoryImpl struct {
+	Db *pgxpool.Pool
+}
+
+var reservationPreparedStatements = map[string]string{
+	DeleteExpiredReservations: "DELETE FROM storage_partition_reservation WHERE expires < now()",
+	// NO KEY UPDATE does not block the partitions referencing the location, locking the
+	// location instead of its partitions also serializes placements on a location without
+	// partitions
+	LockStorageLocation: "SELECT id FROM storage_location WHERE id = $1 FOR NO KEY UPDATE",
+	GetReservedStoragePartitions: "SELECT sp.alias, sp.name, sp.max_size, sp.max_objects," +
+		" sp.current_size + coalesce(r.size, 0), sp.current_objects + coalesce(r.objects, 0), sp.id, sp.storage_location_id" +
+		" FROM storage_partition sp LEFT JOIN (SELECT storage_partition_id, sum(size) AS size, count(*) AS objects" +
+		" FROM storage_partition_reservation WHERE expires >= now() GROUP BY storage_partition_id) r" +
+		" ON r.storage_partition_id = sp.id WHERE sp.storage_location_id = $1",
+	DeleteReservationsOfObject: "DELETE FROM storage_partition_reservation WHERE signature = $1 AND location_group = $2",
+	CreateReservation: "INSERT INTO storage_partition_reservation(storage_partition_id, signature, location_group, size, expires)" +
+		" VALUES ($1, $2, $3, $4, now() + make_interval(secs => $5))",
+	DeleteReservation: "DELETE FROM storage_partition_reservation WHERE storage_partition_id = $1 AND signature = $2",
+}
+
+func CreateReservationPreparedStatements(ctx context.Context, conn *pgx.Conn) error {
+	return prepareStatements(ctx, conn, reservationPreparedStatements)
+}
+
+// lockStorageLocation serializes the changes of the storage location until the transaction ends.
+func lockStorageLocation(ctx context.Context, tx pgx.Tx, storageLocationId string) error {
+	if _, err := tx.Exec(ctx, LockStorageLocation, storageLocationId); err != nil {
+		return errors.Wrapf(err, "Could not execute query for method: %v", LockStorageLocation)
+	}
+	return nil
+}
+
+func (r *ReservationRepositoryImpl) ReserveStoragePartition(ctx context.Context, reservation PartitionReservation,
+	choose func(partitions []models.StoragePartition) (models.StoragePartition, bool),
+	provision func(partitions []models.StoragePartition) (models.StoragePartition, bool)) (models.StoragePartition, bool, error) {
+	var chosen models.StoragePartition
+	var ok bool
+	err := inTransaction(ctx, r.Db, func(tx pgx.Tx) error {
+		if err := lockStorageLocation(ctx, tx, reservation.StorageLocationId); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, DeleteExpiredReservations); err != nil {
+			return errors.Wrapf(err, "Could not execute query for method: %v", DeleteExpiredReservations)
+		}
+		if _, err := tx.Exec(ctx, DeleteReservationsOfObject, reservation.Signature, reservation.LocationGroup); err != nil {
+			return errors.Wrapf(err, "Could not execute query for method: %v", DeleteReservationsOfObject)
+		}
+		partitions, err := getReservedStoragePartitions(ctx, tx, reservation.StorageLocationId)
+		if err != nil {
+			return err
+		}
+		chosen, ok = choose(partitions)
+		if !ok && provision != nil {
+			if chosen, ok = provision(partitions); !ok {
+				return nil
+			}
+			if chosen, err = createStoragePartitionWithNextAlias(ctx, tx, chosen); err != nil {
+				return err
+			}
+		}
+		if !ok {
+			return nil
+		}
+		_, err = tx.Exec(ctx, CreateReservation, chosen.Id, reservation.Signature, reservation.LocationGroup, reservation.Size,
+			reservation.TTL.Seconds())
+		if err != nil {
+			return errors.Wrapf(err, "Could not execute query for method: %v", CreateReservation)
+		}
+		return nil
+	})
+	if err != nil {
+		return models.StoragePartition{}, false, err
+	}
+	return chosen, ok, nil
+}
+
+func getReservedStoragePartitions(ctx context.Context, tx pgx.Tx, storageLocationId string) ([]models.StoragePartition, error) {
+	rows, err := tx.Query(ctx, GetReservedStoragePartitions, storageLocationId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Could not execute query for method: %v", GetReservedStoragePartitions)
+	}
+	defer rows.Close()
+	var partitions []models.StoragePartition
+	for rows.Next() {
+		var partition models.StoragePartition
+		err := rows.Scan(&partition.Alias, &partition.Name, &partition.MaxSize, &partition.MaxObjects, &partition.CurrentSize,
+			&partition.CurrentObjects, &partition.Id, &partition.StorageLocationId)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Could not scan rows for method: %v", GetReservedStoragePartitions)
+		}
+		partitions = append(partitions, partition)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "Could not read rows for method: %v", GetReservedStoragePartitions)
+	}
+	return partitions, nil
+}
+
+func (r *ReservationRepositoryImpl) ReleaseStoragePartitionReservation(ctx context.Context, storagePartitionId string, signature string) error {
+	return releaseStoragePartitionReservation(ctx, r.Db, storagePartitionId, signature)
+}
+
+func (r *ReservationRepositoryImpl) ReleaseStoragePartitionReservationTx(ctx context.Context, tx pgx.Tx, storagePartitionId string, signature string) error {
+	return releaseStoragePartitionReservation(ctx, tx, storagePartitionId, signature)
+}
+
+func releaseStoragePartitionReservation(ctx context.Context, db querier, storagePartitionId string, signature string) error {
+	if storagePartitionId == "" {
+		return nil
+	}
+	if _, err := db.Exec(ctx, DeleteReservation, storagePartitionId, signature); err != nil {
+		return errors.Wrapf(err, "Could not execute query for method: %v", DeleteReservation)
+	}
+	return nil
+}
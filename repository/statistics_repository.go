@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// StoragePartitionFill is the fill level of a storage partition.
+type StoragePartitionFill struct {
+	Id                   string
+	Alias                string
+	StorageLocationId    string
+	StorageLocationAlias string
+	CurrentSize          int64
+	MaxSize              int64
+	CurrentObjects       int64
+	MaxObjects           int64
+}
+
+// IngestHistory is the size and number of the object instances of the objects of a tenant
+// created on a storage partition on one day. Day counts the periods of 24 hours back from
+// the end of the history, 0 is the latest day.
+type IngestHistory struct {
+	StoragePartitionId string
+	TenantId           string
+	Day                int
+	Size               int64
+	Objects            int64
+}
+
+// StatisticsRepository reads the statistics of the archive exposed as metrics and used for
+// the capacity forecast.
+type StatisticsRepository interface {
+	GetObjectInstanceCountsByStatus(ctx context.Context) (map[string]int, error)
+	GetStoragePartitionFills(ctx context.Context) ([]StoragePartitionFill, error)
+	// GetIngestHistory returns the ingest of the days after since up to until, the days
+	// without ingest are left out.
+	GetIngestHistory(ctx context.Context, since time.Time, until time.Time) ([]IngestHistory, error)
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	GetObjectInstanceCountsByStatus = "GetObjectInstanceCountsByStatus"
+	GetStoragePartitionFills        = "GetStoragePartitionFills"
+	GetIngestHistory                = "GetIngestHistory"
+)
+
+func NewStatisticsRepository(db *pgxpool.Pool) StatisticsRepository {
+	return &StatisticsRepositoryImpl{Db: db}
+}
+
+type StatisticsRepositoryImpl struct {
+	Db *pgxpool.Pool
+}
+
+var statisticsPreparedStatements = map[string]string{
+	GetObjectInstanceCountsByStatus: "SELECT status, count(*) FROM object_instance GROUP BY status",
+	GetStoragePartitionFills: "SELECT sp.id, sp.alias, sl.id, sl.alias, sp.current_size, sp.max_size, sp.current_objects, sp.max_objects" +
+		" FROM storage_partition sp" +
+		" INNER JOIN storage_location sl ON sl.id = sp.storage_location_id",
+	GetIngestHistory: "SELECT oi.storage_partition_id, c.tenant_id, floor(extract(epoch FROM $2 - oi.created) / 86400)::integer," +
+		" sum(oi.size)::bigint, count(*)" +
+		" FROM object_instance oi" +
+		" INNER JOIN object o ON o.id = oi.object_id" +
+		" INNER JOIN collection c ON c.id = o.collection_id" +
+		" WHERE oi.created > $1 AND oi.created <= $2" +
+		" GROUP BY 1, 2, 3",
+}
+
+func CreateStatisticsPreparedStatements(ctx context.Context, conn *pgx.Conn) error {
+	return prepareStatements(ctx, conn, statisticsPreparedStatements)
+}
+
+func (s *StatisticsRepositoryImpl) GetObjectInstanceCountsByStatus(ctx context.Context) (map[string]int, error) {
+	rows, err := s.Db.Query(ctx, GetObjectInstanceCountsByStatus)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Could not execute query for method: %v", GetObjectInstanceCountsByStatus)
+	}
+	defer rows.Close()
+	counts := make(map[string]int)
+	for rows.Next() {
+		var status string
+		var count int
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, errors.Wrapf(err, "Could not scan rows for query: %v", GetObjectInstanceCountsByStatus)
+		}
+		counts[status] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "Could not read rows for query: %v", GetObjectInstanceCountsByStatus)
+	}
+	return counts, nil
+}
+
+func (s *StatisticsRepositoryImpl) GetStoragePartitionFills(ctx context.Context) ([]StoragePartitionFill, error) {
+	rows, err := s.Db.Query(ctx, GetStoragePartitionFills)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Could not execute query for method: %v", GetStoragePartitionFills)
+	}
+	defer rows.Close()
+	var fills []StoragePartitionFill
+	for rows.Next() {
+		var fill StoragePartitionFill
+		if err := rows.Scan(&fill.Id, &fill.Alias, &fill.StorageLocationId, &fill.StorageLocationAlias, &fill.CurrentSize, &fill.MaxSize,
+			&fill.CurrentObjects, &fill.MaxObjects); err != nil {
+			return nil, errors.Wrapf(err, "Could not scan rows for query: %v", GetStoragePartitionFills)
+		}
+		fills = append(fills, fill)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "Could not read rows for query: %v", GetStoragePartitionFills)
+	}
+	return fills, nil
+}
+
+func (s *StatisticsRepositoryImpl) GetIngestHistory(ctx context.Context, since time.Time, until time.Time) ([]IngestHistory, error) {
+	rows, err := s.Db.Query(ctx, GetIngestHistory, since, until)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Could not execute query for method: %v", GetIngestHistory)
+	}
+	defer rows.Close()
+	var history []IngestHistory
+	for rows.Next() {
+		var ingest IngestHistory
+		if err := rows.Scan(&ingest.StoragePartitionId, &ingest.TenantId, &ingest.Day, &ingest.Size, &ingest.Objects); err != nil {
+			return nil, errors.Wrapf(err, "Could not scan rows for query: %v", GetIngestHistory)
+		}
+		history = append(history, ingest)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "Could not read rows for query: %v", GetIngestHistory)
+	}
+	return history, nil
+}
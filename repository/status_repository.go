@@ -1,9 +1,18 @@
 package repository
 
-import "github.com/ocfl-archive/dlza-manager/models"
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/ocfl-archive/dlza-manager/models"
+)
 
 type StatusRepository interface {
-	CreateStatus(collection models.ArchivingStatus) (string, error)
-	CheckStatus(id string) (models.ArchivingStatus, error)
-	AlterStatus(status models.ArchivingStatus) error
+	CreateStatus(ctx context.Context, collection models.ArchivingStatus) (string, error)
+	CheckStatus(ctx context.Context, id string) (models.ArchivingStatus, error)
+	AlterStatus(ctx context.Context, status models.ArchivingStatus) error
+	CreateStatusTx(ctx context.Context, tx pgx.Tx, status models.ArchivingStatus) (string, error)
+	// CheckStatusTx returns the status and locks it until the transaction ends.
+	CheckStatusTx(ctx context.Context, tx pgx.Tx, id string) (models.ArchivingStatus, error)
+	AlterStatusTx(ctx context.Context, tx pgx.Tx, status models.ArchivingStatus) error
 }
@@ -10,9 +10,10 @@ import (
 )
 
 const (
-	CreateStatus = "CreateStatus"
-	AlterStatus  = "AlterStatus"
-	CheckStatus  = "CheckStatus"
+	CreateStatus         = "CreateStatus"
+	AlterStatus          = "AlterStatus"
+	CheckStatus          = "CheckStatus"
+	CheckStatusForUpdate = "CheckStatusForUpdate"
 )
 
 type StatusRepositoryImpl struct {
@@ -23,22 +24,27 @@ func NewStatusRepository(db *pgxpool.Pool) StatusRepository {
 	return &StatusRepositoryImpl{Db: db}
 }
 
+var statusPreparedStatements = map[string]string{
+	CreateStatus:         "INSERT INTO archiving_status(status) values($1) RETURNING id",
+	AlterStatus:          "UPDATE archiving_status set last_changed = $1, status = $2 where id =$3",
+	CheckStatus:          "SELECT * FROM archiving_status where id = $1",
+	CheckStatusForUpdate: "SELECT * FROM archiving_status where id = $1 FOR NO KEY UPDATE",
+}
+
 func CreateStatusPreparedStatements(ctx context.Context, conn *pgx.Conn) error {
-	preparedStatements := map[string]string{
-		CreateStatus: "INSERT INTO archiving_status(status) values($1) RETURNING id",
-		AlterStatus:  "UPDATE archiving_status set last_changed = $1, status = $2 where id =$3",
-		CheckStatus:  "SELECT * FROM archiving_status where id = $1",
-	}
-	for name, sqlStm := range preparedStatements {
-		if _, err := conn.Prepare(ctx, name, sqlStm); err != nil {
-			return errors.Wrapf(err, "cannot prepare statement '%s' - '%s'", name, sqlStm)
-		}
-	}
-	return nil
+	return prepareStatements(ctx, conn, statusPreparedStatements)
 }
 
-func (s *StatusRepositoryImpl) CreateStatus(status models.ArchivingStatus) (string, error) {
-	row := s.Db.QueryRow(context.Background(), CreateStatus, status.Status)
+func (s *StatusRepositoryImpl) CreateStatus(ctx context.Context, status models.ArchivingStatus) (string, error) {
+	return createStatus(ctx, s.Db, status)
+}
+
+func (s *StatusRepositoryImpl) CreateStatusTx(ctx context.Context, tx pgx.Tx, status models.ArchivingStatus) (string, error) {
+	return createStatus(ctx, tx, status)
+}
+
+func createStatus(ctx context.Context, db querier, status models.ArchivingStatus) (string, error) {
+	row := db.QueryRow(ctx, CreateStatus, status.Status)
 
 	var id string
 	err := row.Scan(&id)
@@ -48,18 +54,41 @@ func (s *StatusRepositoryImpl) CreateStatus(status models.ArchivingStatus) (stri
 	return id, nil
 }
 
-func (s *StatusRepositoryImpl) AlterStatus(status models.ArchivingStatus) error {
-	_, err := s.Db.Exec(context.Background(), AlterStatus, time.Now(), status.Status, status.Id)
+// AlterStatus sets the status and appends the event of the change. An unknown id changes
+// nothing and is not reported.
+func (s *StatusRepositoryImpl) AlterStatus(ctx context.Context, status models.ArchivingStatus) error {
+	return inTransaction(ctx, s.Db, func(tx pgx.Tx) error {
+		return alterStatus(ctx, tx, status)
+	})
+}
+
+func (s *StatusRepositoryImpl) AlterStatusTx(ctx context.Context, tx pgx.Tx, status models.ArchivingStatus) error {
+	return alterStatus(ctx, tx, status)
+}
+
+func alterStatus(ctx context.Context, tx pgx.Tx, status models.ArchivingStatus) error {
+	tag, err := tx.Exec(ctx, AlterStatus, time.Now(), status.Status, status.Id)
 	if err != nil {
 		return errors.Wrapf(err, "cannot update archiving status")
 	}
-	return nil
+	if tag.RowsAffected() == 0 {
+		return nil
+	}
+	return appendOutboxEvent(ctx, tx, EventArchivingStatusChanged, status.Id, ArchivingStatusEvent{Id: status.Id, Status: status.Status})
+}
+
+func (s *StatusRepositoryImpl) CheckStatus(ctx context.Context, id string) (models.ArchivingStatus, error) {
+	return checkStatus(ctx, s.Db, CheckStatus, id)
+}
+
+func (s *StatusRepositoryImpl) CheckStatusTx(ctx context.Context, tx pgx.Tx, id string) (models.ArchivingStatus, error) {
+	return checkStatus(ctx, tx, CheckStatusForUpdate, id)
 }
 
-func (s *StatusRepositoryImpl) CheckStatus(id string) (models.ArchivingStatus, error) {
+func checkStatus(ctx context.Context, db querier, statement string, id string) (models.ArchivingStatus, error) {
 	var archivingStatus models.ArchivingStatus
 	var lastChanged time.Time
-	err := s.Db.QueryRow(context.Background(), CheckStatus, id).Scan(&archivingStatus.Id, &lastChanged, &archivingStatus.Status)
+	err := db.QueryRow(ctx, statement, id).Scan(&archivingStatus.Id, &lastChanged, &archivingStatus.Status)
 	if err != nil {
 		return archivingStatus, errors.Wrapf(err, "cannot get archiving status by id")
 	}
@@ -1,18 +1,28 @@
 package repository
 
-import "github.com/ocfl-archive/dlza-manager/models"
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/ocfl-archive/dlza-manager/models"
+)
 
 type StorageLocationRepository interface {
-	GetAllStorageLocations() ([]models.StorageLocation, error)
-	GetStorageLocationsByTenantId(tenantId string) ([]models.StorageLocation, error)
-	GetStorageLocationsByTenantIdAndGroup(tenantId string, group string) ([]models.StorageLocation, error)
-	DeleteStorageLocationById(storageLocationId string) error
-	SaveStorageLocation(models.StorageLocation) (string, error)
-	UpdateStorageLocation(models.StorageLocation) error
-	GetStorageLocationById(id string) (models.StorageLocation, error)
-	GetStorageLocationByObjectInstanceId(id string) (models.StorageLocation, error)
-	GetStorageLocationsByObjectId(id string) ([]models.StorageLocation, error)
-	GetAmountOfErrorsForStorageLocationId(id string) (int, error)
-	GetAmountOfObjectsForStorageLocationId(id string) (int, error)
-	GetStorageLocationsByTenantOrCollectionIdPaginated(pagination models.Pagination) ([]models.StorageLocation, int, error)
+	GetAllStorageLocations(ctx context.Context) ([]models.StorageLocation, error)
+	GetStorageLocationsByTenantId(ctx context.Context, tenantId string) ([]models.StorageLocation, error)
+	GetStorageLocationsByTenantIdAndGroup(ctx context.Context, tenantId string, group string) ([]models.StorageLocation, error)
+	DeleteStorageLocationById(ctx context.Context, storageLocationId string) error
+	SaveStorageLocation(ctx context.Context, storageLocation models.StorageLocation) (string, error)
+	UpdateStorageLocation(ctx context.Context, storageLocation models.StorageLocation) error
+	GetStorageLocationById(ctx context.Context, id string) (models.StorageLocation, error)
+	// GetStorageLocationByIdTx returns the storage location and locks it until the transaction ends.
+	GetStorageLocationByIdTx(ctx context.Context, tx pgx.Tx, id string) (models.StorageLocation, error)
+	SaveStorageLocationTx(ctx context.Context, tx pgx.Tx, storageLocation models.StorageLocation) (string, error)
+	UpdateStorageLocationTx(ctx context.Context, tx pgx.Tx, storageLocation models.StorageLocation) error
+	DeleteStorageLocationByIdTx(ctx context.Context, tx pgx.Tx, storageLocationId string) error
+	GetStorageLocationByObjectInstanceId(ctx context.Context, id string) (models.StorageLocation, error)
+	GetStorageLocationsByObjectId(ctx context.Context, id string) ([]models.StorageLocation, error)
+	GetAmountOfErrorsForStorageLocationId(ctx context.Context, id string) (int, error)
+	GetAmountOfObjectsForStorageLocationId(ctx context.Context, id string) (int, error)
+	GetStorageLocationsByTenantOrCollectionIdPaginated(ctx context.Context, pagination models.Pagination) ([]models.StorageLocation, int, error)
 }
@@ -2,9 +2,6 @@ package repository
 
 import (
 	"context"
-	"fmt"
-	"strconv"
-	"strings"
 
 	"emperror.dev/errors"
 	"github.com/jackc/pgx/v5"
@@ -27,58 +24,61 @@ const (
 	GetAmountOfObjectsForStorageLocationId = "GetAmountOfObjectsForStorageLocationId"
 )
 
+var storageLocationPreparedStatements = map[string]string{
+	GetAllStorageLocations:                "SELECT * FROM storage_location",
+	GetStorageLocationsByTenantId:         "SELECT * FROM storage_location where tenant_id = $1",
+	GetStorageLocationsByTenantIdAndGroup: `SELECT * FROM storage_location where tenant_id = $1 and "group" = $2`,
+	GetStorageLocationByObjectInstanceId: "select sl.* from object_instance oi " +
+		" inner join storage_partition sp" +
+		" on sp.id = oi.storage_partition_id" +
+		" inner join storage_location sl" +
+		" on sl.id = sp.storage_location_id where oi.id = $1",
+	GetStorageLocationById: "select a.*, c.total_existing_volume from (select sl.*, sum(oi.size) as total_file_size from storage_location sl" +
+		" left join storage_partition sp on sp.storage_location_id = sl.id" +
+		" left join object_instance oi on sp.id = oi.storage_partition_id" +
+		" where sl.id = $1 group by sl.id) a" +
+		" left join" +
+		" (select sp.storage_location_id, sum(sp.max_size) as total_existing_volume from storage_partition sp group by sp.storage_location_id) c" +
+		" on a.id = c.storage_location_id",
+	DeleteStorageLocationForTenantIdById: "DELETE FROM storage_location WHERE id = $1",
+	SaveStorageLocationForTenant:         `INSERT INTO storage_location(alias, type, vault, connection, quality, price, security_compliency, fill_first, ocfl_type, tenant_id, number_of_threads, "group") VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)  RETURNING id`,
+	GetStorageLocationsByObjectId: "select sl.* from object o," +
+		" object_instance oi," +
+		" storage_partition sp," +
+		" storage_location sl" +
+		" where o.id = $1" +
+		" and o.id = oi.object_id" +
+		" and oi.storage_partition_id = sp.id" +
+		" and sp.storage_location_id = sl.id",
+	GetAmountOfErrorsForStorageLocationId: "select count(*) from object_instance oi, storage_partition sp, storage_location sl" +
+		" where oi.storage_partition_id = sp.id" +
+		" and sp.storage_location_id = sl.id" +
+		" and status = 'error'" +
+		" and sl.id = $1",
+	GetAmountOfObjectsForStorageLocationId: "select count(*) from object_instance oi, storage_partition sp, storage_location sl" +
+		" where oi.storage_partition_id = sp.id" +
+		" and sp.storage_location_id = sl.id" +
+		" and sl.id = $1",
+	UpdateStorageLocation: `UPDATE STORAGE_LOCATION set alias = $1, type = $2, vault = $3, connection = $4, quality = $5, price = $6, security_compliency = $7, fill_first = $8, ocfl_type = $9, tenant_id = $10, number_of_threads = $12, "group" = $13 where id =$11`,
+}
+
 func CreateStorageLocPreparedStatements(ctx context.Context, conn *pgx.Conn) error {
-	preparedStatements := map[string]string{
-		GetAllStorageLocations:                "SELECT * FROM storage_location",
-		GetStorageLocationsByTenantId:         "SELECT * FROM storage_location where tenant_id = $1",
-		GetStorageLocationsByTenantIdAndGroup: `SELECT * FROM storage_location where tenant_id = $1 and "group" = $2`,
-		GetStorageLocationByObjectInstanceId: "select sl.* from object_instance oi " +
-			" inner join storage_partition sp" +
-			" on sp.id = oi.storage_partition_id" +
-			" inner join storage_location sl" +
-			" on sl.id = sp.storage_location_id where oi.id = $1",
-		GetStorageLocationById: "select a.*, c.total_existing_volume from (select sl.*, sum(oi.size) as total_file_size from storage_location sl" +
-			" left join storage_partition sp on sp.storage_location_id = sl.id" +
-			" left join object_instance oi on sp.id = oi.storage_partition_id" +
-			" where sl.id = $1 group by sl.id) a" +
-			" left join" +
-			" (select sp.storage_location_id, sum(sp.max_size) as total_existing_volume from storage_partition sp group by sp.storage_location_id) c" +
-			" on a.id = c.storage_location_id",
-		DeleteStorageLocationForTenantIdById: "DELETE FROM storage_location WHERE id = $1",
-		SaveStorageLocationForTenant:         `INSERT INTO storage_location(alias, type, vault, connection, quality, price, security_compliency, fill_first, ocfl_type, tenant_id, number_of_threads, "group") VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)  RETURNING id`,
-		GetStorageLocationsByObjectId: "select sl.* from object o," +
-			" object_instance oi," +
-			" storage_partition sp," +
-			" storage_location sl" +
-			" where o.id = $1" +
-			" and o.id = oi.object_id" +
-			" and oi.storage_partition_id = sp.id" +
-			" and sp.storage_location_id = sl.id",
-		GetAmountOfErrorsForStorageLocationId: "select count(*) from object_instance oi, storage_partition sp, storage_location sl" +
-			" where oi.storage_partition_id = sp.id" +
-			" and sp.storage_location_id = sl.id" +
-			" and status = 'error'" +
-			" and sl.id = $1",
-		GetAmountOfObjectsForStorageLocationId: "select count(*) from object_instance oi, storage_partition sp, storage_location sl" +
-			" where oi.storage_partition_id = sp.id" +
-			" and sp.storage_location_id = sl.id" +
-			" and sl.id = $1",
-		UpdateStorageLocation: `UPDATE STORAGE_LOCATION set alias = $1, type = $2, vault = $3, connection = $4, quality = $5, price = $6, security_compliency = $7, fill_first = $8, ocfl_type = $9, tenant_id = $10, number_of_threads = $12, "group" = $13 where id =$11`,
-	}
-	for name, sqlStm := range preparedStatements {
-		if _, err := conn.Prepare(ctx, name, sqlStm); err != nil {
-			return errors.Wrapf(err, "cannot prepare statement '%s' - '%s'", name, sqlStm)
-		}
-	}
-	return nil
+	return prepareStatements(ctx, conn, storageLocationPreparedStatements)
 }
 
+var storageLocationSortColumns = newSortColumns("alias", "id", map[string]string{
+	"alias": "alias", "type": "type", "vault": "vault", "connection": "connection", "quality": "quality", "price": "price",
+	"security_compliency": "security_compliency", "fill_first": "fill_first", "ocfl_type": "ocfl_type", "tenant_id": "tenant_id",
+	"id": "id", "number_of_threads": "number_of_threads", "group": "\"group\"", "total_existing_volume": "total_existing_volume",
+	"total_files_size": "total_files_size",
+})
+
 type StorageLocationRepositoryImpl struct {
 	Db *pgxpool.Pool
 }
 
-func (s *StorageLocationRepositoryImpl) GetAllStorageLocations() ([]models.StorageLocation, error) {
-	rows, err := s.Db.Query(context.Background(), GetAllStorageLocations)
+func (s *StorageLocationRepositoryImpl) GetAllStorageLocations(ctx context.Context) ([]models.StorageLocation, error) {
+	rows, err := s.Db.Query(ctx, GetAllStorageLocations)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Could not execute query for method: %v", GetAllStorageLocations)
 	}
@@ -86,8 +86,8 @@ func (s *StorageLocationRepositoryImpl) GetAllStorageLocations() ([]models.Stora
 	return getStorageLocationsFromRows(rows)
 }
 
-func (s *StorageLocationRepositoryImpl) GetStorageLocationsByTenantId(tenantId string) ([]models.StorageLocation, error) {
-	rows, err := s.Db.Query(context.Background(), GetStorageLocationsByTenantId, tenantId)
+func (s *StorageLocationRepositoryImpl) GetStorageLocationsByTenantId(ctx context.Context, tenantId string) ([]models.StorageLocation, error) {
+	rows, err := s.Db.Query(ctx, GetStorageLocationsByTenantId, tenantId)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Could not execute query for method: %v", GetStorageLocationsByTenantId)
 	}
@@ -95,8 +95,8 @@ func (s *StorageLocationRepositoryImpl) GetStorageLocationsByTenantId(tenantId s
 	return getStorageLocationsFromRows(rows)
 }
 
-func (s *StorageLocationRepositoryImpl) GetStorageLocationsByTenantIdAndGroup(tenantId string, group string) ([]models.StorageLocation, error) {
-	rows, err := s.Db.Query(context.Background(), GetStorageLocationsByTenantIdAndGroup, tenantId, group)
+func (s *StorageLocationRepositoryImpl) GetStorageLocationsByTenantIdAndGroup(ctx context.Context, tenantId string, group string) ([]models.StorageLocation, error) {
+	rows, err := s.Db.Query(ctx, GetStorageLocationsByTenantIdAndGroup, tenantId, group)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Could not execute query for method: %v", GetStorageLocationsByTenantIdAndGroup)
 	}
@@ -104,24 +104,40 @@ func (s *StorageLocationRepositoryImpl) GetStorageLocationsByTenantIdAndGroup(te
 	return getStorageLocationsFromRows(rows)
 }
 
-func (s *StorageLocationRepositoryImpl) GetAmountOfErrorsForStorageLocationId(id string) (int, error) {
-	return s.getOneNumberParameterById(id, GetAmountOfErrorsForStorageLocationId)
+func (s *StorageLocationRepositoryImpl) GetAmountOfErrorsForStorageLocationId(ctx context.Context, id string) (int, error) {
+	return s.getOneNumberParameterById(ctx, id, GetAmountOfErrorsForStorageLocationId)
+}
+
+func (s *StorageLocationRepositoryImpl) GetAmountOfObjectsForStorageLocationId(ctx context.Context, id string) (int, error) {
+	return s.getOneNumberParameterById(ctx, id, GetAmountOfObjectsForStorageLocationId)
+}
+
+func (s *StorageLocationRepositoryImpl) DeleteStorageLocationById(ctx context.Context, storageLocationId string) error {
+	return deleteStorageLocationById(ctx, s.Db, storageLocationId)
 }
 
-func (s *StorageLocationRepositoryImpl) GetAmountOfObjectsForStorageLocationId(id string) (int, error) {
-	return s.getOneNumberParameterById(id, GetAmountOfObjectsForStorageLocationId)
+func (s *StorageLocationRepositoryImpl) DeleteStorageLocationByIdTx(ctx context.Context, tx pgx.Tx, storageLocationId string) error {
+	return deleteStorageLocationById(ctx, tx, storageLocationId)
 }
 
-func (s *StorageLocationRepositoryImpl) DeleteStorageLocationById(storageLocationId string) error {
-	_, err := s.Db.Exec(context.Background(), DeleteStorageLocationForTenantIdById, storageLocationId)
+func deleteStorageLocationById(ctx context.Context, db querier, storageLocationId string) error {
+	_, err := db.Exec(ctx, DeleteStorageLocationForTenantIdById, storageLocationId)
 	if err != nil {
 		return errors.Wrapf(err, "Could not execute query for method: %v", DeleteStorageLocationForTenantIdById)
 	}
 	return nil
 }
 
-func (s *StorageLocationRepositoryImpl) SaveStorageLocation(storageLocation models.StorageLocation) (string, error) {
-	row := s.Db.QueryRow(context.Background(), SaveStorageLocationForTenant, storageLocation.Alias, storageLocation.Type, storageLocation.Vault, storageLocation.Connection, storageLocation.Quality,
+func (s *StorageLocationRepositoryImpl) SaveStorageLocation(ctx context.Context, storageLocation models.StorageLocation) (string, error) {
+	return saveStorageLocation(ctx, s.Db, storageLocation)
+}
+
+func (s *StorageLocationRepositoryImpl) SaveStorageLocationTx(ctx context.Context, tx pgx.Tx, storageLocation models.StorageLocation) (string, error) {
+	return saveStorageLocation(ctx, tx, storageLocation)
+}
+
+func saveStorageLocation(ctx context.Context, db querier, storageLocation models.StorageLocation) (string, error) {
+	row := db.QueryRow(ctx, SaveStorageLocationForTenant, storageLocation.Alias, storageLocation.Type, storageLocation.Vault, storageLocation.Connection, storageLocation.Quality,
 		storageLocation.Price, storageLocation.SecurityCompliency, storageLocation.FillFirst, storageLocation.OcflType, storageLocation.TenantId, storageLocation.NumberOfThreads, storageLocation.Group)
 	var id string
 	err := row.Scan(&id)
@@ -131,8 +147,16 @@ func (s *StorageLocationRepositoryImpl) SaveStorageLocation(storageLocation mode
 	return id, nil
 }
 
-func (s *StorageLocationRepositoryImpl) UpdateStorageLocation(storageLocation models.StorageLocation) error {
-	_, err := s.Db.Exec(context.Background(), UpdateStorageLocation, storageLocation.Alias, storageLocation.Type, storageLocation.Vault, storageLocation.Connection, storageLocation.Quality,
+func (s *StorageLocationRepositoryImpl) UpdateStorageLocation(ctx context.Context, storageLocation models.StorageLocation) error {
+	return updateStorageLocation(ctx, s.Db, storageLocation)
+}
+
+func (s *StorageLocationRepositoryImpl) UpdateStorageLocationTx(ctx context.Context, tx pgx.Tx, storageLocation models.StorageLocation) error {
+	return updateStorageLocation(ctx, tx, storageLocation)
+}
+
+func updateStorageLocation(ctx context.Context, db querier, storageLocation models.StorageLocation) error {
+	_, err := db.Exec(ctx, UpdateStorageLocation, storageLocation.Alias, storageLocation.Type, storageLocation.Vault, storageLocation.Connection, storageLocation.Quality,
 		storageLocation.Price, storageLocation.SecurityCompliency, storageLocation.FillFirst, storageLocation.OcflType, storageLocation.TenantId, storageLocation.Id, storageLocation.NumberOfThreads, storageLocation.Group)
 	if err != nil {
 		return errors.Wrapf(err, "Could not execute query for method: %v", UpdateStorageLocation)
@@ -140,12 +164,24 @@ func (s *StorageLocationRepositoryImpl) UpdateStorageLocation(storageLocation mo
 	return nil
 }
 
-func (s *StorageLocationRepositoryImpl) GetStorageLocationById(id string) (models.StorageLocation, error) {
+func (s *StorageLocationRepositoryImpl) GetStorageLocationById(ctx context.Context, id string) (models.StorageLocation, error) {
+	return getStorageLocationById(ctx, s.Db, id)
+}
+
+// GetStorageLocationByIdTx locks the base row first, the query itself aggregates and cannot be locked.
+func (s *StorageLocationRepositoryImpl) GetStorageLocationByIdTx(ctx context.Context, tx pgx.Tx, id string) (models.StorageLocation, error) {
+	if err := lockStorageLocation(ctx, tx, id); err != nil {
+		return models.StorageLocation{}, err
+	}
+	return getStorageLocationById(ctx, tx, id)
+}
+
+func getStorageLocationById(ctx context.Context, db querier, id string) (models.StorageLocation, error) {
 	var storageLocation models.StorageLocation
 	var vault zeronull.Text
 	var totalExistingVolume zeronull.Int8
 	var totalFilesSize zeronull.Int8
-	err := s.Db.QueryRow(context.Background(), GetStorageLocationById, id).Scan(&storageLocation.Alias, &storageLocation.Type, &vault, &storageLocation.Connection, &storageLocation.Quality,
+	err := db.QueryRow(ctx, GetStorageLocationById, id).Scan(&storageLocation.Alias, &storageLocation.Type, &vault, &storageLocation.Connection, &storageLocation.Quality,
 		&storageLocation.Price, &storageLocation.SecurityCompliency, &storageLocation.FillFirst, &storageLocation.OcflType, &storageLocation.TenantId, &storageLocation.Id, &storageLocation.NumberOfThreads, &storageLocation.Group, &totalFilesSize, &totalExistingVolume)
 	if err != nil {
 		return models.StorageLocation{}, errors.Wrapf(err, "Could not execute query for method: %v", GetStorageLocationById)
@@ -156,8 +192,8 @@ func (s *StorageLocationRepositoryImpl) GetStorageLocationById(id string) (model
 	return storageLocation, nil
 }
 
-func (s *StorageLocationRepositoryImpl) GetStorageLocationsByObjectId(id string) ([]models.StorageLocation, error) {
-	rows, err := s.Db.Query(context.Background(), GetStorageLocationsByObjectId, id)
+func (s *StorageLocationRepositoryImpl) GetStorageLocationsByObjectId(ctx context.Context, id string) ([]models.StorageLocation, error) {
+	rows, err := s.Db.Query(ctx, GetStorageLocationsByObjectId, id)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get current storage locations")
 	}
@@ -177,50 +213,53 @@ func (s *StorageLocationRepositoryImpl) GetStorageLocationsByObjectId(id string)
 	return storageLocations, nil
 }
 
-func (s *StorageLocationRepositoryImpl) GetStorageLocationsByTenantOrCollectionIdPaginated(pagination models.Pagination) ([]models.StorageLocation, int, error) {
-	tenantStatement := ""
-	collectionStatement := ""
+func (s *StorageLocationRepositoryImpl) GetStorageLocationsByTenantOrCollectionIdPaginated(ctx context.Context, pagination models.Pagination) ([]models.StorageLocation, int, error) {
+	var builder queryBuilder
 
 	// tenantID filter
-	tenantStatement = fmt.Sprintf(" where sl.tenant_id = '%s'", pagination.Id)
+	builder.whereEquals("sl.tenant_id", pagination.Id)
 	if len(pagination.AllowedTenants) != 0 {
-		tenants := strings.Join(pagination.AllowedTenants, "','")
-		tenantStatement = tenantStatement + fmt.Sprintf(" and sl.tenant_id in ('%s')", tenants)
+		builder.whereIn("sl.tenant_id", pagination.AllowedTenants)
 	}
+	getLikeQueryForStorageLocation(&builder, pagination.SearchField)
+	tenantStatement := builder.whereClause()
 
 	// collectionID filter
+	collectionStatement := ""
 	if pagination.SecondId != "" {
-		collectionStatement = fmt.Sprintf("where c.id = '%s'", pagination.SecondId)
+		collectionStatement = " where c.id = " + builder.bind(pagination.SecondId)
 	}
 
-	query := fmt.Sprintf("select a.*, d.total_files_size, count(*) over() as total_items  from"+
-		" (select sl.*, sum(sp.max_size) as total_existing_volume from storage_location sl"+
-		" left join storage_partition sp"+
-		" on sl.id = sp.storage_location_id "+
-		" %s %s"+
-		" group by sl.id) a"+
-		" left join"+
-		" (select b.storage_location_id, sum(total_files_size_for_instance) as total_files_size"+
-		" from (select sum(oi.size) as total_files_size_for_instance, sp.id as spid, sp.storage_location_id"+
-		" from storage_partition sp"+
-		" inner join object_instance oi"+
-		" on sp.id = oi.storage_partition_id"+
-		" inner join object o"+
-		" on o.id = oi.object_id"+
-		" inner join collection c"+
-		" on c.id = o.collection_id"+
-		" %s"+
-		" group by sp.id, sp.storage_location_id) b"+
-		" group by storage_location_id) d"+
-		" on a.id = d.storage_location_id"+
-		" order by %s %s limit %s OFFSET %s ", tenantStatement, getLikeQueryForStorageLocation(pagination.SearchField, tenantStatement), collectionStatement, pagination.SortKey, pagination.SortDirection, strconv.Itoa(pagination.Take), strconv.Itoa(pagination.Skip))
-	rows, err := s.Db.Query(context.Background(), query)
+	query := "select a.*, d.total_files_size from" +
+		" (select sl.*, sum(sp.max_size) as total_existing_volume from storage_location sl" +
+		" left join storage_partition sp" +
+		" on sl.id = sp.storage_location_id " +
+		tenantStatement +
+		" group by sl.id) a" +
+		" left join" +
+		" (select b.storage_location_id, sum(total_files_size_for_instance) as total_files_size" +
+		" from (select sum(oi.size) as total_files_size_for_instance, sp.id as spid, sp.storage_location_id" +
+		" from storage_partition sp" +
+		" inner join object_instance oi" +
+		" on sp.id = oi.storage_partition_id" +
+		" inner join object o" +
+		" on o.id = oi.object_id" +
+		" inner join collection c" +
+		" on c.id = o.collection_id" +
+		collectionStatement +
+		" group by sp.id, sp.storage_location_id) b" +
+		" group by storage_location_id) d" +
+		" on a.id = d.storage_location_id"
+	page, args, err := builder.page(pagination, storageLocationSortColumns)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "Could not build query for method: GetStorageLocationsByTenantOrCollectionIdPaginated")
+	}
+	rows, err := s.Db.Query(ctx, query+page, args...)
 	if err != nil {
-		return nil, 0, errors.Wrapf(err, "Could not execute query: %v", query)
+		return nil, 0, errors.Wrapf(err, "Could not execute query: %v", query+page)
 	}
 	defer rows.Close()
 	var storageLocations []models.StorageLocation
-	var totalItems int
 	for rows.Next() {
 		var storageLocation models.StorageLocation
 		var vault zeronull.Text
@@ -229,22 +268,26 @@ func (s *StorageLocationRepositoryImpl) GetStorageLocationsByTenantOrCollectionI
 		err := rows.Scan(&storageLocation.Alias, &storageLocation.Type, &vault, &storageLocation.Connection, &storageLocation.Quality,
 			&storageLocation.Price, &storageLocation.SecurityCompliency, &storageLocation.FillFirst, &storageLocation.OcflType, &storageLocation.TenantId,
 			&storageLocation.Id, &storageLocation.NumberOfThreads, &storageLocation.Group,
-			&totalExistingVolume, &totalFilesSize, &totalItems)
+			&totalExistingVolume, &totalFilesSize)
 		if err != nil {
-			return nil, 0, errors.Wrapf(err, "Could not scan rows for query: %v", query)
+			return nil, 0, errors.Wrapf(err, "Could not scan rows for query: %v", query+page)
 		}
 		storageLocation.TotalFilesSize = int64(totalFilesSize)
 		storageLocation.TotalExistingVolume = int64(totalExistingVolume)
 		storageLocation.Vault = string(vault)
 		storageLocations = append(storageLocations, storageLocation)
 	}
+	totalItems, err := builder.count(ctx, s.Db, query)
+	if err != nil {
+		return nil, 0, err
+	}
 	return storageLocations, totalItems, nil
 }
 
-func (s *StorageLocationRepositoryImpl) GetStorageLocationByObjectInstanceId(id string) (models.StorageLocation, error) {
+func (s *StorageLocationRepositoryImpl) GetStorageLocationByObjectInstanceId(ctx context.Context, id string) (models.StorageLocation, error) {
 	var storageLocation models.StorageLocation
 	var vault zeronull.Text
-	err := s.Db.QueryRow(context.Background(), GetStorageLocationByObjectInstanceId, id).Scan(&storageLocation.Alias, &storageLocation.Type, &vault, &storageLocation.Connection, &storageLocation.Quality,
+	err := s.Db.QueryRow(ctx, GetStorageLocationByObjectInstanceId, id).Scan(&storageLocation.Alias, &storageLocation.Type, &vault, &storageLocation.Connection, &storageLocation.Quality,
 		&storageLocation.Price, &storageLocation.SecurityCompliency, &storageLocation.FillFirst, &storageLocation.OcflType, &storageLocation.TenantId, &storageLocation.Id, &storageLocation.NumberOfThreads, &storageLocation.Group)
 	if err != nil {
 		return models.StorageLocation{}, errors.Wrapf(err, "Could not execute query: %v", GetStorageLocationByObjectInstanceId)
@@ -257,22 +300,12 @@ func NewStorageLocationRepository(db *pgxpool.Pool) StorageLocationRepository {
 	return &StorageLocationRepositoryImpl{Db: db}
 }
 
-func getLikeQueryForStorageLocation(searchKey string, tenantStatement string) string {
-	if searchKey != "" {
-		condition := ""
-		if tenantStatement == "" {
-			condition = "where"
-		} else {
-			condition = "and"
-		}
-		return condition + strings.Replace(" (sl.id::text like '_search_key_%' or lower(sl.alias) like '%_search_key_%' or lower(sl.security_compliency) like '%_search_key_%')",
-			"_search_key_", searchKey, -1)
-	}
-	return ""
+func getLikeQueryForStorageLocation(builder *queryBuilder, searchKey string) {
+	builder.whereSearch(searchKey, []string{"sl.id"}, []string{"sl.alias", "sl.security_compliency"})
 }
 
-func (s *StorageLocationRepositoryImpl) getOneNumberParameterById(id string, preparedStatement string) (int, error) {
-	row := s.Db.QueryRow(context.Background(), preparedStatement, id)
+func (s *StorageLocationRepositoryImpl) getOneNumberParameterById(ctx context.Context, id string, preparedStatement string) (int, error) {
+	row := s.Db.QueryRow(ctx, preparedStatement, id)
 	var amount int
 	err := row.Scan(&amount)
 	if err != nil {
@@ -1,19 +1,34 @@
 package repository
 
-import "github.com/ocfl-archive/dlza-manager/models"
+import (
+	"context"
+	"github.com/jackc/pgx/v5"
+	"github.com/ocfl-archive/dlza-manager/models"
+)
 
 type StoragePartitionRepository interface {
-	CreateStoragePartition(partition models.StoragePartition) (string, error)
-	CreateStoragePartitionGroupElement(partitionGroupElement models.StoragePartitionGroup) (string, error)
-	DeleteStoragePartitionGroupElementByStoragePartitionId(id string) error
-	DeleteStoragePartitionById(id string) error
-	GetStoragePartitionById(id string) (models.StoragePartition, error)
-	GetStoragePartitionGroupElementByAlias(alias string) (models.StoragePartitionGroup, error)
-	UpdateStoragePartition(partition models.StoragePartition) error
-	UpdateStoragePartitionGroupElement(partition models.StoragePartitionGroup) error
-	GetStoragePartitionsByLocationIdPaginated(pagination models.Pagination) ([]models.StoragePartition, int, error)
-	GetStoragePartitionsByLocationId(locationId string) ([]models.StoragePartition, error)
-	GetStoragePartitionByObjectSignatureAndLocation(signature string, locationGroup string) (models.StoragePartition, error)
-	GetStoragePartitionGroupElementById(Id string) (models.StoragePartitionGroup, error)
-	GetStoragePartitionGroupElementsByStoragePartitionId(partitionGroupId string) ([]models.StoragePartitionGroup, error)
+	CreateStoragePartition(ctx context.Context, partition models.StoragePartition) (string, error)
+	CreateStoragePartitionTx(ctx context.Context, tx pgx.Tx, partition models.StoragePartition) (string, error)
+	// CreateStoragePartitionWithNextAlias creates the partition with the alias
+	// "<locationId>-partition-N" of the next number N of its location and returns it with
+	// alias and id. A partition without name is named after its alias.
+	CreateStoragePartitionWithNextAlias(ctx context.Context, partition models.StoragePartition) (models.StoragePartition, error)
+	CreateStoragePartitionGroupElement(ctx context.Context, partitionGroupElement models.StoragePartitionGroup) (string, error)
+	CreateStoragePartitionGroupElementTx(ctx context.Context, tx pgx.Tx, partitionGroupElement models.StoragePartitionGroup) (string, error)
+	DeleteStoragePartitionGroupElementByStoragePartitionId(ctx context.Context, id string) error
+	DeleteStoragePartitionGroupElementByStoragePartitionIdTx(ctx context.Context, tx pgx.Tx, id string) error
+	DeleteStoragePartitionById(ctx context.Context, id string) error
+	DeleteStoragePartitionByIdTx(ctx context.Context, tx pgx.Tx, id string) error
+	GetStoragePartitionById(ctx context.Context, id string) (models.StoragePartition, error)
+	// GetStoragePartitionByIdTx returns the storage partition and locks it until the transaction ends.
+	GetStoragePartitionByIdTx(ctx context.Context, tx pgx.Tx, id string) (models.StoragePartition, error)
+	GetStoragePartitionGroupElementByAlias(ctx context.Context, alias string) (models.StoragePartitionGroup, error)
+	UpdateStoragePartition(ctx context.Context, partition models.StoragePartition) error
+	UpdateStoragePartitionTx(ctx context.Context, tx pgx.Tx, partition models.StoragePartition) error
+	UpdateStoragePartitionGroupElement(ctx context.Context, partition models.StoragePartitionGroup) error
+	GetStoragePartitionsByLocationIdPaginated(ctx context.Context, pagination models.Pagination) ([]models.StoragePartition, int, error)
+	GetStoragePartitionsByLocationId(ctx context.Context, locationId string) ([]models.StoragePartition, error)
+	GetStoragePartitionByObjectSignatureAndLocation(ctx context.Context, signature string, locationGroup string) (models.StoragePartition, error)
+	GetStoragePartitionGroupElementById(ctx context.Context, Id string) (models.StoragePartitionGroup, error)
+	GetStoragePartitionGroupElementsByStoragePartitionId(ctx context.Context, partitionGroupId string) ([]models.StoragePartitionGroup, error)
 }
@@ -2,10 +2,7 @@ package repository
 
 import (
 	"context"
-	"fmt"
-	"slices"
 	"strconv"
-	"strings"
 
 	"emperror.dev/errors"
 	"github.com/jackc/pgx/v5"
@@ -16,6 +13,7 @@ import (
 
 const (
 	GetStoragePartition                                    = "GetStoragePartition"
+	LockStoragePartition                                   = "LockStoragePartition"
 	CreateStoragePartition                                 = "CreateStoragePartition"
 	UpdateStoragePartition                                 = "UpdateStoragePartition"
 	DeleteStoragePartition                                 = "DeleteStoragePartition"
@@ -27,42 +25,53 @@ const (
 	GetStoragePartitionGroupElementByAlias                 = "GetStoragePartitionGroupElementByAlias"
 	GetStoragePartitionGroupElementById                    = "GetStoragePartitionGroupElementById"
 	GetStoragePartitionGroupElementsByStoragePartitionId   = "GetStoragePartitionGroupElementsByStoragePartitionId"
+	NextStoragePartitionAliasNumber                        = "NextStoragePartitionAliasNumber"
 )
 
+// storagePartitionAliasStart precedes the number in the alias "<locationId>-partition-N"
+// of the partitions created with the next alias of their location.
+const storagePartitionAliasStart = "partition-"
+
+var storagePartitionSortColumns = newSortColumns("alias", "sp.id", columnsWithPrefix("sp.", "alias", "name", "max_size",
+	"max_objects", "current_size", "current_objects", "id", "storage_location_id"))
+
 type storagePartitionRepositoryImpl struct {
 	Db *pgxpool.Pool
 }
 
-func CreateStoragePartitionPreparedStatements(ctx context.Context, conn *pgx.Conn) error {
-
-	preparedStatements := map[string]string{
-		GetStoragePartition:                    "SELECT * FROM STORAGE_PARTITION WHERE ID = $1",
-		GetStoragePartitionGroupElementByAlias: "SELECT * FROM STORAGE_PARTITION_GROUP_ELEM o WHERE alias = $1",
-		GetStoragePartitionByObjectSignatureAndLocation: `SELECT sp.* FROM object o INNER JOIN object_instance oi ON o.id = oi.object_id INNER JOIN storage_partition sp ON oi.storage_partition_id = sp.id
+var storagePartitionPreparedStatements = map[string]string{
+	GetStoragePartition: "SELECT * FROM STORAGE_PARTITION WHERE ID = $1",
+	// the view aggregates and cannot be locked, the lock is taken on its base row
+	LockStoragePartition:                   "SELECT id FROM STORAGE_PARTITION_BASE WHERE id = $1 FOR NO KEY UPDATE",
+	GetStoragePartitionGroupElementByAlias: "SELECT * FROM STORAGE_PARTITION_GROUP_ELEM o WHERE alias = $1",
+	GetStoragePartitionByObjectSignatureAndLocation: `SELECT sp.* FROM object o INNER JOIN object_instance oi ON o.id = oi.object_id INNER JOIN storage_partition sp ON oi.storage_partition_id = sp.id
             														INNER JOIN storage_location sl ON sp.storage_location_id = sl.id
             														WHERE signature = $1 AND sl.group = $2 AND (oi.status = 'ok' or oi.status = 'new')`,
-		CreateStoragePartition:                                 "INSERT INTO STORAGE_PARTITION_BASE(alias, \"name\", max_size, max_objects, storage_location_id) VALUES ($1, $2, $3, $4, $5) RETURNING id",
-		UpdateStoragePartition:                                 "UPDATE STORAGE_PARTITION_BASE set name = $1, max_size = $2, max_objects = $3 where id =$4",
-		DeleteStoragePartition:                                 "DELETE FROM STORAGE_PARTITION_BASE  where id =$1",
-		GetStoragePartitionsByLocationId:                       "SELECT * FROM STORAGE_PARTITION WHERE storage_location_id = $1",
-		CreateStoragePartitionGroupElement:                     "INSERT INTO STORAGE_PARTITION_GROUP_ELEM(alias, \"name\", partition_group_id) VALUES ($1, $2, $3) RETURNING id",
-		UpdateStoragePartitionGroupElement:                     "UPDATE STORAGE_PARTITION_GROUP_ELEM set name = $1, alias = $2 where id =$3",
-		DeleteStoragePartitionGroupElementByStoragePartitionId: "DELETE FROM STORAGE_PARTITION_GROUP_ELEM  where partition_group_id =$1",
-		GetStoragePartitionGroupElementById:                    "SELECT * FROM STORAGE_PARTITION_GROUP_ELEM WHERE id =$1",
-		GetStoragePartitionGroupElementsByStoragePartitionId:   "SELECT * FROM STORAGE_PARTITION_GROUP_ELEM WHERE partition_group_id =$1",
-	}
-	for name, sqlStm := range preparedStatements {
-		if _, err := conn.Prepare(ctx, name, sqlStm); err != nil {
-			return errors.Wrapf(err, "cannot prepare statement '%s' - '%s'", name, sqlStm)
-		}
-	}
-	return nil
+	CreateStoragePartition:                                 "INSERT INTO STORAGE_PARTITION_BASE(alias, \"name\", max_size, max_objects, storage_location_id) VALUES ($1, $2, $3, $4, $5) RETURNING id",
+	UpdateStoragePartition:                                 "UPDATE STORAGE_PARTITION_BASE set name = $1, max_size = $2, max_objects = $3 where id =$4",
+	DeleteStoragePartition:                                 "DELETE FROM STORAGE_PARTITION_BASE  where id =$1",
+	GetStoragePartitionsByLocationId:                       "SELECT * FROM STORAGE_PARTITION WHERE storage_location_id = $1",
+	CreateStoragePartitionGroupElement:                     "INSERT INTO STORAGE_PARTITION_GROUP_ELEM(alias, \"name\", partition_group_id) VALUES ($1, $2, $3) RETURNING id",
+	UpdateStoragePartitionGroupElement:                     "UPDATE STORAGE_PARTITION_GROUP_ELEM set name = $1, alias = $2 where id =$3",
+	DeleteStoragePartitionGroupElementByStoragePartitionId: "DELETE FROM STORAGE_PARTITION_GROUP_ELEM  where partition_group_id =$1",
+	GetStoragePartitionGroupElementById:                    "SELECT * FROM STORAGE_PARTITION_GROUP_ELEM WHERE id =$1",
+	GetStoragePartitionGroupElementsByStoragePartitionId:   "SELECT * FROM STORAGE_PARTITION_GROUP_ELEM WHERE partition_group_id =$1",
+	// the number follows the counter and the aliases created with a number by hand
+	NextStoragePartitionAliasNumber: "INSERT INTO storage_partition_alias_counter(storage_location_id, last_number)" +
+		" SELECT $1, coalesce(max(substring(alias FROM 'partition-([0-9]+)$')::integer), 0) + 1 FROM storage_partition_base" +
+		" WHERE storage_location_id = $1 AND alias ~ 'partition-[0-9]+$'" +
+		" ON CONFLICT (storage_location_id) DO UPDATE" +
+		" SET last_number = greatest(storage_partition_alias_counter.last_number + 1, EXCLUDED.last_number) RETURNING last_number",
+}
+
+func CreateStoragePartitionPreparedStatements(ctx context.Context, conn *pgx.Conn) error {
+	return prepareStatements(ctx, conn, storagePartitionPreparedStatements)
 }
 
-func (s *storagePartitionRepositoryImpl) GetStoragePartitionByObjectSignatureAndLocation(signature string, locationGroup string) (models.StoragePartition, error) {
+func (s *storagePartitionRepositoryImpl) GetStoragePartitionByObjectSignatureAndLocation(ctx context.Context, signature string, locationGroup string) (models.StoragePartition, error) {
 	storagePartition := models.StoragePartition{}
 	var currentSize zeronull.Int8
-	err := s.Db.QueryRow(context.Background(), GetStoragePartitionByObjectSignatureAndLocation, signature, locationGroup).Scan(&storagePartition.Alias, &storagePartition.Name, &storagePartition.MaxSize,
+	err := s.Db.QueryRow(ctx, GetStoragePartitionByObjectSignatureAndLocation, signature, locationGroup).Scan(&storagePartition.Alias, &storagePartition.Name, &storagePartition.MaxSize,
 		&storagePartition.MaxObjects, &currentSize, &storagePartition.CurrentObjects, &storagePartition.Id, &storagePartition.StorageLocationId)
 	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
 		return storagePartition, errors.Wrapf(err, "Could not execute query for method: %v", GetStoragePartitionByObjectSignatureAndLocation)
@@ -74,8 +83,16 @@ func (s *storagePartitionRepositoryImpl) GetStoragePartitionByObjectSignatureAnd
 	return storagePartition, nil
 }
 
-func (s *storagePartitionRepositoryImpl) CreateStoragePartition(partition models.StoragePartition) (string, error) {
-	row := s.Db.QueryRow(context.Background(), CreateStoragePartition, partition.Alias, partition.Name, partition.MaxSize, partition.MaxObjects, partition.StorageLocationId)
+func (s *storagePartitionRepositoryImpl) CreateStoragePartition(ctx context.Context, partition models.StoragePartition) (string, error) {
+	return createStoragePartition(ctx, s.Db, partition)
+}
+
+func (s *storagePartitionRepositoryImpl) CreateStoragePartitionTx(ctx context.Context, tx pgx.Tx, partition models.StoragePartition) (string, error) {
+	return createStoragePartition(ctx, tx, partition)
+}
+
+func createStoragePartition(ctx context.Context, db querier, partition models.StoragePartition) (string, error) {
+	row := db.QueryRow(ctx, CreateStoragePartition, partition.Alias, partition.Name, partition.MaxSize, partition.MaxObjects, partition.StorageLocationId)
 
 	var id string
 	err := row.Scan(&id)
@@ -85,8 +102,46 @@ func (s *storagePartitionRepositoryImpl) CreateStoragePartition(partition models
 	return id, nil
 }
 
-func (s *storagePartitionRepositoryImpl) CreateStoragePartitionGroupElement(partitionGroup models.StoragePartitionGroup) (string, error) {
-	row := s.Db.QueryRow(context.Background(), CreateStoragePartitionGroupElement, partitionGroup.Alias, partitionGroup.Name, partitionGroup.PartitionGroupId)
+func (s *storagePartitionRepositoryImpl) CreateStoragePartitionWithNextAlias(ctx context.Context, partition models.StoragePartition) (models.StoragePartition, error) {
+	err := inTransaction(ctx, s.Db, func(tx pgx.Tx) error {
+		var err error
+		partition, err = createStoragePartitionWithNextAlias(ctx, tx, partition)
+		return err
+	})
+	return partition, err
+}
+
+// createStoragePartitionWithNextAlias allocates the next alias number of the location of
+// the partition, above the numbers of the aliases of its partitions created by hand, and
+// creates the partition with it. The counter row stays locked until the
+// transaction ends, so the numbers of a location are allocated in the order of creation.
+func createStoragePartitionWithNextAlias(ctx context.Context, tx pgx.Tx, partition models.StoragePartition) (models.StoragePartition, error) {
+	var number int
+	if err := tx.QueryRow(ctx, NextStoragePartitionAliasNumber, partition.StorageLocationId).Scan(&number); err != nil {
+		return partition, errors.Wrapf(err, "Could not execute query for method: %v", NextStoragePartitionAliasNumber)
+	}
+	partition.Alias = partition.StorageLocationId + "-" + storagePartitionAliasStart + strconv.Itoa(number)
+	if partition.Name == "" {
+		partition.Name = partition.Alias
+	}
+	id, err := createStoragePartition(ctx, tx, partition)
+	if err != nil {
+		return partition, err
+	}
+	partition.Id = id
+	return partition, nil
+}
+
+func (s *storagePartitionRepositoryImpl) CreateStoragePartitionGroupElement(ctx context.Context, partitionGroup models.StoragePartitionGroup) (string, error) {
+	return createStoragePartitionGroupElement(ctx, s.Db, partitionGroup)
+}
+
+func (s *storagePartitionRepositoryImpl) CreateStoragePartitionGroupElementTx(ctx context.Context, tx pgx.Tx, partitionGroup models.StoragePartitionGroup) (string, error) {
+	return createStoragePartitionGroupElement(ctx, tx, partitionGroup)
+}
+
+func createStoragePartitionGroupElement(ctx context.Context, db querier, partitionGroup models.StoragePartitionGroup) (string, error) {
+	row := db.QueryRow(ctx, CreateStoragePartitionGroupElement, partitionGroup.Alias, partitionGroup.Name, partitionGroup.PartitionGroupId)
 
 	var id string
 	err := row.Scan(&id)
@@ -96,9 +151,9 @@ func (s *storagePartitionRepositoryImpl) CreateStoragePartitionGroupElement(part
 	return id, nil
 }
 
-func (s *storagePartitionRepositoryImpl) GetStoragePartitionGroupElementById(Id string) (models.StoragePartitionGroup, error) {
+func (s *storagePartitionRepositoryImpl) GetStoragePartitionGroupElementById(ctx context.Context, Id string) (models.StoragePartitionGroup, error) {
 	storagePartitionGroup := models.StoragePartitionGroup{}
-	err := s.Db.QueryRow(context.Background(), GetStoragePartitionGroupElementById, Id).Scan(&storagePartitionGroup.PartitionGroupId, &storagePartitionGroup.Alias,
+	err := s.Db.QueryRow(ctx, GetStoragePartitionGroupElementById, Id).Scan(&storagePartitionGroup.PartitionGroupId, &storagePartitionGroup.Alias,
 		&storagePartitionGroup.Id, &storagePartitionGroup.Name)
 	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
 		return storagePartitionGroup, errors.Wrapf(err, "Could not execute query for method: %v", GetStoragePartitionGroupElementById)
@@ -106,8 +161,8 @@ func (s *storagePartitionRepositoryImpl) GetStoragePartitionGroupElementById(Id
 	return storagePartitionGroup, nil
 }
 
-func (s *storagePartitionRepositoryImpl) GetStoragePartitionGroupElementsByStoragePartitionId(partitionGroupId string) ([]models.StoragePartitionGroup, error) {
-	rows, err := s.Db.Query(context.Background(), GetStoragePartitionGroupElementsByStoragePartitionId, partitionGroupId)
+func (s *storagePartitionRepositoryImpl) GetStoragePartitionGroupElementsByStoragePartitionId(ctx context.Context, partitionGroupId string) ([]models.StoragePartitionGroup, error) {
+	rows, err := s.Db.Query(ctx, GetStoragePartitionGroupElementsByStoragePartitionId, partitionGroupId)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Could not execute query for method: %v", GetStoragePartitionGroupElementsByStoragePartitionId)
 	}
@@ -125,41 +180,65 @@ func (s *storagePartitionRepositoryImpl) GetStoragePartitionGroupElementsByStora
 	return storagePartitionGroups, nil
 }
 
-func (s *storagePartitionRepositoryImpl) UpdateStoragePartitionGroupElement(partitionGroup models.StoragePartitionGroup) error {
-	_, err := s.Db.Exec(context.Background(), UpdateStoragePartitionGroupElement, partitionGroup.Name, partitionGroup.Alias, partitionGroup.Id)
+func (s *storagePartitionRepositoryImpl) UpdateStoragePartitionGroupElement(ctx context.Context, partitionGroup models.StoragePartitionGroup) error {
+	_, err := s.Db.Exec(ctx, UpdateStoragePartitionGroupElement, partitionGroup.Name, partitionGroup.Alias, partitionGroup.Id)
 	if err != nil {
 		return errors.Wrapf(err, "Could not execute query for method: %v", UpdateStoragePartitionGroupElement)
 	}
 	return nil
 }
 
-func (s *storagePartitionRepositoryImpl) UpdateStoragePartition(partition models.StoragePartition) error {
-	_, err := s.Db.Exec(context.Background(), UpdateStoragePartition, partition.Name, partition.MaxSize, partition.MaxObjects, partition.Id)
+func (s *storagePartitionRepositoryImpl) UpdateStoragePartition(ctx context.Context, partition models.StoragePartition) error {
+	return updateStoragePartition(ctx, s.Db, partition)
+}
+
+func (s *storagePartitionRepositoryImpl) UpdateStoragePartitionTx(ctx context.Context, tx pgx.Tx, partition models.StoragePartition) error {
+	return updateStoragePartition(ctx, tx, partition)
+}
+
+func updateStoragePartition(ctx context.Context, db querier, partition models.StoragePartition) error {
+	_, err := db.Exec(ctx, UpdateStoragePartition, partition.Name, partition.MaxSize, partition.MaxObjects, partition.Id)
 	if err != nil {
 		return errors.Wrapf(err, "Could not execute query for method: %v", UpdateStoragePartition)
 	}
 	return nil
 }
 
-func (s *storagePartitionRepositoryImpl) DeleteStoragePartitionById(id string) error {
-	_, err := s.Db.Exec(context.Background(), DeleteStoragePartition, id)
+func (s *storagePartitionRepositoryImpl) DeleteStoragePartitionById(ctx context.Context, id string) error {
+	return deleteStoragePartitionById(ctx, s.Db, id)
+}
+
+func (s *storagePartitionRepositoryImpl) DeleteStoragePartitionByIdTx(ctx context.Context, tx pgx.Tx, id string) error {
+	return deleteStoragePartitionById(ctx, tx, id)
+}
+
+func deleteStoragePartitionById(ctx context.Context, db querier, id string) error {
+	_, err := db.Exec(ctx, DeleteStoragePartition, id)
 	if err != nil {
 		return errors.Wrapf(err, "Could not execute query for method: %v", DeleteStoragePartition)
 	}
 	return nil
 }
 
-func (s *storagePartitionRepositoryImpl) DeleteStoragePartitionGroupElementByStoragePartitionId(partitionId string) error {
-	_, err := s.Db.Exec(context.Background(), DeleteStoragePartitionGroupElementByStoragePartitionId, partitionId)
+func (s *storagePartitionRepositoryImpl) DeleteStoragePartitionGroupElementByStoragePartitionId(ctx context.Context, partitionId string) error {
+	return deleteStoragePartitionGroupElementByStoragePartitionId(ctx, s.Db, partitionId)
+}
+
+func (s *storagePartitionRepositoryImpl) DeleteStoragePartitionGroupElementByStoragePartitionIdTx(ctx context.Context, tx pgx.Tx, partitionId string) error {
+	return deleteStoragePartitionGroupElementByStoragePartitionId(ctx, tx, partitionId)
+}
+
+func deleteStoragePartitionGroupElementByStoragePartitionId(ctx context.Context, db querier, partitionId string) error {
+	_, err := db.Exec(ctx, DeleteStoragePartitionGroupElementByStoragePartitionId, partitionId)
 	if err != nil {
 		return errors.Wrapf(err, "Could not execute query for method: %v", DeleteStoragePartitionGroupElementByStoragePartitionId)
 	}
 	return nil
 }
 
-func (s *storagePartitionRepositoryImpl) GetStoragePartitionGroupElementByAlias(alias string) (models.StoragePartitionGroup, error) {
+func (s *storagePartitionRepositoryImpl) GetStoragePartitionGroupElementByAlias(ctx context.Context, alias string) (models.StoragePartitionGroup, error) {
 	storagePartitionGroup := models.StoragePartitionGroup{}
-	err := s.Db.QueryRow(context.Background(), GetStoragePartitionGroupElementByAlias, alias).Scan(&storagePartitionGroup.PartitionGroupId, &storagePartitionGroup.Alias,
+	err := s.Db.QueryRow(ctx, GetStoragePartitionGroupElementByAlias, alias).Scan(&storagePartitionGroup.PartitionGroupId, &storagePartitionGroup.Alias,
 		&storagePartitionGroup.Id, &storagePartitionGroup.Name)
 	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
 		return storagePartitionGroup, errors.Wrapf(err, "Could not execute query for method: %v", GetStoragePartitionGroupElementByAlias)
@@ -167,10 +246,21 @@ func (s *storagePartitionRepositoryImpl) GetStoragePartitionGroupElementByAlias(
 	return storagePartitionGroup, nil
 }
 
-func (s *storagePartitionRepositoryImpl) GetStoragePartitionById(id string) (models.StoragePartition, error) {
+func (s *storagePartitionRepositoryImpl) GetStoragePartitionById(ctx context.Context, id string) (models.StoragePartition, error) {
+	return getStoragePartitionById(ctx, s.Db, id)
+}
+
+func (s *storagePartitionRepositoryImpl) GetStoragePartitionByIdTx(ctx context.Context, tx pgx.Tx, id string) (models.StoragePartition, error) {
+	if _, err := tx.Exec(ctx, LockStoragePartition, id); err != nil {
+		return models.StoragePartition{}, errors.Wrapf(err, "Could not execute query for method: %v", LockStoragePartition)
+	}
+	return getStoragePartitionById(ctx, tx, id)
+}
+
+func getStoragePartitionById(ctx context.Context, db querier, id string) (models.StoragePartition, error) {
 	storagePartition := models.StoragePartition{}
 	var currentSize zeronull.Int8
-	err := s.Db.QueryRow(context.Background(), GetStoragePartition, id).Scan(&storagePartition.Alias, &storagePartition.Name, &storagePartition.MaxSize,
+	err := db.QueryRow(ctx, GetStoragePartition, id).Scan(&storagePartition.Alias, &storagePartition.Name, &storagePartition.MaxSize,
 		&storagePartition.MaxObjects, &currentSize, &storagePartition.CurrentObjects, &storagePartition.Id, &storagePartition.StorageLocationId)
 	if err != nil {
 		return storagePartition, errors.Wrapf(err, "Could not execute query for method: %v", GetStoragePartition)
@@ -182,8 +272,8 @@ func (s *storagePartitionRepositoryImpl) GetStoragePartitionById(id string) (mod
 	return storagePartition, err
 }
 
-func (s *storagePartitionRepositoryImpl) GetStoragePartitionsByLocationId(locationId string) ([]models.StoragePartition, error) {
-	rows, err := s.Db.Query(context.Background(), GetStoragePartitionsByLocationId, locationId)
+func (s *storagePartitionRepositoryImpl) GetStoragePartitionsByLocationId(ctx context.Context, locationId string) ([]models.StoragePartition, error) {
+	rows, err := s.Db.Query(ctx, GetStoragePartitionsByLocationId, locationId)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Could not execute query for method: %v", GetStoragePartitionsByLocationId)
 	}
@@ -206,52 +296,31 @@ func (s *storagePartitionRepositoryImpl) GetStoragePartitionsByLocationId(locati
 	return storagePartitions, nil
 }
 
-func (s *storagePartitionRepositoryImpl) GetStoragePartitionsByLocationIdPaginated(pagination models.Pagination) ([]models.StoragePartition, int, error) {
-	firstCondition := ""
-	secondCondition := ""
-	if pagination.SecondId != "" {
-		if len(pagination.AllowedTenants) != 0 && slices.Contains(pagination.AllowedTenants, pagination.SecondId) {
-			pagination.AllowedTenants = []string{pagination.SecondId}
-		}
-		if len(pagination.AllowedTenants) == 0 {
-			pagination.AllowedTenants = []string{pagination.SecondId}
-		}
-	}
-	if pagination.Id == "" {
-		if len(pagination.AllowedTenants) != 0 {
-			tenants := strings.Join(pagination.AllowedTenants, "','")
-			firstCondition = fmt.Sprintf("where t.id in ('%s')", tenants)
-		}
-	} else {
-		firstCondition = fmt.Sprintf("where sp.storage_location_id = '%s'", pagination.Id)
-		if len(pagination.AllowedTenants) != 0 {
-			tenants := strings.Join(pagination.AllowedTenants, "','")
-			secondCondition = fmt.Sprintf("and t.id in ('%s')", tenants)
-		}
-	}
-	if firstCondition == "" && secondCondition == "" {
-		firstCondition = "where"
-	} else {
-		secondCondition = secondCondition + " and"
+func (s *storagePartitionRepositoryImpl) GetStoragePartitionsByLocationIdPaginated(ctx context.Context, pagination models.Pagination) ([]models.StoragePartition, int, error) {
+	var builder queryBuilder
+	builder.whereTenantScope(pagination, "sp.storage_location_id", "t.id")
+	getLikeQueryForStoragePartition(&builder, pagination.SearchField)
+
+	query := "SELECT sp.* FROM STORAGE_PARTITION sp" +
+		" inner join storage_location sl on sl.id = sp.storage_location_id" +
+		" inner join tenant t on t.id = sl.tenant_id" + builder.whereClause()
+	page, args, err := builder.page(pagination, storagePartitionSortColumns)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "Could not build query for method: GetStoragePartitionsByLocationIdPaginated")
 	}
-	query := fmt.Sprintf("SELECT sp.*, count(*) over() as total_items FROM STORAGE_PARTITION sp"+
-		" inner join storage_location sl on sl.id = sp.storage_location_id"+
-		" inner join tenant t on t.id = sl.tenant_id"+
-		" %s %s %s order by %s %s limit %s OFFSET %s ", firstCondition, secondCondition, getLikeQueryForStoragePartition(pagination.SearchField), "sp."+pagination.SortKey, pagination.SortDirection, strconv.Itoa(pagination.Take), strconv.Itoa(pagination.Skip))
-	rows, err := s.Db.Query(context.Background(), query)
+	rows, err := s.Db.Query(ctx, query+page, args...)
 	if err != nil {
-		return nil, 0, errors.Wrapf(err, "Could not execute query: %v", query)
+		return nil, 0, errors.Wrapf(err, "Could not execute query: %v", query+page)
 	}
 	defer rows.Close()
 	var storagePartitions []models.StoragePartition
 	var currentSize zeronull.Int8
-	var totalItems int
 	for rows.Next() {
 		var storagePartition models.StoragePartition
 		err := rows.Scan(&storagePartition.Alias, &storagePartition.Name, &storagePartition.MaxSize,
-			&storagePartition.MaxObjects, &currentSize, &storagePartition.CurrentObjects, &storagePartition.Id, &storagePartition.StorageLocationId, &totalItems)
+			&storagePartition.MaxObjects, &currentSize, &storagePartition.CurrentObjects, &storagePartition.Id, &storagePartition.StorageLocationId)
 		if err != nil {
-			return nil, 0, errors.Wrapf(err, "Could not scan rows for query: %v", query)
+			return nil, 0, errors.Wrapf(err, "Could not scan rows for query: %v", query+page)
 		}
 		if currentSize == 0 && storagePartition.CurrentObjects == 1 {
 			storagePartition.CurrentObjects = 0
@@ -259,6 +328,10 @@ func (s *storagePartitionRepositoryImpl) GetStoragePartitionsByLocationIdPaginat
 		storagePartition.CurrentSize = int64(currentSize)
 		storagePartitions = append(storagePartitions, storagePartition)
 	}
+	totalItems, err := builder.count(ctx, s.Db, query)
+	if err != nil {
+		return nil, 0, err
+	}
 	return storagePartitions, totalItems, nil
 }
 
@@ -266,8 +339,6 @@ func NewStoragePartitionRepository(db *pgxpool.Pool) StoragePartitionRepository
 	return &storagePartitionRepositoryImpl{Db: db}
 }
 
-func getLikeQueryForStoragePartition(searchKey string) string {
-	return strings.Replace("(sp.id::text like '_search_key_%' or lower(sp.alias) like '%_search_key_%'"+
-		" or lower(sp.name) like '%_search_key_%')",
-		"_search_key_", searchKey, -1)
+func getLikeQueryForStoragePartition(builder *queryBuilder, searchKey string) {
+	builder.whereSearch(searchKey, []string{"sp.id"}, []string{"sp.alias", "sp.name"})
 }
@@ -1,16 +1,26 @@
 package repository
 
-import "github.com/ocfl-archive/dlza-manager/models"
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/ocfl-archive/dlza-manager/models"
+)
 
 type TenantRepository interface {
-	FindTenantById(id string) (models.Tenant, error)
-	FindTenantByCollectionId(id string) (models.Tenant, error)
-	FindTenantByCollectionAlias(alias string) (models.Tenant, error)
-	FindTenantByKey(key string) (models.Tenant, error)
-	SaveTenant(tenant models.Tenant) error
-	UpdateTenant(tenant models.Tenant) error
-	DeleteTenant(id string) error
-	FindAllTenantsPaginated(pagination models.Pagination) ([]models.Tenant, int, error)
-	FindAllTenants() ([]models.Tenant, error)
-	GetAmountOfObjectsAndTotalSizeByTenantId(id string) (int64, int64, error)
+	FindTenantById(ctx context.Context, id string) (models.Tenant, error)
+	FindTenantByCollectionId(ctx context.Context, id string) (models.Tenant, error)
+	FindTenantByCollectionAlias(ctx context.Context, alias string) (models.Tenant, error)
+	FindTenantByKey(ctx context.Context, key string) (models.Tenant, error)
+	SaveTenant(ctx context.Context, tenant models.Tenant) (string, error)
+	UpdateTenant(ctx context.Context, tenant models.Tenant) error
+	DeleteTenant(ctx context.Context, id string) error
+	// FindTenantByIdTx returns the tenant and locks it until the transaction ends.
+	FindTenantByIdTx(ctx context.Context, tx pgx.Tx, id string) (models.Tenant, error)
+	SaveTenantTx(ctx context.Context, tx pgx.Tx, tenant models.Tenant) (string, error)
+	UpdateTenantTx(ctx context.Context, tx pgx.Tx, tenant models.Tenant) error
+	DeleteTenantTx(ctx context.Context, tx pgx.Tx, id string) error
+	FindAllTenantsPaginated(ctx context.Context, pagination models.Pagination) ([]models.Tenant, int, error)
+	FindAllTenants(ctx context.Context) ([]models.Tenant, error)
+	GetAmountOfObjectsAndTotalSizeByTenantId(ctx context.Context, id string) (int64, int64, error)
 }
@@ -3,8 +3,6 @@ package repository
 import (
 	"context"
 	"fmt"
-	"strconv"
-	"strings"
 
 	"emperror.dev/errors"
 	"github.com/jackc/pgx/v5"
@@ -16,12 +14,15 @@ import (
 const (
 	FindAllTenants                           = "FindAllTenants"
 	FindTenantById                           = "FindTenantById"
+	FindTenantByIdForUpdate                  = "FindTenantByIdForUpdate"
 	SaveTenant                               = "SaveTenant"
 	UpdateTenant                             = "UpdateTenant"
 	DeleteTenant                             = "DeleteTenant"
 	GetAmountOfObjectsAndTotalSizeByTenantId = "GetAmountOfObjectsAndTotalSizeByTenantId"
 )
 
+var tenantSortColumns = newSortColumns("name", "t.id", columnsWithPrefix("t.", "name", "alias", "person", "email", "id", "api_key_id"))
+
 type TenantRepositoryImpl struct {
 	Db *pgxpool.Pool
 }
@@ -32,32 +33,29 @@ func NewTenantRepository(Db *pgxpool.Pool) TenantRepository {
 	}
 }
 
-func CreateTenantPreparedStatements(ctx context.Context, conn *pgx.Conn) error {
+var tenantPreparedStatements = map[string]string{
+	FindAllTenants: "SELECT * FROM TENANT",
+	FindTenantById: "SELECT * FROM TENANT WHERE id = $1",
+	// NO KEY UPDATE does not block the collections and locations referencing the tenant
+	FindTenantByIdForUpdate: "SELECT * FROM TENANT WHERE id = $1 FOR NO KEY UPDATE",
+	SaveTenant:              "insert into TENANT(name, alias, person, email, api_key_id) values($1, $2, $3, $4, $5) RETURNING id",
+	UpdateTenant:            "update TENANT set name = $1, alias = $2, person = $3, email = $4 where id =$5",
+	DeleteTenant:            "DELETE FROM TENANT WHERE id = $1",
+	GetAmountOfObjectsAndTotalSizeByTenantId: "select sum(current_objects),  sum(current_size) from tenant t" +
+		" left join storage_location sl" +
+		" on t.id = sl.tenant_id" +
+		" left join storage_partition sp" +
+		" on sl.id = sp.storage_location_id" +
+		" where t.id = $1" +
+		" group by t.id",
+}
 
-	tenantPreparedStatements := map[string]string{
-		FindAllTenants: "SELECT * FROM TENANT",
-		FindTenantById: "SELECT * FROM TENANT WHERE id = $1",
-		SaveTenant:     "insert into TENANT(name, alias, person, email, api_key_id) values($1, $2, $3, $4, $5)",
-		UpdateTenant:   "update TENANT set name = $1, alias = $2, person = $3, email = $4 where id =$5",
-		DeleteTenant:   "DELETE FROM TENANT WHERE id = $1",
-		GetAmountOfObjectsAndTotalSizeByTenantId: "select sum(current_objects),  sum(current_size) from tenant t" +
-			" left join storage_location sl" +
-			" on t.id = sl.tenant_id" +
-			" left join storage_partition sp" +
-			" on sl.id = sp.storage_location_id" +
-			" where t.id = $1" +
-			" group by t.id",
-	}
-	for name, sqlStm := range tenantPreparedStatements {
-		if _, err := conn.Prepare(ctx, name, sqlStm); err != nil {
-			return errors.Wrapf(err, "cannot prepare statement '%s' - '%s'", name, sqlStm)
-		}
-	}
-	return nil
+func CreateTenantPreparedStatements(ctx context.Context, conn *pgx.Conn) error {
+	return prepareStatements(ctx, conn, tenantPreparedStatements)
 }
 
-func (t *TenantRepositoryImpl) GetAmountOfObjectsAndTotalSizeByTenantId(id string) (int64, int64, error) {
-	row := t.Db.QueryRow(context.Background(), GetAmountOfObjectsAndTotalSizeByTenantId, id)
+func (t *TenantRepositoryImpl) GetAmountOfObjectsAndTotalSizeByTenantId(ctx context.Context, id string) (int64, int64, error) {
+	row := t.Db.QueryRow(ctx, GetAmountOfObjectsAndTotalSizeByTenantId, id)
 	var amount zeronull.Int8
 	var size zeronull.Int8
 	err := row.Scan(&amount, &size)
@@ -67,36 +65,60 @@ func (t *TenantRepositoryImpl) GetAmountOfObjectsAndTotalSizeByTenantId(id strin
 	return int64(amount), int64(size), nil
 }
 
-func (t *TenantRepositoryImpl) UpdateTenant(tenant models.Tenant) error {
-	_, err := t.Db.Exec(context.Background(), UpdateTenant, tenant.Name, tenant.Alias, tenant.Person, tenant.Email, tenant.Id)
+func (t *TenantRepositoryImpl) UpdateTenant(ctx context.Context, tenant models.Tenant) error {
+	return updateTenant(ctx, t.Db, tenant)
+}
+
+func (t *TenantRepositoryImpl) UpdateTenantTx(ctx context.Context, tx pgx.Tx, tenant models.Tenant) error {
+	return updateTenant(ctx, tx, tenant)
+}
+
+func updateTenant(ctx context.Context, db querier, tenant models.Tenant) error {
+	_, err := db.Exec(ctx, UpdateTenant, tenant.Name, tenant.Alias, tenant.Person, tenant.Email, tenant.Id)
 	if err != nil {
 		return errors.Wrapf(err, "Could not execute query: %v", UpdateTenant)
 	}
 	return nil
 }
 
-func (t *TenantRepositoryImpl) DeleteTenant(id string) error {
-	_, err := t.Db.Exec(context.Background(), DeleteTenant, id)
+func (t *TenantRepositoryImpl) DeleteTenant(ctx context.Context, id string) error {
+	return deleteTenant(ctx, t.Db, id)
+}
+
+func (t *TenantRepositoryImpl) DeleteTenantTx(ctx context.Context, tx pgx.Tx, id string) error {
+	return deleteTenant(ctx, tx, id)
+}
+
+func deleteTenant(ctx context.Context, db querier, id string) error {
+	_, err := db.Exec(ctx, DeleteTenant, id)
 	if err != nil {
 		return errors.Wrapf(err, "Could not execute query: %v", DeleteTenant)
 	}
 	return nil
 }
 
-func (t *TenantRepositoryImpl) FindTenantById(id string) (models.Tenant, error) {
+func (t *TenantRepositoryImpl) FindTenantById(ctx context.Context, id string) (models.Tenant, error) {
+	return findTenantById(ctx, t.Db, FindTenantById, id)
+}
+
+func (t *TenantRepositoryImpl) FindTenantByIdTx(ctx context.Context, tx pgx.Tx, id string) (models.Tenant, error) {
+	return findTenantById(ctx, tx, FindTenantByIdForUpdate, id)
+}
+
+func findTenantById(ctx context.Context, db querier, statement string, id string) (models.Tenant, error) {
 	var tenant models.Tenant
-	err := t.Db.QueryRow(context.Background(), FindTenantById, id).Scan(&tenant.Name, &tenant.Alias, &tenant.Person, &tenant.Email, &tenant.Id, &tenant.ApiKeyId)
+	err := db.QueryRow(ctx, statement, id).Scan(&tenant.Name, &tenant.Alias, &tenant.Person, &tenant.Email, &tenant.Id, &tenant.ApiKeyId)
 	if err != nil {
-		return tenant, errors.Wrapf(err, "Could not execute query: %v", FindTenantById)
+		return tenant, errors.Wrapf(err, "Could not execute query: %v", statement)
 	}
 	return tenant, nil
 }
 
-func (t *TenantRepositoryImpl) FindTenantByKey(key string) (models.Tenant, error) {
+func (t *TenantRepositoryImpl) FindTenantByKey(ctx context.Context, key string) (models.Tenant, error) {
 	var tenant models.Tenant
 	query := fmt.Sprintf("SELECT t.name, t.alias, t.person, t.email, t.id, t.api_key_id  FROM Tenant t, api_key a"+
 		" where t.api_key_id = a.id and a.key = '%s'", key)
-	countRow := t.Db.QueryRow(context.Background(), query)
+	countRow := t.Db.QueryRow(ctx, query)
 	err := countRow.Scan(&tenant.Name, &tenant.Alias, &tenant.Person, &tenant.Email, &tenant.Id, &tenant.ApiKeyId)
 	if err != nil {
 		return tenant, errors.Wrapf(err, "Could not scan tenant for query: %v", query)
@@ -104,11 +126,11 @@ func (t *TenantRepositoryImpl) FindTenantByKey(key string) (models.Tenant, error
 	return tenant, nil
 }
 
-func (t *TenantRepositoryImpl) FindTenantByCollectionId(collectionId string) (models.Tenant, error) {
+func (t *TenantRepositoryImpl) FindTenantByCollectionId(ctx context.Context, collectionId string) (models.Tenant, error) {
 	var tenant models.Tenant
 	query := fmt.Sprintf("SELECT t.name, t.alias, t.person, t.email, t.id, t.api_key_id  FROM TENANT t, COLLECTION c"+
 		" where t.id = c.tenant_id and c.id = '%s'", collectionId)
-	countRow := t.Db.QueryRow(context.Background(), query)
+	countRow := t.Db.QueryRow(ctx, query)
 	err := countRow.Scan(&tenant.Name, &tenant.Alias, &tenant.Person, &tenant.Email, &tenant.Id, &tenant.ApiKeyId)
 	if err != nil {
 		return tenant, errors.Wrapf(err, "Could not scan tenant for query: %v", query)
@@ -116,11 +138,11 @@ func (t *TenantRepositoryImpl) FindTenantByCollectionId(collectionId string) (mo
 	return tenant, nil
 }
 
-func (t *TenantRepositoryImpl) FindTenantByCollectionAlias(collectionAlias string) (models.Tenant, error) {
+func (t *TenantRepositoryImpl) FindTenantByCollectionAlias(ctx context.Context, collectionAlias string) (models.Tenant, error) {
 	var tenant models.Tenant
 	query := fmt.Sprintf("SELECT t.name, t.alias, t.person, t.email, t.id, t.api_key_id  FROM TENANT t, COLLECTION c"+
 		" where t.id = c.tenant_id and c.alias = '%s'", collectionAlias)
-	countRow := t.Db.QueryRow(context.Background(), query)
+	countRow := t.Db.QueryRow(ctx, query)
 	err := countRow.Scan(&tenant.Name, &tenant.Alias, &tenant.Person, &tenant.Email, &tenant.Id, &tenant.ApiKeyId)
 	if err != nil {
 		return tenant, errors.Wrapf(err, "Could not scan tenant for query: %v", query)
@@ -128,16 +150,25 @@ func (t *TenantRepositoryImpl) FindTenantByCollectionAlias(collectionAlias strin
 	return tenant, nil
 }
 
-func (t *TenantRepositoryImpl) SaveTenant(tenant models.Tenant) error {
-	_, err := t.Db.Exec(context.Background(), SaveTenant, tenant.Name, tenant.Alias, tenant.Person, tenant.Email, tenant.ApiKeyId)
+func (t *TenantRepositoryImpl) SaveTenant(ctx context.Context, tenant models.Tenant) (string, error) {
+	return saveTenant(ctx, t.Db, tenant)
+}
+
+func (t *TenantRepositoryImpl) SaveTenantTx(ctx context.Context, tx pgx.Tx, tenant models.Tenant) (string, error) {
+	return saveTenant(ctx, tx, tenant)
+}
+
+func saveTenant(ctx context.Context, db querier, tenant models.Tenant) (string, error) {
+	var id string
+	err := db.QueryRow(ctx, SaveTenant, tenant.Name, tenant.Alias, tenant.Person, tenant.Email, tenant.ApiKeyId).Scan(&id)
 	if err != nil {
-		return errors.Wrapf(err, "Could not execute query: %v", SaveTenant)
+		return "", errors.Wrapf(err, "Could not execute query: %v", SaveTenant)
 	}
-	return nil
+	return id, nil
 }
 
-func (t *TenantRepositoryImpl) FindAllTenants() ([]models.Tenant, error) {
-	rows, err := t.Db.Query(context.Background(), FindAllTenants)
+func (t *TenantRepositoryImpl) FindAllTenants(ctx context.Context) ([]models.Tenant, error) {
+	rows, err := t.Db.Query(ctx, FindAllTenants)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Could not execute query: %v", FindAllTenants)
 	}
@@ -155,42 +186,40 @@ func (t *TenantRepositoryImpl) FindAllTenants() ([]models.Tenant, error) {
 	return tenants, nil
 }
 
-func (t *TenantRepositoryImpl) FindAllTenantsPaginated(pagination models.Pagination) ([]models.Tenant, int, error) {
-	firstCondition := ""
-	secondCondition := ""
+func (t *TenantRepositoryImpl) FindAllTenantsPaginated(ctx context.Context, pagination models.Pagination) ([]models.Tenant, int, error) {
+	var builder queryBuilder
 	if len(pagination.AllowedTenants) != 0 {
-		tenants := strings.Join(pagination.AllowedTenants, "','")
-		firstCondition = fmt.Sprintf("where t.id in ('%s')", tenants)
-	}
-	if firstCondition == "" {
-		firstCondition = "where"
-	} else {
-		secondCondition = "and"
+		builder.whereIn("t.id", pagination.AllowedTenants)
 	}
+	getLikeQueryForTenant(&builder, pagination.SearchField)
 
-	query := fmt.Sprintf("SELECT *,  count(*) over() as total_items FROM TENANT t %s %s %s order by %s %s limit %s OFFSET %s",
-		firstCondition, secondCondition, getLikeQueryForTenant(pagination.SearchField), pagination.SortKey, pagination.SortDirection, strconv.Itoa(pagination.Take), strconv.Itoa(pagination.Skip))
-	rows, err := t.Db.Query(context.Background(), query)
+	query := "SELECT * FROM TENANT t" + builder.whereClause()
+	page, args, err := builder.page(pagination, tenantSortColumns)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "Could not build query for method: FindAllTenantsPaginated")
+	}
+	rows, err := t.Db.Query(ctx, query+page, args...)
 	if err != nil {
-		return nil, 0, errors.Wrapf(err, "Could not scan tenant for query: %v", query)
+		return nil, 0, errors.Wrapf(err, "Could not scan tenant for query: %v", query+page)
 	}
 	defer rows.Close()
 	var tenants []models.Tenant
-	var totalItems int
 
 	for rows.Next() {
 		var tenant models.Tenant
-		err := rows.Scan(&tenant.Name, &tenant.Alias, &tenant.Person, &tenant.Email, &tenant.Id, &tenant.ApiKeyId, &totalItems)
+		err := rows.Scan(&tenant.Name, &tenant.Alias, &tenant.Person, &tenant.Email, &tenant.Id, &tenant.ApiKeyId)
 		if err != nil {
-			return nil, 0, errors.Wrapf(err, "Could not scan rows for query: %v", query)
+			return nil, 0, errors.Wrapf(err, "Could not scan rows for query: %v", query+page)
 		}
 		tenants = append(tenants, tenant)
 	}
+	totalItems, err := builder.count(ctx, t.Db, query)
+	if err != nil {
+		return nil, 0, err
+	}
 	return tenants, totalItems, nil
 }
 
-func getLikeQueryForTenant(searchKey string) string {
-	return strings.Replace("(t.id::text like '_search_key_%' or lower(t.alias) like '%_search_key_%'"+
-		" or (t.name) like '%_search_key_%' or lower(t.email) like '%_search_key_%' or lower(t.person) like '%_search_key_%')",
-		"_search_key_", searchKey, -1)
+func getLikeQueryForTenant(builder *queryBuilder, searchKey string) {
+	builder.whereSearch(searchKey, []string{"t.id"}, []string{"t.alias", "t.name", "t.email", "t.person"})
 }
@@ -1,9 +1,21 @@
 package repository
 
 import (
+	"context"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	pb "github.com/ocfl-archive/dlza-manager/dlzamanagerproto"
 )
 
 type TransactionRepository interface {
-	SaveAllTableObjectsAfterCopying([]*pb.InstanceWithPartitionAndObjectWithFile) error
+	SaveAllTableObjectsAfterCopying(ctx context.Context, idempotencyKey string, instanceWithPartitionAndObjectWithFiles []*pb.InstanceWithPartitionAndObjectWithFile) (*pb.Status, error)
+	InTransaction(ctx context.Context, fn func(tx pgx.Tx) error) error
+	CreateFilesTx(ctx context.Context, tx pgx.Tx, objectId string, files []*pb.File) (int64, error)
+}
+
+// querier is implemented by the pool as well as by pgx.Tx, so that a statement can run
+// on its own or as part of a transaction.
+type querier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
 }
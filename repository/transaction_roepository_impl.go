@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"emperror.dev/errors"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	pb "github.com/ocfl-archive/dlza-manager/dlzamanagerproto"
 )
@@ -23,17 +24,53 @@ func NewTransactionRepository(Db *pgxpool.Pool, objectRepository ObjectRepositor
 	}
 }
 
-func (t TransactionRepositoryImpl) SaveAllTableObjectsAfterCopying(instanceWithPartitionAndObjectWithFiles []*pb.InstanceWithPartitionAndObjectWithFile) error {
+// InTransaction runs fn in a transaction, which is committed if fn returns nil and
+// rolled back otherwise.
+func (t TransactionRepositoryImpl) InTransaction(ctx context.Context, fn func(tx pgx.Tx) error) error {
+	return inTransaction(ctx, t.Db, fn)
+}
+
+func inTransaction(ctx context.Context, db *pgxpool.Pool, fn func(tx pgx.Tx) error) error {
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "Could not begin transaction")
+	}
+	if err = fn(tx); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return errors.Wrapf(err, "Could not commit transaction")
+	}
+	return nil
+}
+
+// SaveAllTableObjectsAfterCopying stores the object, its files and the status of the
+// object instance in one transaction. A non-empty idempotency key is stored with them,
+// a replayed key returns the status of the first commit without storing anything.
+func (t TransactionRepositoryImpl) SaveAllTableObjectsAfterCopying(ctx context.Context, idempotencyKey string, instanceWithPartitionAndObjectWithFiles []*pb.InstanceWithPartitionAndObjectWithFile) (*pb.Status, error) {
 	var expirationTime any
 	if instanceWithPartitionAndObjectWithFiles[0].Object.Expiration == "" {
 		expirationTime = nil
 	} else {
 		expirationTime = instanceWithPartitionAndObjectWithFiles[0].Object.Expiration
 	}
-	ctx := context.Background()
 	tx, err := t.Db.Begin(ctx)
 	if err != nil {
-		return errors.Wrapf(err, "Could not creating transaction storing object instance with path: '%s'", instanceWithPartitionAndObjectWithFiles[0].ObjectInstance.Path)
+		return nil, errors.Wrapf(err, "Could not creating transaction storing object instance with path: '%s'", instanceWithPartitionAndObjectWithFiles[0].ObjectInstance.Path)
+	}
+
+	//////// CHECK IDEMPOTENCY KEY
+	if idempotencyKey != "" {
+		status, replayed, err := claimIdempotencyKey(ctx, tx, idempotencyKey, instanceWithPartitionAndObjectWithFiles[0].ObjectInstance.Id)
+		if err != nil {
+			tx.Rollback(ctx)
+			return nil, err
+		}
+		if replayed {
+			tx.Rollback(ctx)
+			return status, nil
+		}
 	}
 
 	//////// CREATE/UPDATE OBJECT
@@ -49,40 +86,45 @@ func (t TransactionRepositoryImpl) SaveAllTableObjectsAfterCopying(instanceWithP
 		objectIns.Keywords, objectIns.References, objectIns.IngestWorkflow, objectIns.User, objectIns.Address, time.Now(), objectIns.Size, objectIns.CollectionId, objectIns.Checksum, objectIns.Authors, objectIns.Holding, expirationTime, objectIns.Head, objectIns.Versions, objectIns.Id)
 	if err != nil {
 		tx.Rollback(ctx)
-		return errors.Wrapf(err, "cannot update object in transaction")
+		return nil, errors.Wrapf(err, "cannot update object in transaction")
 	}
 	if instanceWithPartitionAndObjectWithFiles[0].NewVersion {
 		deleteFilesQuery := "DELETE FROM FILE WHERE object_id = $1"
 		_, err = tx.Exec(ctx, deleteFilesQuery, objectIns.Id)
 		if err != nil {
 			tx.Rollback(ctx)
-			return errors.Wrapf(err, "cannot delete files in transaction")
+			return nil, errors.Wrapf(err, "cannot delete files in transaction")
 		}
-		oldObjectInstances, err := t.ObjectInstanceRepository.GetObjectInstancesByObjectId(objectIns.Id)
+		oldObjectInstances, err := t.ObjectInstanceRepository.GetObjectInstancesByObjectId(ctx, objectIns.Id)
 		if err != nil {
 			tx.Rollback(ctx)
-			return errors.Wrapf(err, "cannot GetObjectInstancesByObjectId in transaction")
+			return nil, errors.Wrapf(err, "cannot GetObjectInstancesByObjectId in transaction")
 		}
 		queryUpdateObjectInstance := "UPDATE OBJECT_INSTANCE set status = $1 where id = $2"
 		for _, objectInstance := range oldObjectInstances {
 			_, err = tx.Exec(ctx, queryUpdateObjectInstance, "deprecated", objectInstance.Id)
 			if err != nil {
 				tx.Rollback(ctx)
-				return errors.Wrapf(err, "cannot update object instance in transaction")
+				return nil, errors.Wrapf(err, "cannot update object instance in transaction")
+			}
+			err = appendObjectInstanceEvent(ctx, tx, EventObjectInstanceDeprecated, ObjectInstanceEvent{Id: objectInstance.Id,
+				ObjectId: objectInstance.ObjectId, StoragePartitionId: objectInstance.StoragePartitionId, Path: objectInstance.Path, Status: "deprecated"})
+			if err != nil {
+				tx.Rollback(ctx)
+				return nil, err
 			}
 		}
 	}
 
 	//////// CREATE FILES
 	if instanceWithPartitionAndObjectWithFiles[0].File != nil {
-		queryCreateFile := "insert into File(checksum, \"name\", \"size\", mime_type, pronom, width, height, duration, object_id) values($1, $2, $3, $4, $5, $6, $7, $8, $9)"
+		files := make([]*pb.File, 0, len(instanceWithPartitionAndObjectWithFiles))
 		for _, file := range instanceWithPartitionAndObjectWithFiles {
-			file.File.ObjectId = objectIns.Id
-			_, err = tx.Exec(ctx, queryCreateFile, file.File.Checksum, file.File.Name, file.File.Size, file.File.MimeType, file.File.Pronom, file.File.Width, file.File.Height, file.File.Duration, file.File.ObjectId)
-			if err != nil {
-				tx.Rollback(ctx)
-				return errors.Wrapf(err, "Could not exequte query: '%s'", queryCreateFile)
-			}
+			files = append(files, file.File)
+		}
+		if _, err = t.CreateFilesTx(ctx, tx, objectIns.Id, files); err != nil {
+			tx.Rollback(ctx)
+			return nil, err
 		}
 	}
 
@@ -92,12 +134,77 @@ func (t TransactionRepositoryImpl) SaveAllTableObjectsAfterCopying(instanceWithP
 	_, err = tx.Exec(ctx, queryCreateObjectInstance, objectInstance.Status, objectInstance.Id)
 	if err != nil {
 		tx.Rollback(ctx)
-		return errors.Wrapf(err, "Could not exequte query: '%s'", queryCreateObjectInstance)
+		return nil, errors.Wrapf(err, "Could not exequte query: '%s'", queryCreateObjectInstance)
+	}
+	err = appendObjectInstanceEvent(ctx, tx, EventObjectInstanceStatusChanged, ObjectInstanceEvent{Id: objectInstance.Id,
+		ObjectId: objectInstance.ObjectId, StoragePartitionId: objectInstance.StoragePartitionId, Path: objectInstance.Path, Status: objectInstance.Status})
+	if err != nil {
+		tx.Rollback(ctx)
+		return nil, err
+	}
+
+	//////// COMMIT RESERVATION
+	// the stored instance counts for the partition from now on
+	if err = releaseStoragePartitionReservation(ctx, tx, ReservedStoragePartitionId(instanceWithPartitionAndObjectWithFiles[0]), objectIns.Signature); err != nil {
+		tx.Rollback(ctx)
+		return nil, err
 	}
 
 	// COMMIT TRANSACTION
 	if err = tx.Commit(ctx); err != nil {
-		return errors.Wrapf(err, "Could not commit transaction storing object instance with path: '%s'", instanceWithPartitionAndObjectWithFiles[0].ObjectInstance.Path)
+		return nil, errors.Wrapf(err, "Could not commit transaction storing object instance with path: '%s'", instanceWithPartitionAndObjectWithFiles[0].ObjectInstance.Path)
 	}
-	return nil
+	return &pb.Status{Ok: true}, nil
+}
+
+// fileColumns are the columns CreateFilesTx copies, in the order of the row values.
+var fileColumns = []string{"checksum", "name", "size", "mime_type", "pronom", "width", "height", "duration", "object_id"}
+
+// CreateFilesTx inserts the files of the object with a single COPY, so that the number of
+// round trips does not grow with the number of files.
+func (t TransactionRepositoryImpl) CreateFilesTx(ctx context.Context, tx pgx.Tx, objectId string, files []*pb.File) (int64, error) {
+	rows := pgx.CopyFromSlice(len(files), func(i int) ([]any, error) {
+		file := files[i]
+		file.ObjectId = objectId
+		return []any{file.Checksum, file.Name, file.Size, file.MimeType, file.Pronom, file.Width, file.Height, file.Duration, file.ObjectId}, nil
+	})
+	copied, err := tx.CopyFrom(ctx, pgx.Identifier{"file"}, fileColumns, rows)
+	if err != nil {
+		return 0, errors.Wrapf(err, "Could not copy %d files for object with id: '%s'", len(files), objectId)
+	}
+	return copied, nil
+}
+
+// claimIdempotencyKey stores the key for the object instance. If the key has been
+// committed before, replayed is true and the ok status of that commit is returned, as a
+// key is only committed with a successful ingest. The insert of a key used by a
+// concurrent transaction waits until that transaction has finished.
+func claimIdempotencyKey(ctx context.Context, tx pgx.Tx, idempotencyKey string, objectInstanceId string) (*pb.Status, bool, error) {
+	queryInsertKey := "INSERT INTO ingest_idempotency_key(idempotency_key, object_instance_id) VALUES ($1, $2)" +
+		" ON CONFLICT (idempotency_key) DO NOTHING"
+	tag, err := tx.Exec(ctx, queryInsertKey, idempotencyKey, objectInstanceId)
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "Could not store idempotency key '%s'", idempotencyKey)
+	}
+	if tag.RowsAffected() == 1 {
+		return nil, false, nil
+	}
+	var storedObjectInstanceId string
+	querySelectKey := "SELECT object_instance_id FROM ingest_idempotency_key WHERE idempotency_key = $1"
+	if err = tx.QueryRow(ctx, querySelectKey, idempotencyKey).Scan(&storedObjectInstanceId); err != nil {
+		return nil, false, errors.Wrapf(err, "Could not read idempotency key '%s'", idempotencyKey)
+	}
+	if storedObjectInstanceId != objectInstanceId {
+		return nil, false, errors.Wrapf(ErrAlreadyExists, "idempotency key '%s' has already been used for object instance with id '%s'", idempotencyKey, storedObjectInstanceId)
+	}
+	return &pb.Status{Ok: true}, true, nil
+}
+
+// ReservedStoragePartitionId returns the id of the partition the object instance of the
+// message has been placed on.
+func ReservedStoragePartitionId(message *pb.InstanceWithPartitionAndObjectWithFile) string {
+	if id := message.GetStoragePartition().GetId(); id != "" {
+		return id
+	}
+	return message.GetObjectInstance().GetStoragePartitionId()
 }
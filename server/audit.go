@@ -0,0 +1,135 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+
+	"emperror.dev/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/ocfl-archive/dlza-manager-handler/repository"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// actorMetadataKey is the metadata the callers name the user acting through them with.
+const actorMetadataKey = "actor"
+
+// clientIdentity returns the identity of the client certificate of the call: the common
+// name, else the first URI or DNS name. It is empty for calls without certificate.
+func clientIdentity(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return ""
+	}
+	certificate := tlsInfo.State.PeerCertificates[0]
+	switch {
+	case certificate.Subject.CommonName != "":
+		return certificate.Subject.CommonName
+	case len(certificate.URIs) != 0:
+		return certificate.URIs[0].String()
+	case len(certificate.DNSNames) != 0:
+		return certificate.DNSNames[0]
+	}
+	return ""
+}
+
+// actor returns the user named in the metadata of the call, else the client identity.
+func actor(ctx context.Context, client string) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(actorMetadataKey); len(values) != 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+	if client == "" {
+		return "unknown"
+	}
+	return client
+}
+
+// recordAudit appends the change to the audit log in the transaction of the change, so
+// that the change is not committed without its entry.
+func recordAudit(ctx context.Context, tx pgx.Tx, auditRepository repository.AuditRepository, entityType string,
+	entityId string, before any, after any) error {
+	method, _ := grpc.Method(ctx)
+	client := clientIdentity(ctx)
+	entry := repository.AuditEntry{
+		Actor:      actor(ctx, client),
+		Client:     client,
+		Method:     method,
+		EntityType: entityType,
+		EntityId:   entityId,
+	}
+	var err error
+	if before != nil {
+		if entry.Before, err = json.Marshal(before); err != nil {
+			return errors.Wrapf(err, "Could not marshal %s '%s' for audit log", entityType, entityId)
+		}
+	}
+	if after != nil {
+		if entry.After, err = json.Marshal(after); err != nil {
+			return errors.Wrapf(err, "Could not marshal %s '%s' for audit log", entityType, entityId)
+		}
+	}
+	if err := auditRepository.CreateAuditEntryTx(ctx, tx, entry); err != nil {
+		return errors.Wrapf(err, "Could not record audit entry for %s '%s'", entityType, entityId)
+	}
+	return nil
+}
+
+// auditedCreate creates the entity and records it with its state as created.
+func auditedCreate[T any](ctx context.Context, tx pgx.Tx, auditRepository repository.AuditRepository, entityType string,
+	load func(context.Context, pgx.Tx, string) (T, error), create func() (string, error)) (string, error) {
+	id, err := create()
+	if err != nil {
+		return "", err
+	}
+	after, err := load(ctx, tx, id)
+	if err != nil {
+		return "", err
+	}
+	return id, recordAudit(ctx, tx, auditRepository, entityType, id, nil, after)
+}
+
+// auditedUpdate changes the entity and records it with its states before and after the
+// change. Loading the state before locks the entity; an unknown entity is left to the
+// change and not recorded, as nothing is changed.
+func auditedUpdate[T any](ctx context.Context, tx pgx.Tx, auditRepository repository.AuditRepository, entityType string, id string,
+	load func(context.Context, pgx.Tx, string) (T, error), update func() error) error {
+	before, err := load(ctx, tx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return update()
+	}
+	if err != nil {
+		return err
+	}
+	if err := update(); err != nil {
+		return err
+	}
+	after, err := load(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+	return recordAudit(ctx, tx, auditRepository, entityType, id, before, after)
+}
+
+// auditedDelete deletes the entity and records it with its state before the deletion.
+func auditedDelete[T any](ctx context.Context, tx pgx.Tx, auditRepository repository.AuditRepository, entityType string, id string,
+	load func(context.Context, pgx.Tx, string) (T, error), remove func() error) error {
+	before, err := load(ctx, tx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return remove()
+	}
+	if err != nil {
+		return err
+	}
+	if err := remove(); err != nil {
+		return err
+	}
+	return recordAudit(ctx, tx, auditRepository, entityType, id, before, nil)
+}
@@ -7,8 +7,10 @@ import (
 	"github.com/je4/utils/v2/pkg/zLogger"
 	pbHandler "github.com/ocfl-archive/dlza-manager-handler/handlerproto"
 	"github.com/ocfl-archive/dlza-manager-handler/repository"
+	"github.com/ocfl-archive/dlza-manager-handler/service"
 	pb "github.com/ocfl-archive/dlza-manager/dlzamanagerproto"
 	"github.com/ocfl-archive/dlza-manager/mapper"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type CheckerHandlerServer struct {
@@ -16,11 +18,13 @@ type CheckerHandlerServer struct {
 	ObjectInstanceRepository      repository.ObjectInstanceRepository
 	ObjectRepository              repository.ObjectRepository
 	ObjectInstanceCheckRepository repository.ObjectInstanceCheckRepository
+	CheckerRepository             repository.CheckerRepository
+	EventPublisher                service.EventPublisher
 	Logger                        zLogger.ZLogger
 }
 
 func (c *CheckerHandlerServer) GetObjectInstanceChecksByObjectInstanceId(ctx context.Context, id *pb.Id) (*pb.ObjectInstanceChecks, error) {
-	objectInstanceChecks, err := c.ObjectInstanceCheckRepository.GetObjectInstanceChecksByObjectInstanceId(id.Id)
+	objectInstanceChecks, err := c.ObjectInstanceCheckRepository.GetObjectInstanceChecksByObjectInstanceId(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get objectInstanceChecks for object instance ID %s. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not get objectInstances for object instance ID")
@@ -34,16 +38,16 @@ func (c *CheckerHandlerServer) GetObjectInstanceChecksByObjectInstanceId(ctx con
 }
 
 func (c *CheckerHandlerServer) UpdateObjectInstance(ctx context.Context, objectInstancePb *pb.ObjectInstance) (*pb.NoParam, error) {
-	err := c.ObjectInstanceRepository.UpdateObjectInstance(mapper.ConvertToObjectInstance(objectInstancePb))
+	err := c.ObjectInstanceRepository.UpdateObjectInstance(ctx, mapper.ConvertToObjectInstance(objectInstancePb))
 	if err != nil {
 		c.Logger.Error().Msgf("Could not UpdateObjectInstance with ID %s. err: %v", objectInstancePb.Id, err)
 		return nil, errors.Wrapf(err, "Could not UpdateObjectInstance with ID %s", objectInstancePb.Id)
 	}
-	return nil, nil
+	return &pb.NoParam{}, nil
 }
 
 func (c *CheckerHandlerServer) CreateObjectInstanceCheck(ctx context.Context, objectInstanceCheckPb *pb.ObjectInstanceCheck) (*pb.NoParam, error) {
-	_, err := c.ObjectInstanceCheckRepository.CreateObjectInstanceCheck(mapper.ConvertToObjectInstanceCheck(objectInstanceCheckPb))
+	_, err := c.ObjectInstanceCheckRepository.CreateObjectInstanceCheck(ctx, mapper.ConvertToObjectInstanceCheck(objectInstanceCheckPb))
 	if err != nil {
 		c.Logger.Error().Msgf("Could not create object instance check. err: %v", err)
 		return &pb.NoParam{}, errors.Wrapf(err, "Could not create object instance check")
@@ -52,7 +56,7 @@ func (c *CheckerHandlerServer) CreateObjectInstanceCheck(ctx context.Context, ob
 }
 
 func (c *CheckerHandlerServer) GetObjectById(ctx context.Context, id *pb.Id) (*pb.Object, error) {
-	object, err := c.ObjectRepository.GetObjectById(id.Id)
+	object, err := c.ObjectRepository.GetObjectById(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get object by id: %s. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not get object by id: %s", id.Id)
@@ -61,7 +65,7 @@ func (c *CheckerHandlerServer) GetObjectById(ctx context.Context, id *pb.Id) (*p
 }
 
 func (c *CheckerHandlerServer) GetObjectInstanceExceptListOlderThanWithChecks(ctx context.Context, idsWithInterval *pb.IdsWithSQLInterval) (*pb.ObjectInstance, error) {
-	objectInstance, err := c.ObjectInstanceRepository.GetObjectInstanceExceptListOlderThanWithChecks(idsWithInterval.Ids, idsWithInterval.Interval, idsWithInterval.AvailabilityInterval)
+	objectInstance, err := c.ObjectInstanceRepository.GetObjectInstanceExceptListOlderThanWithChecks(ctx, idsWithInterval.Ids, idsWithInterval.Interval, idsWithInterval.AvailabilityInterval)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not GetObjectInstanceExceptListOlderThanWithChecks. err: %v", err)
 		return nil, errors.Wrapf(err, "Could not GetObjectInstanceExceptListOlderThanWithChecks")
@@ -70,7 +74,7 @@ func (c *CheckerHandlerServer) GetObjectInstanceExceptListOlderThanWithChecks(ct
 }
 
 func (c *CheckerHandlerServer) GetObjectsInstancesByObjectId(ctx context.Context, id *pb.Id) (*pb.ObjectInstances, error) {
-	objectInstances, err := c.ObjectInstanceRepository.GetObjectInstancesByObjectId(id.Id)
+	objectInstances, err := c.ObjectInstanceRepository.GetObjectInstancesByObjectId(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get objectInstances for object ID %s. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not get objectInstances for object ID %s", id.Id)
@@ -82,3 +86,21 @@ func (c *CheckerHandlerServer) GetObjectsInstancesByObjectId(ctx context.Context
 	}
 	return &pb.ObjectInstances{ObjectInstances: objectInstancesPb}, nil
 }
+
+func (c *CheckerHandlerServer) GetRepairCandidates(_ *emptypb.Empty, stream pbHandler.CheckerHandlerService_GetRepairCandidatesServer) error {
+	err := c.CheckerRepository.ExportRepairCandidates(stream.Context(), func(candidate repository.RepairCandidate) error {
+		return stream.Send(&pbHandler.RepairCandidate{ObjectId: candidate.ObjectId, SourceObjectInstanceId: candidate.SourceObjectInstanceId,
+			SourcePath: candidate.SourcePath, SourceStorageLocationId: candidate.SourceStorageLocationId, SourceStorageLocationAlias: candidate.SourceStorageLocationAlias,
+			TargetObjectInstanceId: candidate.TargetObjectInstanceId, TargetPath: candidate.TargetPath, TargetStorageLocationId: candidate.TargetStorageLocationId,
+			TargetStorageLocationAlias: candidate.TargetStorageLocationAlias})
+	})
+	if err != nil {
+		c.Logger.Error().Msgf("Could not get repair candidates. err: %v", err)
+		return errors.Wrapf(err, "Could not get repair candidates")
+	}
+	return nil
+}
+
+func (c *CheckerHandlerServer) WatchEvents(request *pbHandler.WatchEventsRequest, stream pbHandler.CheckerHandlerService_WatchEventsServer) error {
+	return watchEvents(request, stream, c.EventPublisher, c.Logger)
+}
@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"path"
+	"slices"
 	"strings"
 	"time"
 
 	"emperror.dev/errors"
+	"github.com/jackc/pgx/v5"
 	"github.com/je4/utils/v2/pkg/zLogger"
 	pbHandler "github.com/ocfl-archive/dlza-manager-handler/handlerproto"
 	"github.com/ocfl-archive/dlza-manager-handler/repository"
@@ -16,28 +18,38 @@ import (
 	pb "github.com/ocfl-archive/dlza-manager/dlzamanagerproto"
 	"github.com/ocfl-archive/dlza-manager/mapper"
 	"github.com/ocfl-archive/dlza-manager/models"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type ClerkHandlerServer struct {
 	pbHandler.UnimplementedClerkHandlerServiceServer
-	TenantService                      service.TenantService
-	CollectionRepository               repository.CollectionRepository
-	StorageLocationRepository          repository.StorageLocationRepository
-	StoragePartitionRepository         repository.StoragePartitionRepository
-	ObjectRepository                   repository.ObjectRepository
-	ObjectInstanceRepository           repository.ObjectInstanceRepository
-	FileRepository                     repository.FileRepository
-	ObjectInstanceCheckRepository      repository.ObjectInstanceCheckRepository
-	StatusRepository                   repository.StatusRepository
-	ObjectInstanceService              service.ObjectInstanceService
-	TenantRepository                   repository.TenantRepository
-	StorageLocationService             service.StorageLocationService
-	RefreshMaterializedViewsRepository repository.RefreshMaterializedViewsRepository
-	Logger                             zLogger.ZLogger
+	TenantService                 service.TenantService
+	CollectionRepository          repository.CollectionRepository
+	StorageLocationRepository     repository.StorageLocationRepository
+	StoragePartitionRepository    repository.StoragePartitionRepository
+	ObjectRepository              repository.ObjectRepository
+	ObjectInstanceRepository      repository.ObjectInstanceRepository
+	FileRepository                repository.FileRepository
+	ObjectInstanceCheckRepository repository.ObjectInstanceCheckRepository
+	StatusRepository              repository.StatusRepository
+	ObjectInstanceService         service.ObjectInstanceService
+	TenantRepository              repository.TenantRepository
+	StorageLocationService        service.StorageLocationService
+	MaterializedViewRefresher     service.MaterializedViewRefresher
+	TransactionRepository         repository.TransactionRepository
+	AuditRepository               repository.AuditRepository
+	PlacementRepository           repository.PlacementRepository
+	CapacityForecastService       service.CapacityForecastService
+	Logger                        zLogger.ZLogger
+}
+
+func (c *ClerkHandlerServer) audit(ctx context.Context, tx pgx.Tx, entityType string, entityId string, before any, after any) error {
+	return recordAudit(ctx, tx, c.AuditRepository, entityType, entityId, before, after)
 }
 
 func (c *ClerkHandlerServer) GetObjectInstancesBySignatureAndLocationsPathName(ctx context.Context, signatureAndLocationsName *pb.AliasAndLocationsName) (*pb.ObjectInstance, error) {
-	objectInstance, err := c.ObjectInstanceRepository.GetObjectInstancesBySignatureAndLocationsPathName(signatureAndLocationsName.Alias, signatureAndLocationsName.LocationsName)
+	objectInstance, err := c.ObjectInstanceRepository.GetObjectInstancesBySignatureAndLocationsPathName(ctx, signatureAndLocationsName.Alias, signatureAndLocationsName.LocationsName)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not GetObjectInstancesBySignatureAndLocationsPathName with alias: '%s'. err: %v", signatureAndLocationsName.Alias, err)
 		return nil, errors.Wrapf(err, "Could not GetObjectInstancesBySignatureAndLocationsPathName with alias: '%s'", signatureAndLocationsName.Alias)
@@ -47,7 +59,7 @@ func (c *ClerkHandlerServer) GetObjectInstancesBySignatureAndLocationsPathName(c
 }
 
 func (c *ClerkHandlerServer) FindTenantById(ctx context.Context, id *pb.Id) (*pb.Tenant, error) {
-	tenant, err := c.TenantService.FindTenantById(id.Id)
+	tenant, err := c.TenantService.FindTenantById(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get tenant with id: '%s'. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not get tenant with id: '%s'", id.Id)
@@ -57,17 +69,19 @@ func (c *ClerkHandlerServer) FindTenantById(ctx context.Context, id *pb.Id) (*pb
 }
 
 func (c *ClerkHandlerServer) GetCollectionByIdFromMv(ctx context.Context, id *pb.Id) (*pb.Collection, error) {
-	collection, err := c.CollectionRepository.GetCollectionByIdFromMv(id.Id)
+	fresh := isFreshRequested(ctx)
+	collection, err := c.CollectionRepository.GetCollectionByIdFromMv(ctx, id.Id, fresh)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get collection from materialized view with id: '%s'. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not get collection from materialized view with id: '%s'", id.Id)
 	}
+	setMaterializedViewHeader(ctx, c.MaterializedViewRefresher, repository.MatCollObjFile, fresh)
 	collectionPb := mapper.ConvertToCollectionPb(collection)
 	return collectionPb, nil
 }
 
 func (c *ClerkHandlerServer) GetCollectionById(ctx context.Context, id *pb.Id) (*pb.Collection, error) {
-	collection, err := c.CollectionRepository.GetCollectionById(id.Id)
+	collection, err := c.CollectionRepository.GetCollectionById(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get collection with id: '%s'. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not get collection with id: '%s'", id.Id)
@@ -77,7 +91,16 @@ func (c *ClerkHandlerServer) GetCollectionById(ctx context.Context, id *pb.Id) (
 }
 
 func (c *ClerkHandlerServer) DeleteTenant(ctx context.Context, id *pb.Id) (*pb.Status, error) {
-	err := c.TenantService.DeleteTenant(id.Id)
+	err := c.TransactionRepository.InTransaction(ctx, func(tx pgx.Tx) error {
+		before, err := c.TenantRepository.FindTenantByIdTx(ctx, tx, id.Id)
+		if err != nil {
+			return err
+		}
+		if err := c.TenantRepository.DeleteTenantTx(ctx, tx, id.Id); err != nil {
+			return err
+		}
+		return c.audit(ctx, tx, repository.AuditEntityTenant, id.Id, before, nil)
+	})
 	if err != nil {
 		c.Logger.Error().Msgf("Could not delete tenant with id: '%s'. err: %v", id.Id, err)
 		return &pb.Status{Ok: false}, errors.Wrapf(err, "Could not delete tenant with id: '%s'", id.Id)
@@ -86,7 +109,12 @@ func (c *ClerkHandlerServer) DeleteTenant(ctx context.Context, id *pb.Id) (*pb.S
 }
 
 func (c *ClerkHandlerServer) SaveTenant(ctx context.Context, tenantPb *pb.Tenant) (*pb.Status, error) {
-	err := c.TenantService.SaveTenant(mapper.ConvertToTenant(tenantPb))
+	err := c.TransactionRepository.InTransaction(ctx, func(tx pgx.Tx) error {
+		_, err := auditedCreate(ctx, tx, c.AuditRepository, repository.AuditEntityTenant, c.TenantRepository.FindTenantByIdTx, func() (string, error) {
+			return c.TenantRepository.SaveTenantTx(ctx, tx, mapper.ConvertToTenant(tenantPb))
+		})
+		return err
+	})
 	if err != nil {
 		c.Logger.Error().Msgf("Could not save tenant '%s'. err: %v", tenantPb.Name, err)
 		return &pb.Status{Ok: false}, errors.Wrapf(err, "Could not save tenant '%s'", tenantPb.Name)
@@ -95,7 +123,20 @@ func (c *ClerkHandlerServer) SaveTenant(ctx context.Context, tenantPb *pb.Tenant
 }
 
 func (c *ClerkHandlerServer) UpdateTenant(ctx context.Context, tenantPb *pb.Tenant) (*pb.Status, error) {
-	err := c.TenantService.UpdateTenant(mapper.ConvertToTenant(tenantPb))
+	err := c.TransactionRepository.InTransaction(ctx, func(tx pgx.Tx) error {
+		before, err := c.TenantRepository.FindTenantByIdTx(ctx, tx, tenantPb.Id)
+		if err != nil {
+			return err
+		}
+		if err := c.TenantRepository.UpdateTenantTx(ctx, tx, mapper.ConvertToTenant(tenantPb)); err != nil {
+			return err
+		}
+		after, err := c.TenantRepository.FindTenantByIdTx(ctx, tx, tenantPb.Id)
+		if err != nil {
+			return err
+		}
+		return c.audit(ctx, tx, repository.AuditEntityTenant, tenantPb.Id, before, after)
+	})
 	if err != nil {
 		c.Logger.Error().Msgf("Could not save tenant '%s'. err: %v", tenantPb.Name, err)
 		return &pb.Status{Ok: false}, errors.Wrapf(err, "Could not save tenant '%s'", tenantPb.Name)
@@ -104,7 +145,7 @@ func (c *ClerkHandlerServer) UpdateTenant(ctx context.Context, tenantPb *pb.Tena
 }
 
 func (c *ClerkHandlerServer) FindAllTenants(ctx context.Context, status *pb.NoParam) (*pb.Tenants, error) {
-	tenants, err := c.TenantService.FindAllTenants()
+	tenants, err := c.TenantService.FindAllTenants(ctx)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get all tenants")
 		return nil, errors.Wrapf(err, "Could not get all tenants")
@@ -119,50 +160,65 @@ func (c *ClerkHandlerServer) FindAllTenants(ctx context.Context, status *pb.NoPa
 }
 
 func (c *ClerkHandlerServer) CreateCollection(ctx context.Context, collectionPb *pb.Collection) (*pb.Id, error) {
-	id, err := c.CollectionRepository.CreateCollection(mapper.ConvertToCollection(collectionPb))
+	var id string
+	err := c.TransactionRepository.InTransaction(ctx, func(tx pgx.Tx) error {
+		var err error
+		id, err = auditedCreate(ctx, tx, c.AuditRepository, repository.AuditEntityCollection, c.CollectionRepository.GetCollectionByIdTx, func() (string, error) {
+			return c.CollectionRepository.CreateCollectionTx(ctx, tx, mapper.ConvertToCollection(collectionPb))
+		})
+		return err
+	})
 	if err != nil {
 		c.Logger.Error().Msgf("Could not create collection '%s'. err: %v", collectionPb.Name, err)
 		return nil, errors.Wrapf(err, "Could not create collection '%s'", collectionPb.Name)
 	}
-	err = c.RefreshMaterializedViewsRepository.RefreshMaterializedViewsFromCollectionToFile()
+	err = c.MaterializedViewRefresher.Request(repository.MatCollObjFile)
 	if err != nil {
-		c.Logger.Error().Msgf("Could not RefreshMaterializedViewsFromCollectionToFile for collection '%s'. err: %v", collectionPb.Name, err)
-		return nil, errors.Wrapf(err, "Could not RefreshMaterializedViewsFromCollectionToFile for collection '%s'", collectionPb.Name)
+		c.Logger.Error().Msgf("Could not request refresh of materialized view for collection '%s'. err: %v", collectionPb.Name, err)
+		return nil, errors.Wrapf(err, "Could not request refresh of materialized view for collection '%s'", collectionPb.Name)
 	}
 	return &pb.Id{Id: id}, nil
 
 }
 
 func (c *ClerkHandlerServer) UpdateCollection(ctx context.Context, collectionPb *pb.Collection) (*pb.Status, error) {
-	err := c.CollectionRepository.UpdateCollection(mapper.ConvertToCollection(collectionPb))
+	err := c.TransactionRepository.InTransaction(ctx, func(tx pgx.Tx) error {
+		return auditedUpdate(ctx, tx, c.AuditRepository, repository.AuditEntityCollection, collectionPb.Id, c.CollectionRepository.GetCollectionByIdTx, func() error {
+			return c.CollectionRepository.UpdateCollectionTx(ctx, tx, mapper.ConvertToCollection(collectionPb))
+		})
+	})
 	if err != nil {
 		c.Logger.Error().Msgf("Could not update collection '%s'. err: %v", collectionPb.Name, err)
 		return &pb.Status{Ok: false}, errors.Wrapf(err, "Could not update collection '%s'", collectionPb.Name)
 	}
-	err = c.RefreshMaterializedViewsRepository.RefreshMaterializedViewsFromCollectionToFile()
+	err = c.MaterializedViewRefresher.Request(repository.MatCollObjFile)
 	if err != nil {
-		c.Logger.Error().Msgf("Could not RefreshMaterializedViewsFromCollectionToFile for collection '%s'. err: %v", collectionPb.Name, err)
-		return nil, errors.Wrapf(err, "Could not RefreshMaterializedViewsFromCollectionToFile for collection '%s'", collectionPb.Name)
+		c.Logger.Error().Msgf("Could not request refresh of materialized view for collection '%s'. err: %v", collectionPb.Name, err)
+		return nil, errors.Wrapf(err, "Could not request refresh of materialized view for collection '%s'", collectionPb.Name)
 	}
 	return &pb.Status{Ok: true}, nil
 }
 
 func (c *ClerkHandlerServer) DeleteCollectionById(ctx context.Context, id *pb.Id) (*pb.Status, error) {
-	err := c.CollectionRepository.DeleteCollectionById(id.Id)
+	err := c.TransactionRepository.InTransaction(ctx, func(tx pgx.Tx) error {
+		return auditedDelete(ctx, tx, c.AuditRepository, repository.AuditEntityCollection, id.Id, c.CollectionRepository.GetCollectionByIdTx, func() error {
+			return c.CollectionRepository.DeleteCollectionByIdTx(ctx, tx, id.Id)
+		})
+	})
 	if err != nil {
 		c.Logger.Error().Msgf("Could not delete collection with id: '%s'. err: %v", id.Id, err)
 		return &pb.Status{Ok: false}, errors.Wrapf(err, "Could not delete collection with id: '%s'", id.Id)
 	}
-	err = c.RefreshMaterializedViewsRepository.RefreshMaterializedViewsFromCollectionToFile()
+	err = c.MaterializedViewRefresher.Request(repository.MatCollObjFile)
 	if err != nil {
-		c.Logger.Error().Msgf("Could not RefreshMaterializedViewsFromCollectionToFile after deleting collection with id '%s'. err: %v", id, err)
-		return nil, errors.Wrapf(err, "Could not RefreshMaterializedViewsFromCollectionToFile after deleting collection with id '%s'", id)
+		c.Logger.Error().Msgf("Could not request refresh of materialized view after deleting collection with id '%s'. err: %v", id, err)
+		return nil, errors.Wrapf(err, "Could not request refresh of materialized view after deleting collection with id '%s'", id)
 	}
 	return &pb.Status{Ok: true}, nil
 }
 
 func (c *ClerkHandlerServer) GetCollectionsByTenantId(ctx context.Context, id *pb.Id) (*pb.Collections, error) {
-	collections, err := c.CollectionRepository.GetCollectionsByTenantId(id.Id)
+	collections, err := c.CollectionRepository.GetCollectionsByTenantId(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get collections by tenant with id: '%s'. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not get collections by tenant with id: '%s'", id.Id)
@@ -177,7 +233,14 @@ func (c *ClerkHandlerServer) GetCollectionsByTenantId(ctx context.Context, id *p
 }
 
 func (c *ClerkHandlerServer) SaveStorageLocation(ctx context.Context, storageLocationPb *pb.StorageLocation) (*pb.Id, error) {
-	id, err := c.StorageLocationRepository.SaveStorageLocation(mapper.ConvertToStorageLocation(storageLocationPb))
+	var id string
+	err := c.TransactionRepository.InTransaction(ctx, func(tx pgx.Tx) error {
+		var err error
+		id, err = auditedCreate(ctx, tx, c.AuditRepository, repository.AuditEntityStorageLocation, c.StorageLocationRepository.GetStorageLocationByIdTx, func() (string, error) {
+			return c.StorageLocationRepository.SaveStorageLocationTx(ctx, tx, mapper.ConvertToStorageLocation(storageLocationPb))
+		})
+		return err
+	})
 	if err != nil {
 		c.Logger.Error().Msgf("Could not create storageLocation '%s'. err: %v", storageLocationPb.Alias, err)
 		return nil, errors.Wrapf(err, "Could not create storageLocation '%s'", storageLocationPb.Alias)
@@ -186,7 +249,11 @@ func (c *ClerkHandlerServer) SaveStorageLocation(ctx context.Context, storageLoc
 }
 
 func (c *ClerkHandlerServer) UpdateStorageLocation(ctx context.Context, storageLocationPb *pb.StorageLocation) (*pb.Status, error) {
-	err := c.StorageLocationRepository.UpdateStorageLocation(mapper.ConvertToStorageLocation(storageLocationPb))
+	err := c.TransactionRepository.InTransaction(ctx, func(tx pgx.Tx) error {
+		return auditedUpdate(ctx, tx, c.AuditRepository, repository.AuditEntityStorageLocation, storageLocationPb.Id, c.StorageLocationRepository.GetStorageLocationByIdTx, func() error {
+			return c.StorageLocationRepository.UpdateStorageLocationTx(ctx, tx, mapper.ConvertToStorageLocation(storageLocationPb))
+		})
+	})
 	if err != nil {
 		c.Logger.Error().Msgf("Could not update storageLocation '%s'. err: %v", storageLocationPb.Alias, err)
 		return &pb.Status{Ok: false}, errors.Wrapf(err, "Could not update storageLocation '%s'", storageLocationPb.Alias)
@@ -200,21 +267,35 @@ func (c *ClerkHandlerServer) CreateStoragePartition(ctx context.Context, storage
 		return nil, errors.Wrapf(err, "Could not getAliases err: %v", err)
 	}
 	storagePartitionPb.Alias = alias
-	partitionGroupId, err := c.StoragePartitionRepository.CreateStoragePartition(mapper.ConvertToStoragePartition(storagePartitionPb))
+	var id string
+	err = c.TransactionRepository.InTransaction(ctx, func(tx pgx.Tx) error {
+		partitionGroupId, err := c.StoragePartitionRepository.CreateStoragePartitionTx(ctx, tx, mapper.ConvertToStoragePartition(storagePartitionPb))
+		if err != nil {
+			return errors.Wrapf(err, "Could not create storagePartition '%s'", storagePartitionPb.Alias)
+		}
+		id, err = c.StoragePartitionRepository.CreateStoragePartitionGroupElementTx(ctx, tx, models.StoragePartitionGroup{PartitionGroupId: partitionGroupId, Name: storagePartitionPb.Name, Alias: groupAlias})
+		if err != nil {
+			return errors.Wrapf(err, "Could not create CreateStoragePartitionGroupElement '%s'", storagePartitionPb.Alias)
+		}
+		after, err := c.StoragePartitionRepository.GetStoragePartitionByIdTx(ctx, tx, partitionGroupId)
+		if err != nil {
+			return errors.Wrapf(err, "Could not get created storagePartition '%s'", storagePartitionPb.Alias)
+		}
+		return c.audit(ctx, tx, repository.AuditEntityStoragePartition, partitionGroupId, nil, after)
+	})
 	if err != nil {
 		c.Logger.Error().Msgf("Could not create storagePartition '%s'. err: %v", storagePartitionPb.Alias, err)
-		return nil, errors.Wrapf(err, "Could not create storagePartition '%s'", storagePartitionPb.Alias)
-	}
-	id, err := c.StoragePartitionRepository.CreateStoragePartitionGroupElement(models.StoragePartitionGroup{PartitionGroupId: partitionGroupId, Name: storagePartitionPb.Name, Alias: groupAlias})
-	if err != nil {
-		c.Logger.Error().Msgf("Could not create CreateStoragePartitionGroupElement '%s'. err: %v", storagePartitionPb.Alias, err)
-		return nil, errors.Wrapf(err, "Could not create CreateStoragePartitionGroupElement '%s'", storagePartitionPb.Alias)
+		return nil, err
 	}
 	return &pb.Id{Id: id}, nil
 }
 
 func (c *ClerkHandlerServer) UpdateStoragePartition(ctx context.Context, storagePartitionPb *pb.StoragePartition) (*pb.Status, error) {
-	err := c.StoragePartitionRepository.UpdateStoragePartition(mapper.ConvertToStoragePartition(storagePartitionPb))
+	err := c.TransactionRepository.InTransaction(ctx, func(tx pgx.Tx) error {
+		return auditedUpdate(ctx, tx, c.AuditRepository, repository.AuditEntityStoragePartition, storagePartitionPb.Id, c.StoragePartitionRepository.GetStoragePartitionByIdTx, func() error {
+			return c.StoragePartitionRepository.UpdateStoragePartitionTx(ctx, tx, mapper.ConvertToStoragePartition(storagePartitionPb))
+		})
+	})
 	if err != nil {
 		c.Logger.Error().Msgf("Could not update storagePartition '%s'. err: %v", storagePartitionPb.Alias, err)
 		return &pb.Status{Ok: false}, errors.Wrapf(err, "Could not update storagePartition '%s'", storagePartitionPb.Alias)
@@ -223,35 +304,47 @@ func (c *ClerkHandlerServer) UpdateStoragePartition(ctx context.Context, storage
 }
 
 func (c *ClerkHandlerServer) DeleteStoragePartitionById(ctx context.Context, id *pb.Id) (*pb.Status, error) {
-	storagePartitionGroupElem, err := c.StoragePartitionRepository.GetStoragePartitionGroupElementById(id.Id)
+	storagePartitionGroupElem, err := c.StoragePartitionRepository.GetStoragePartitionGroupElementById(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not GetStoragePartitionGroupElementsByStoragePartitionId with partition id: '%s'. err: %v", id.Id, err)
 		return &pb.Status{Ok: false}, errors.Wrapf(err, "Could not GetStoragePartitionGroupElementsByStoragePartitionId with partition id: '%s'", id.Id)
 	}
-	storagePartitionGroupElements, err := c.StoragePartitionRepository.GetStoragePartitionGroupElementsByStoragePartitionId(storagePartitionGroupElem.PartitionGroupId)
+	storagePartitionGroupElements, err := c.StoragePartitionRepository.GetStoragePartitionGroupElementsByStoragePartitionId(ctx, storagePartitionGroupElem.PartitionGroupId)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not GetStoragePartitionGroupElementsByStoragePartitionId with partition id: '%s'. err: %v", id.Id, err)
 		return &pb.Status{Ok: false}, errors.Wrapf(err, "Could not GetStoragePartitionGroupElementsByStoragePartitionId with partition id: '%s'", id.Id)
 	}
 	if len(storagePartitionGroupElements) > 1 {
 		c.Logger.Error().Msgf("You are not allowed to proceed with deleting partition with id: '%s'", id.Id)
-		return &pb.Status{Ok: false}, errors.New(fmt.Sprintf("You are not allowed to proceed with deleting partition with id: '%s'", id.Id))
-	}
-	err = c.StoragePartitionRepository.DeleteStoragePartitionGroupElementByStoragePartitionId(storagePartitionGroupElem.PartitionGroupId)
-	if err != nil {
-		c.Logger.Error().Msgf("Could not delete DeleteStoragePartitionGroupElementByStoragePartitionId with partition id: '%s'. err: %v", id.Id, err)
-		return &pb.Status{Ok: false}, errors.Wrapf(err, "Could not delete storagePartition with partition id: '%s'", id.Id)
-	}
-	err = c.StoragePartitionRepository.DeleteStoragePartitionById(storagePartitionGroupElem.PartitionGroupId)
+		return &pb.Status{Ok: false}, errors.Wrapf(repository.ErrFailedPrecondition, "You are not allowed to proceed with deleting partition with id: '%s'", id.Id)
+	}
+	err = c.TransactionRepository.InTransaction(ctx, func(tx pgx.Tx) error {
+		return auditedDelete(ctx, tx, c.AuditRepository, repository.AuditEntityStoragePartition, storagePartitionGroupElem.PartitionGroupId,
+			c.StoragePartitionRepository.GetStoragePartitionByIdTx, func() error {
+				err := c.StoragePartitionRepository.DeleteStoragePartitionGroupElementByStoragePartitionIdTx(ctx, tx, storagePartitionGroupElem.PartitionGroupId)
+				if err != nil {
+					return errors.Wrapf(err, "Could not delete storagePartitionGroupElements with partition id: '%s'", id.Id)
+				}
+				err = c.StoragePartitionRepository.DeleteStoragePartitionByIdTx(ctx, tx, storagePartitionGroupElem.PartitionGroupId)
+				if err != nil {
+					return errors.Wrapf(err, "Could not delete storagePartition with id: '%s'", id.Id)
+				}
+				return nil
+			})
+	})
 	if err != nil {
 		c.Logger.Error().Msgf("Could not delete storagePartition with id: '%s'. err: %v", id.Id, err)
-		return &pb.Status{Ok: false}, errors.Wrapf(err, "Could not delete storagePartition with id: '%s'", id.Id)
+		return &pb.Status{Ok: false}, err
 	}
 	return &pb.Status{Ok: true}, nil
 }
 
 func (c *ClerkHandlerServer) DeleteStorageLocationById(ctx context.Context, id *pb.Id) (*pb.Status, error) {
-	err := c.StorageLocationRepository.DeleteStorageLocationById(id.Id)
+	err := c.TransactionRepository.InTransaction(ctx, func(tx pgx.Tx) error {
+		return auditedDelete(ctx, tx, c.AuditRepository, repository.AuditEntityStorageLocation, id.Id, c.StorageLocationRepository.GetStorageLocationByIdTx, func() error {
+			return c.StorageLocationRepository.DeleteStorageLocationByIdTx(ctx, tx, id.Id)
+		})
+	})
 	if err != nil {
 		c.Logger.Error().Msgf("Could not delete storageLocation with id: '%s'. err: %v", id.Id, err)
 		return &pb.Status{Ok: false}, errors.Wrapf(err, "Could not delete storageLocation with id: '%s'", id.Id)
@@ -260,7 +353,7 @@ func (c *ClerkHandlerServer) DeleteStorageLocationById(ctx context.Context, id *
 }
 
 func (c *ClerkHandlerServer) GetStorageLocationsByTenantId(ctx context.Context, tenantId *pb.Id) (*pb.StorageLocations, error) {
-	storageLocations, err := c.StorageLocationRepository.GetStorageLocationsByTenantId(tenantId.Id)
+	storageLocations, err := c.StorageLocationRepository.GetStorageLocationsByTenantId(ctx, tenantId.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get storageLocations by tenant with id: '%s'. err: %v", tenantId.Id, err)
 		return nil, errors.Wrapf(err, "Could not get storageLocations by tenant with id: '%s'", tenantId.Id)
@@ -275,17 +368,19 @@ func (c *ClerkHandlerServer) GetStorageLocationsByTenantId(ctx context.Context,
 }
 
 func (c *ClerkHandlerServer) GetObjectById(ctx context.Context, id *pb.Id) (*pb.Object, error) {
-	object, err := c.ObjectRepository.GetObjectByIdMv(id.Id)
+	fresh := isFreshRequested(ctx)
+	object, err := c.ObjectRepository.GetObjectByIdMv(ctx, id.Id, fresh)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not GetObjectById with id: '%s'. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not GetObjectById with id: '%s'", id.Id)
 	}
+	setMaterializedViewHeader(ctx, c.MaterializedViewRefresher, repository.MatCollObj, fresh)
 	objectPb := mapper.ConvertToObjectPb(object)
 	return objectPb, nil
 }
 
 func (c *ClerkHandlerServer) GetObjectBySignature(ctx context.Context, id *pb.Id) (*pb.Object, error) {
-	object, err := c.ObjectRepository.GetObjectBySignature(id.Id)
+	object, err := c.ObjectRepository.GetObjectBySignature(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get object by signature: %s. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not get object by signature: %s", id.Id)
@@ -294,7 +389,7 @@ func (c *ClerkHandlerServer) GetObjectBySignature(ctx context.Context, id *pb.Id
 }
 
 func (c *ClerkHandlerServer) GetObjectInstanceById(ctx context.Context, id *pb.Id) (*pb.ObjectInstance, error) {
-	objectInstance, err := c.ObjectInstanceRepository.GetObjectInstanceById(id.Id)
+	objectInstance, err := c.ObjectInstanceRepository.GetObjectInstanceById(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not GetObjectInstanceById with id: '%s'. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not GetObjectInstanceById with id: '%s'", id.Id)
@@ -304,7 +399,7 @@ func (c *ClerkHandlerServer) GetObjectInstanceById(ctx context.Context, id *pb.I
 }
 
 func (c *ClerkHandlerServer) GetFileById(ctx context.Context, id *pb.Id) (*pb.File, error) {
-	file, err := c.FileRepository.GetFileById(id.Id)
+	file, err := c.FileRepository.GetFileById(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not GetFileById with id: '%s'. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not GetFileById with id: '%s'", id.Id)
@@ -314,7 +409,7 @@ func (c *ClerkHandlerServer) GetFileById(ctx context.Context, id *pb.Id) (*pb.Fi
 }
 
 func (c *ClerkHandlerServer) GetObjectInstanceCheckById(ctx context.Context, id *pb.Id) (*pb.ObjectInstanceCheck, error) {
-	objectInstanceCheck, err := c.ObjectInstanceCheckRepository.GetObjectInstanceCheckById(id.Id)
+	objectInstanceCheck, err := c.ObjectInstanceCheckRepository.GetObjectInstanceCheckById(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not GetObjectInstanceCheckById with id: '%s'. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not GetObjectInstanceCheckById with id: '%s'", id.Id)
@@ -324,7 +419,7 @@ func (c *ClerkHandlerServer) GetObjectInstanceCheckById(ctx context.Context, id
 }
 
 func (c *ClerkHandlerServer) GetStorageLocationById(ctx context.Context, id *pb.Id) (*pb.StorageLocation, error) {
-	storageLocation, err := c.StorageLocationRepository.GetStorageLocationById(id.Id)
+	storageLocation, err := c.StorageLocationRepository.GetStorageLocationById(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not GetStorageLocationById with id: '%s'. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not GetStorageLocationById with id: '%s'", id.Id)
@@ -334,7 +429,7 @@ func (c *ClerkHandlerServer) GetStorageLocationById(ctx context.Context, id *pb.
 }
 
 func (c *ClerkHandlerServer) GetStoragePartitionById(ctx context.Context, id *pb.Id) (*pb.StoragePartition, error) {
-	storagePartition, err := c.StoragePartitionRepository.GetStoragePartitionById(id.Id)
+	storagePartition, err := c.StoragePartitionRepository.GetStoragePartitionById(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not GetStoragePartitionById with id: '%s'. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not GetStoragePartitionById with id: '%s'", id.Id)
@@ -346,7 +441,7 @@ func (c *ClerkHandlerServer) GetStoragePartitionById(ctx context.Context, id *pb
 /////Paginated methods
 
 func (c *ClerkHandlerServer) FindAllTenantsPaginated(ctx context.Context, pagination *pb.Pagination) (*pb.Tenants, error) {
-	tenants, totalItems, err := c.TenantService.FindAllTenantsPaginated(mapper.ConvertToPagination(pagination))
+	tenants, totalItems, err := c.TenantService.FindAllTenantsPaginated(ctx, mapper.ConvertToPagination(pagination))
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get all tenants. err: %v", err)
 		return nil, errors.Wrapf(err, "Could not get all tenants")
@@ -361,11 +456,13 @@ func (c *ClerkHandlerServer) FindAllTenantsPaginated(ctx context.Context, pagina
 }
 
 func (c *ClerkHandlerServer) GetCollectionsByTenantIdPaginated(ctx context.Context, pagination *pb.Pagination) (*pb.Collections, error) {
-	collections, totalItems, err := c.CollectionRepository.GetCollectionsByTenantIdPaginated(mapper.ConvertToPagination(pagination))
+	fresh := isFreshRequested(ctx)
+	collections, totalItems, err := c.CollectionRepository.GetCollectionsByTenantIdPaginated(ctx, mapper.ConvertToPagination(pagination), fresh)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get collections by tenant with id: '%s'. err: %v", pagination.Id, err)
 		return nil, errors.Wrapf(err, "Could not get collections by tenant with id: '%s'", pagination.Id)
 	}
+	setMaterializedViewHeader(ctx, c.MaterializedViewRefresher, repository.MatCollObjFile, fresh)
 	var collectionsPb []*pb.Collection
 
 	for _, collection := range collections {
@@ -377,7 +474,7 @@ func (c *ClerkHandlerServer) GetCollectionsByTenantIdPaginated(ctx context.Conte
 
 func (c *ClerkHandlerServer) GetStorageLocationsByTenantOrCollectionIdPaginated(ctx context.Context, pagination *pb.Pagination) (*pb.StorageLocations, error) {
 	if pagination.Id == "" {
-		tenant, err := c.TenantRepository.FindTenantByCollectionId(pagination.SecondId)
+		tenant, err := c.TenantRepository.FindTenantByCollectionId(ctx, pagination.SecondId)
 		if err != nil {
 			c.Logger.Error().Msgf("Could not get c by collection id: '%s'. err: %v", pagination.SecondId, err)
 			return nil, errors.Wrapf(err, "Could not get tenant by collection id: '%s'", pagination.SecondId)
@@ -385,7 +482,7 @@ func (c *ClerkHandlerServer) GetStorageLocationsByTenantOrCollectionIdPaginated(
 		pagination.Id = tenant.Id
 	}
 
-	storageLocations, totalItems, err := c.StorageLocationRepository.GetStorageLocationsByTenantOrCollectionIdPaginated(mapper.ConvertToPagination(pagination))
+	storageLocations, totalItems, err := c.StorageLocationRepository.GetStorageLocationsByTenantOrCollectionIdPaginated(ctx, mapper.ConvertToPagination(pagination))
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get storageLocations by collection with id: '%s'. err: %v", pagination.Id, err)
 		return nil, errors.Wrapf(err, "Could not get storageLocations by collection with id: '%s'", pagination.Id)
@@ -400,7 +497,7 @@ func (c *ClerkHandlerServer) GetStorageLocationsByTenantOrCollectionIdPaginated(
 }
 
 func (c *ClerkHandlerServer) GetStoragePartitionsByLocationIdPaginated(ctx context.Context, pagination *pb.Pagination) (*pb.StoragePartitions, error) {
-	storagePartitions, totalItems, err := c.StoragePartitionRepository.GetStoragePartitionsByLocationIdPaginated(mapper.ConvertToPagination(pagination))
+	storagePartitions, totalItems, err := c.StoragePartitionRepository.GetStoragePartitionsByLocationIdPaginated(ctx, mapper.ConvertToPagination(pagination))
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get GetStoragePartitionsByLocationIdPaginated by storage location with id: '%s'. err: %v", pagination.Id, err)
 		return nil, errors.Wrapf(err, "Could not get GetStoragePartitionsByLocationIdPaginated by storage location with id: '%s'", pagination.Id)
@@ -416,11 +513,13 @@ func (c *ClerkHandlerServer) GetStoragePartitionsByLocationIdPaginated(ctx conte
 
 func (c *ClerkHandlerServer) GetObjectsByCollectionIdPaginated(ctx context.Context, pagination *pb.Pagination) (*pb.Objects, error) {
 	c.Logger.Debug().Msgf("grpc function GetObjectsByCollectionIdPaginated called %s", time.Now())
-	objects, totalItems, err := c.ObjectRepository.GetObjectsByCollectionIdPaginated(mapper.ConvertToPagination(pagination))
+	fresh := isFreshRequested(ctx)
+	objects, totalItems, err := c.ObjectRepository.GetObjectsByCollectionIdPaginated(ctx, mapper.ConvertToPagination(pagination), fresh)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get paginated objects by collection with id: '%s'. err: %v", pagination.Id, err)
 		return nil, errors.Wrapf(err, "Could not get paginated objects by collection with id: '%s'", pagination.Id)
 	}
+	setMaterializedViewHeader(ctx, c.MaterializedViewRefresher, repository.MatCollObj, fresh)
 	var objectsPb []*pb.Object
 
 	for _, object := range objects {
@@ -431,7 +530,7 @@ func (c *ClerkHandlerServer) GetObjectsByCollectionIdPaginated(ctx context.Conte
 }
 
 func (c *ClerkHandlerServer) GetFilesByCollectionIdPaginated(ctx context.Context, pagination *pb.Pagination) (*pb.Files, error) {
-	files, totalItems, err := c.FileRepository.GetFilesByCollectionIdPaginated(mapper.ConvertToPagination(pagination))
+	files, totalItems, err := c.FileRepository.GetFilesByCollectionIdPaginated(ctx, mapper.ConvertToPagination(pagination))
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get paginated files by collection with id: '%s'. err: %v", pagination.Id, err)
 		return nil, errors.Wrapf(err, "Could not get paginated files by collection with id: '%s'", pagination.Id)
@@ -445,7 +544,7 @@ func (c *ClerkHandlerServer) GetFilesByCollectionIdPaginated(ctx context.Context
 }
 
 func (c *ClerkHandlerServer) GetObjectInstancesByObjectIdPaginated(ctx context.Context, pagination *pb.Pagination) (*pb.ObjectInstances, error) {
-	objectInstances, totalItems, err := c.ObjectInstanceRepository.GetObjectInstancesByObjectIdPaginated(mapper.ConvertToPagination(pagination))
+	objectInstances, totalItems, err := c.ObjectInstanceRepository.GetObjectInstancesByObjectIdPaginated(ctx, mapper.ConvertToPagination(pagination))
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get paginated objectInstances by object with id: '%s'. err: %v", pagination.Id, err)
 		return nil, errors.Wrapf(err, "Could not get paginated objectInstances by object with id: '%s'", pagination.Id)
@@ -459,7 +558,7 @@ func (c *ClerkHandlerServer) GetObjectInstancesByObjectIdPaginated(ctx context.C
 }
 
 func (c *ClerkHandlerServer) GetFilesByObjectIdPaginated(ctx context.Context, pagination *pb.Pagination) (*pb.Files, error) {
-	files, totalItems, err := c.FileRepository.GetFilesByObjectIdPaginated(mapper.ConvertToPagination(pagination))
+	files, totalItems, err := c.FileRepository.GetFilesByObjectIdPaginated(ctx, mapper.ConvertToPagination(pagination))
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get paginated files by object with id: '%s'. err: %v", pagination.Id, err)
 		return nil, errors.Wrapf(err, "Could not get paginated files by object with id: '%s'", pagination.Id)
@@ -472,8 +571,278 @@ func (c *ClerkHandlerServer) GetFilesByObjectIdPaginated(ctx context.Context, pa
 	return &pb.Files{Files: filesPb, TotalItems: int32(totalItems)}, nil
 }
 
+func (c *ClerkHandlerServer) GetObjectsByCollectionIdCursor(ctx context.Context, cursorPagination *pbHandler.CursorPagination) (*pbHandler.ObjectsPage, error) {
+	pagination := convertToKeysetPagination(cursorPagination)
+	pagination.Fresh = isFreshRequested(ctx)
+	objects, nextCursor, totalItems, err := c.ObjectRepository.GetObjectsByCollectionIdKeyset(ctx, pagination)
+	if err != nil {
+		c.Logger.Error().Msgf("Could not get objects page by collection with id: '%s'. err: %v", pagination.Id, err)
+		return nil, errors.Wrapf(err, "Could not get objects page by collection with id: '%s'", pagination.Id)
+	}
+	setMaterializedViewHeader(ctx, c.MaterializedViewRefresher, repository.MatCollObj, pagination.Fresh)
+	objectsPb := make([]*pb.Object, 0)
+
+	for _, object := range objects {
+		objectsPb = append(objectsPb, mapper.ConvertToObjectPb(object))
+	}
+	return &pbHandler.ObjectsPage{Objects: objectsPb, NextCursor: nextCursor, TotalItems: int32(totalItems)}, nil
+}
+
+func (c *ClerkHandlerServer) GetFilesByCollectionIdCursor(ctx context.Context, cursorPagination *pbHandler.CursorPagination) (*pbHandler.FilesPage, error) {
+	pagination := convertToKeysetPagination(cursorPagination)
+	files, nextCursor, totalItems, err := c.FileRepository.GetFilesByCollectionIdKeyset(ctx, pagination)
+	if err != nil {
+		c.Logger.Error().Msgf("Could not get files page by collection with id: '%s'. err: %v", pagination.Id, err)
+		return nil, errors.Wrapf(err, "Could not get files page by collection with id: '%s'", pagination.Id)
+	}
+	filesPb := make([]*pb.File, 0)
+
+	for _, file := range files {
+		filesPb = append(filesPb, mapper.ConvertToFilePb(file))
+	}
+	return &pbHandler.FilesPage{Files: filesPb, NextCursor: nextCursor, TotalItems: int32(totalItems)}, nil
+}
+
+func (c *ClerkHandlerServer) GetFilesByObjectIdCursor(ctx context.Context, cursorPagination *pbHandler.CursorPagination) (*pbHandler.FilesPage, error) {
+	pagination := convertToKeysetPagination(cursorPagination)
+	files, nextCursor, totalItems, err := c.FileRepository.GetFilesByObjectIdKeyset(ctx, pagination)
+	if err != nil {
+		c.Logger.Error().Msgf("Could not get files page by object with id: '%s'. err: %v", pagination.Id, err)
+		return nil, errors.Wrapf(err, "Could not get files page by object with id: '%s'", pagination.Id)
+	}
+	filesPb := make([]*pb.File, 0)
+
+	for _, file := range files {
+		filesPb = append(filesPb, mapper.ConvertToFilePb(file))
+	}
+	return &pbHandler.FilesPage{Files: filesPb, NextCursor: nextCursor, TotalItems: int32(totalItems)}, nil
+}
+
+func (c *ClerkHandlerServer) ExportObjectsByCollectionId(id *pb.Id, stream pbHandler.ClerkHandlerService_ExportObjectsByCollectionIdServer) error {
+	err := c.ObjectRepository.ExportObjectsByCollectionId(stream.Context(), id.Id, func(object models.Object) error {
+		return stream.Send(mapper.ConvertToObjectPb(object))
+	})
+	if err != nil {
+		c.Logger.Error().Msgf("Could not export objects for collection with id: '%s'. err: %v", id.Id, err)
+		return errors.Wrapf(err, "Could not export objects for collection with id: '%s'", id.Id)
+	}
+	return nil
+}
+
+func (c *ClerkHandlerServer) ExportObjectInstancesByCollectionId(id *pb.Id, stream pbHandler.ClerkHandlerService_ExportObjectInstancesByCollectionIdServer) error {
+	err := c.ObjectInstanceRepository.ExportObjectInstancesByCollectionId(stream.Context(), id.Id, func(objectInstance models.ObjectInstance) error {
+		return stream.Send(mapper.ConvertToObjectInstancePb(objectInstance))
+	})
+	if err != nil {
+		c.Logger.Error().Msgf("Could not export objectInstances for collection with id: '%s'. err: %v", id.Id, err)
+		return errors.Wrapf(err, "Could not export objectInstances for collection with id: '%s'", id.Id)
+	}
+	return nil
+}
+
+func (c *ClerkHandlerServer) ExportFilesByCollectionId(id *pb.Id, stream pbHandler.ClerkHandlerService_ExportFilesByCollectionIdServer) error {
+	err := c.FileRepository.ExportFilesByCollectionId(stream.Context(), id.Id, func(file models.File) error {
+		return stream.Send(mapper.ConvertToFilePb(file))
+	})
+	if err != nil {
+		c.Logger.Error().Msgf("Could not export files for collection with id: '%s'. err: %v", id.Id, err)
+		return errors.Wrapf(err, "Could not export files for collection with id: '%s'", id.Id)
+	}
+	return nil
+}
+
+func (c *ClerkHandlerServer) GetMaterializedViewRefreshes(ctx context.Context, param *emptypb.Empty) (*pbHandler.MaterializedViewRefreshes, error) {
+	refreshesPb := make([]*pbHandler.MaterializedViewRefresh, 0)
+	for _, refresh := range c.MaterializedViewRefresher.Refreshes() {
+		refreshPb := &pbHandler.MaterializedViewRefresh{View: refresh.View, Pending: refresh.Pending}
+		if !refresh.LastRefresh.IsZero() {
+			refreshPb.LastRefresh = timestamppb.New(refresh.LastRefresh)
+		}
+		refreshesPb = append(refreshesPb, refreshPb)
+	}
+	return &pbHandler.MaterializedViewRefreshes{MaterializedViewRefreshes: refreshesPb}, nil
+}
+
+func (c *ClerkHandlerServer) RefreshMaterializedViews(ctx context.Context, views *pbHandler.MaterializedViews) (*pb.Status, error) {
+	err := c.MaterializedViewRefresher.Request(views.Views...)
+	if err != nil {
+		c.Logger.Error().Msgf("Could not request refresh of materialized views %v. err: %v", views.Views, err)
+		return &pb.Status{Ok: false}, errors.Wrapf(err, "Could not request refresh of materialized views %v", views.Views)
+	}
+	return &pb.Status{Ok: true}, nil
+}
+
+func (c *ClerkHandlerServer) GetAuditLog(ctx context.Context, query *pbHandler.AuditLogQuery) (*pbHandler.AuditLogPage, error) {
+	filter := repository.AuditFilter{
+		EntityType: query.EntityType,
+		EntityId:   query.EntityId,
+		Actor:      query.Actor,
+		Method:     query.Method,
+	}
+	if query.Since != nil {
+		filter.Since = query.Since.AsTime()
+	}
+	if query.Until != nil {
+		filter.Until = query.Until.AsTime()
+	}
+	entries, nextCursor, totalItems, err := c.AuditRepository.GetAuditEntriesKeyset(ctx, filter, convertToKeysetPagination(query.Pagination))
+	if err != nil {
+		c.Logger.Error().Msgf("Could not get audit log page for %s '%s'. err: %v", query.EntityType, query.EntityId, err)
+		return nil, errors.Wrapf(err, "Could not get audit log page for %s '%s'", query.EntityType, query.EntityId)
+	}
+	entriesPb := make([]*pbHandler.AuditEntry, 0, len(entries))
+	for _, entry := range entries {
+		entriesPb = append(entriesPb, &pbHandler.AuditEntry{
+			Id:         entry.Id,
+			Created:    timestamppb.New(entry.Created),
+			Actor:      entry.Actor,
+			Client:     entry.Client,
+			Method:     entry.Method,
+			EntityType: entry.EntityType,
+			EntityId:   entry.EntityId,
+			Before:     string(entry.Before),
+			After:      string(entry.After),
+		})
+	}
+	return &pbHandler.AuditLogPage{AuditEntries: entriesPb, NextCursor: nextCursor, TotalItems: int32(totalItems)}, nil
+}
+
+func (c *ClerkHandlerServer) GetPlacementStrategy(ctx context.Context, id *pb.Id) (*pbHandler.PlacementStrategy, error) {
+	strategy, err := c.PlacementRepository.GetPlacementStrategy(ctx, id.Id)
+	if err != nil {
+		c.Logger.Error().Msgf("Could not get placement strategy of storageLocation with id: '%s'. err: %v", id.Id, err)
+		return nil, errors.Wrapf(err, "Could not get placement strategy of storageLocation with id: '%s'", id.Id)
+	}
+	if strategy == "" {
+		strategy = service.DefaultPlacement
+	}
+	return &pbHandler.PlacementStrategy{StorageLocationId: id.Id, Strategy: strategy}, nil
+}
+
+func (c *ClerkHandlerServer) SetPlacementStrategy(ctx context.Context, strategyPb *pbHandler.PlacementStrategy) (*pb.Status, error) {
+	if !slices.Contains(service.PlacementStrategies, strategyPb.Strategy) {
+		return &pb.Status{Ok: false}, errors.Wrapf(repository.ErrInvalidArgument, "unknown placement strategy '%s', expected one of %v",
+			strategyPb.Strategy, service.PlacementStrategies)
+	}
+	err := c.TransactionRepository.InTransaction(ctx, func(tx pgx.Tx) error {
+		before, err := c.PlacementRepository.GetPlacementStrategyTx(ctx, tx, strategyPb.StorageLocationId)
+		if err != nil {
+			return err
+		}
+		if before == "" {
+			before = service.DefaultPlacement
+		}
+		if err := c.PlacementRepository.SetPlacementStrategyTx(ctx, tx, strategyPb.StorageLocationId, strategyPb.Strategy); err != nil {
+			return err
+		}
+		return c.audit(ctx, tx, repository.AuditEntityPlacementStrategy, strategyPb.StorageLocationId,
+			&pbHandler.PlacementStrategy{StorageLocationId: strategyPb.StorageLocationId, Strategy: before}, strategyPb)
+	})
+	if err != nil {
+		c.Logger.Error().Msgf("Could not set placement strategy of storageLocation with id: '%s'. err: %v", strategyPb.StorageLocationId, err)
+		return &pb.Status{Ok: false}, errors.Wrapf(err, "Could not set placement strategy of storageLocation with id: '%s'", strategyPb.StorageLocationId)
+	}
+	return &pb.Status{Ok: true}, nil
+}
+
+func (c *ClerkHandlerServer) GetPartitionProvisioning(ctx context.Context, id *pb.Id) (*pbHandler.PartitionProvisioning, error) {
+	provisioning, err := c.PlacementRepository.GetPartitionProvisioning(ctx, id.Id)
+	if err != nil {
+		c.Logger.Error().Msgf("Could not get partition provisioning of storageLocation with id: '%s'. err: %v", id.Id, err)
+		return nil, errors.Wrapf(err, "Could not get partition provisioning of storageLocation with id: '%s'", id.Id)
+	}
+	return convertToPartitionProvisioningPb(provisioning), nil
+}
+
+func convertToPartitionProvisioningPb(provisioning repository.PartitionProvisioning) *pbHandler.PartitionProvisioning {
+	return &pbHandler.PartitionProvisioning{StorageLocationId: provisioning.StorageLocationId, Enabled: provisioning.Enabled, MaxSize: provisioning.MaxSize,
+		MaxObjects: provisioning.MaxObjects, MaxPartitions: int32(provisioning.MaxPartitions)}
+}
+
+func (c *ClerkHandlerServer) SetPartitionProvisioning(ctx context.Context, provisioningPb *pbHandler.PartitionProvisioning) (*pb.Status, error) {
+	if provisioningPb.Enabled && (provisioningPb.MaxSize < 1 || provisioningPb.MaxObjects < 1 || provisioningPb.MaxPartitions < 1) {
+		return &pb.Status{Ok: false}, errors.Wrapf(repository.ErrInvalidArgument, "maxSize, maxObjects and maxPartitions of partition provisioning must be positive")
+	}
+	err := c.TransactionRepository.InTransaction(ctx, func(tx pgx.Tx) error {
+		before, err := c.PlacementRepository.GetPartitionProvisioningTx(ctx, tx, provisioningPb.StorageLocationId)
+		if err != nil {
+			return err
+		}
+		err = c.PlacementRepository.SetPartitionProvisioningTx(ctx, tx, repository.PartitionProvisioning{StorageLocationId: provisioningPb.StorageLocationId,
+			Enabled: provisioningPb.Enabled, MaxSize: provisioningPb.MaxSize, MaxObjects: provisioningPb.MaxObjects, MaxPartitions: int(provisioningPb.MaxPartitions)})
+		if err != nil {
+			return err
+		}
+		return c.audit(ctx, tx, repository.AuditEntityProvisioning, provisioningPb.StorageLocationId, convertToPartitionProvisioningPb(before), provisioningPb)
+	})
+	if err != nil {
+		c.Logger.Error().Msgf("Could not set partition provisioning of storageLocation with id: '%s'. err: %v", provisioningPb.StorageLocationId, err)
+		return &pb.Status{Ok: false}, errors.Wrapf(err, "Could not set partition provisioning of storageLocation with id: '%s'", provisioningPb.StorageLocationId)
+	}
+	return &pb.Status{Ok: true}, nil
+}
+
+func (c *ClerkHandlerServer) GetCapacityForecast(ctx context.Context, request *pbHandler.CapacityForecastRequest) (*pbHandler.CapacityForecast, error) {
+	if request.WindowDays < 0 {
+		return nil, errors.Wrapf(repository.ErrInvalidArgument, "windowDays must not be negative")
+	}
+	if request.WindowDays > service.MaxForecastWindowDays {
+		return nil, errors.Wrapf(repository.ErrInvalidArgument, "windowDays must not be greater than %d", service.MaxForecastWindowDays)
+	}
+	forecast, err := c.CapacityForecastService.ForecastCapacity(ctx, request.StorageLocationId, time.Duration(request.WindowDays)*24*time.Hour)
+	if err != nil {
+		c.Logger.Error().Msgf("Could not forecast capacity of storageLocation with id: '%s'. err: %v", request.StorageLocationId, err)
+		return nil, errors.Wrapf(err, "Could not forecast capacity of storageLocation with id: '%s'", request.StorageLocationId)
+	}
+	forecastPb := &pbHandler.CapacityForecast{WindowDays: int32(forecast.WindowDays), Generated: timestamppb.New(forecast.Generated)}
+	for _, location := range forecast.Locations {
+		locationPb := &pbHandler.LocationForecast{StorageLocationId: location.Id, Alias: location.Alias,
+			Projection: convertToCapacityProjectionPb(location.CapacityProjection)}
+		for _, partition := range location.Partitions {
+			locationPb.Partitions = append(locationPb.Partitions, &pbHandler.PartitionForecast{StoragePartitionId: partition.Id,
+				Alias: partition.Alias, Projection: convertToCapacityProjectionPb(partition.CapacityProjection)})
+		}
+		for _, tenant := range location.Tenants {
+			locationPb.Tenants = append(locationPb.Tenants, &pbHandler.TenantIngestRate{TenantId: tenant.TenantId,
+				DailySize: tenant.DailySize, DailyObjects: tenant.DailyObjects})
+		}
+		forecastPb.Locations = append(forecastPb.Locations, locationPb)
+	}
+	return forecastPb, nil
+}
+
+func convertToCapacityProjectionPb(projection service.CapacityProjection) *pbHandler.CapacityProjection {
+	return &pbHandler.CapacityProjection{
+		CurrentSize:           projection.CurrentSize,
+		MaxSize:               projection.MaxSize,
+		CurrentObjects:        projection.CurrentObjects,
+		MaxObjects:            projection.MaxObjects,
+		DailySize:             projection.DailySize,
+		DailySizeLow:          projection.DailySizeLow,
+		DailySizeHigh:         projection.DailySizeHigh,
+		DailyObjects:          projection.DailyObjects,
+		DailyObjectsLow:       projection.DailyObjectsLow,
+		DailyObjectsHigh:      projection.DailyObjectsHigh,
+		DaysUntilFull:         projection.DaysUntilFull,
+		DaysUntilFullEarliest: projection.DaysUntilFullEarliest,
+		DaysUntilFullLatest:   projection.DaysUntilFullLatest,
+	}
+}
+
+func convertToKeysetPagination(cursorPagination *pbHandler.CursorPagination) repository.KeysetPagination {
+	pagination := cursorPagination.GetPagination()
+	if pagination == nil {
+		pagination = &pb.Pagination{}
+	}
+	return repository.KeysetPagination{
+		Pagination:     mapper.ConvertToPagination(pagination),
+		Cursor:         cursorPagination.GetCursor(),
+		WithTotalItems: cursorPagination.GetWithTotalItems(),
+	}
+}
+
 func (c *ClerkHandlerServer) GetObjectInstanceChecksByObjectInstanceIdPaginated(ctx context.Context, pagination *pb.Pagination) (*pb.ObjectInstanceChecks, error) {
-	objectInstanceChecks, totalItems, err := c.ObjectInstanceCheckRepository.GetObjectInstanceChecksByObjectInstanceIdPaginated(mapper.ConvertToPagination(pagination))
+	objectInstanceChecks, totalItems, err := c.ObjectInstanceCheckRepository.GetObjectInstanceChecksByObjectInstanceIdPaginated(ctx, mapper.ConvertToPagination(pagination))
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get paginated objectInstanceChecks by objectInstance with id: '%s'. err: %v", pagination.Id, err)
 		return nil, errors.Wrapf(err, "Could not get paginated objectInstanceChecks by objectInstance with id: '%s'", pagination.Id)
@@ -487,7 +856,7 @@ func (c *ClerkHandlerServer) GetObjectInstanceChecksByObjectInstanceIdPaginated(
 }
 
 func (c *ClerkHandlerServer) GetObjectInstancesByStoragePartitionIdPaginated(ctx context.Context, pagination *pb.Pagination) (*pb.ObjectInstances, error) {
-	objectInstances, totalItems, err := c.ObjectInstanceRepository.GetObjectInstancesByPartitionIdPaginated(mapper.ConvertToPagination(pagination))
+	objectInstances, totalItems, err := c.ObjectInstanceRepository.GetObjectInstancesByPartitionIdPaginated(ctx, mapper.ConvertToPagination(pagination))
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get paginated objectInstances by object with id: '%s'. err: %v", pagination.Id, err)
 		return nil, errors.Wrapf(err, "Could not get paginated objectInstances by object with id: '%s'", pagination.Id)
@@ -503,11 +872,13 @@ func (c *ClerkHandlerServer) GetObjectInstancesByStoragePartitionIdPaginated(ctx
 //Statistic
 
 func (c *ClerkHandlerServer) GetMimeTypesForCollectionId(ctx context.Context, pagination *pb.Pagination) (*pb.MimeTypes, error) {
-	mimeTypes, totalItems, err := c.FileRepository.GetMimeTypesForCollectionId(mapper.ConvertToPagination(pagination))
+	fresh := isFreshRequested(ctx)
+	mimeTypes, totalItems, err := c.FileRepository.GetMimeTypesForCollectionId(ctx, mapper.ConvertToPagination(pagination), fresh)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get paginated mimeTypes by collection with id: '%s'. err: %v", pagination.Id, err)
 		return nil, errors.Wrapf(err, "Could not get paginated mimeTypes by collection with id: '%s'", pagination.Id)
 	}
+	setMaterializedViewHeader(ctx, c.MaterializedViewRefresher, repository.MatTenantFileJoin, fresh)
 
 	mimeTypesPb := make([]*pb.MimeType, 0)
 	for _, mimeType := range mimeTypes {
@@ -519,11 +890,13 @@ func (c *ClerkHandlerServer) GetMimeTypesForCollectionId(ctx context.Context, pa
 }
 
 func (c *ClerkHandlerServer) GetPronomsForCollectionId(ctx context.Context, pagination *pb.Pagination) (*pb.Pronoms, error) {
-	pronoms, totalItems, err := c.FileRepository.GetPronomsForCollectionId(mapper.ConvertToPagination(pagination))
+	fresh := isFreshRequested(ctx)
+	pronoms, totalItems, err := c.FileRepository.GetPronomsForCollectionId(ctx, mapper.ConvertToPagination(pagination), fresh)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get paginated pronoms by collection with id: '%s'. err: %v", pagination.Id, err)
 		return nil, errors.Wrapf(err, "Could not get paginated pronoms by collection with id: '%s'", pagination.Id)
 	}
+	setMaterializedViewHeader(ctx, c.MaterializedViewRefresher, repository.MatTenantFileJoin, fresh)
 
 	pronomsPb := make([]*pb.Pronom, 0)
 	for _, pronom := range pronoms {
@@ -535,7 +908,7 @@ func (c *ClerkHandlerServer) GetPronomsForCollectionId(ctx context.Context, pagi
 }
 
 func (c *ClerkHandlerServer) CheckStatus(ctx context.Context, id *pb.Id) (*pb.StatusObject, error) {
-	status, err := c.StatusRepository.CheckStatus(id.Id)
+	status, err := c.StatusRepository.CheckStatus(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not CheckStatus with id: '%s'. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not CheckStatus with id: '%s'", id.Id)
@@ -545,7 +918,7 @@ func (c *ClerkHandlerServer) CheckStatus(ctx context.Context, id *pb.Id) (*pb.St
 	return &statusPb, nil
 }
 func (c *ClerkHandlerServer) GetResultingQualityForObject(ctx context.Context, id *pb.Id) (*pb.SizeAndId, error) {
-	quality, err := c.ObjectRepository.GetResultingQualityForObject(id.Id)
+	quality, err := c.ObjectRepository.GetResultingQualityForObject(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not GetResultingQualityForObject with id: '%s'. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not GetResultingQualityForObject with id: '%s'", id.Id)
@@ -555,7 +928,7 @@ func (c *ClerkHandlerServer) GetResultingQualityForObject(ctx context.Context, i
 	return &qualityPb, nil
 }
 func (c *ClerkHandlerServer) GetNeededQualityForObject(ctx context.Context, id *pb.Id) (*pb.SizeAndId, error) {
-	quality, err := c.ObjectRepository.GetNeededQualityForObject(id.Id)
+	quality, err := c.ObjectRepository.GetNeededQualityForObject(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not GetNeededQualityForObject with id: '%s'. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not GetNeededQualityForObject with id: '%s'", id.Id)
@@ -567,7 +940,11 @@ func (c *ClerkHandlerServer) GetNeededQualityForObject(ctx context.Context, id *
 
 func (c *ClerkHandlerServer) AlterStatus(ctx context.Context, statusPb *pb.StatusObject) (*pb.Status, error) {
 	status := models.ArchivingStatus{Status: statusPb.Status, LastChanged: statusPb.LastChanged, Id: statusPb.Id}
-	err := c.StatusRepository.AlterStatus(status)
+	err := c.TransactionRepository.InTransaction(ctx, func(tx pgx.Tx) error {
+		return auditedUpdate(ctx, tx, c.AuditRepository, repository.AuditEntityArchivingStatus, statusPb.Id, c.StatusRepository.CheckStatusTx, func() error {
+			return c.StatusRepository.AlterStatusTx(ctx, tx, status)
+		})
+	})
 	if err != nil {
 		c.Logger.Error().Msgf("Could not AlterStatus with id: '%s'. err: %v", statusPb.Id, err)
 		return &pb.Status{Ok: false}, errors.Wrapf(err, "Could not AlterStatus with id: '%s'", statusPb.Id)
@@ -577,7 +954,14 @@ func (c *ClerkHandlerServer) AlterStatus(ctx context.Context, statusPb *pb.Statu
 
 func (c *ClerkHandlerServer) CreateStatus(ctx context.Context, statusPb *pb.StatusObject) (*pb.Id, error) {
 	status := models.ArchivingStatus{Status: statusPb.Status, LastChanged: statusPb.LastChanged}
-	id, err := c.StatusRepository.CreateStatus(status)
+	var id string
+	err := c.TransactionRepository.InTransaction(ctx, func(tx pgx.Tx) error {
+		var err error
+		id, err = auditedCreate(ctx, tx, c.AuditRepository, repository.AuditEntityArchivingStatus, c.StatusRepository.CheckStatusTx, func() (string, error) {
+			return c.StatusRepository.CreateStatusTx(ctx, tx, status)
+		})
+		return err
+	})
 	if err != nil {
 		c.Logger.Error().Msgf("Could not AlterStatus with id: '%s'. err: %v", statusPb.Id, err)
 		return nil, errors.Wrapf(err, "Could not AlterStatus with id: '%s'", statusPb.Id)
@@ -586,7 +970,7 @@ func (c *ClerkHandlerServer) CreateStatus(ctx context.Context, statusPb *pb.Stat
 }
 
 func (c *ClerkHandlerServer) GetObjectInstancesByName(ctx context.Context, objectInstanceName *pb.Id) (*pb.ObjectInstances, error) {
-	objectInstances, err := c.ObjectInstanceRepository.GetObjectInstancesByName(objectInstanceName.Id)
+	objectInstances, err := c.ObjectInstanceRepository.GetObjectInstancesByName(ctx, objectInstanceName.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not check whether ObjectInstanceWithNameExists with name: '%s' exists. err: %v", objectInstanceName.Id, err)
 		return nil, errors.Wrapf(err, "Could not check whether ObjectInstanceWithNameExists with name: '%s' exists", objectInstanceName.Id)
@@ -600,7 +984,7 @@ func (c *ClerkHandlerServer) GetObjectInstancesByName(ctx context.Context, objec
 }
 
 func (c *ClerkHandlerServer) CheckRawObjectInstanceByObjectId(ctx context.Context, objectId *pb.Id) (*pb.ObjectInstance, error) {
-	objectInstances, err := c.ObjectInstanceRepository.GetObjectInstancesByObjectId(objectId.Id)
+	objectInstances, err := c.ObjectInstanceRepository.GetObjectInstancesByObjectId(ctx, objectId.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not GetObjectInstancesByObjectId with objectId: '%s'. err: %v", objectId.Id, err)
 		return nil, errors.Wrapf(err, "Could not GetObjectInstancesByObjectId with objectId: '%s'", objectId.Id)
@@ -612,7 +996,7 @@ func (c *ClerkHandlerServer) CheckRawObjectInstanceByObjectId(ctx context.Contex
 }
 
 func (c *ClerkHandlerServer) GetObjectsByChecksum(ctx context.Context, checksum *pb.Id) (*pb.Objects, error) {
-	objects, err := c.ObjectRepository.GetObjectsByChecksum(checksum.Id)
+	objects, err := c.ObjectRepository.GetObjectsByChecksum(ctx, checksum.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get objects with checksum: '%s'. err: %v", checksum.Id, err)
 		return nil, errors.Wrapf(err, "Could not get objects with checksum: '%s'", checksum.Id)
@@ -626,7 +1010,7 @@ func (c *ClerkHandlerServer) GetObjectsByChecksum(ctx context.Context, checksum
 }
 
 func (c *ClerkHandlerServer) GetStatusForObjectId(ctx context.Context, id *pb.Id) (*pb.SizeAndId, error) {
-	status, err := c.ObjectInstanceService.GetStatusForObjectId(id.Id)
+	status, err := c.ObjectInstanceService.GetStatusForObjectId(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not GetStatusForObjectId for object with id: '%s'. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not GetStatusForObjectId for object with id: '%s'", id.Id)
@@ -637,7 +1021,7 @@ func (c *ClerkHandlerServer) GetStatusForObjectId(ctx context.Context, id *pb.Id
 }
 
 func (c *ClerkHandlerServer) GetAmountOfErrorsByCollectionId(ctx context.Context, id *pb.Id) (*pb.SizeAndId, error) {
-	amount, err := c.ObjectInstanceRepository.GetAmountOfErrorsByCollectionId(id.Id)
+	amount, err := c.ObjectInstanceRepository.GetAmountOfErrorsByCollectionId(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not GetAmountOfErrorsByCollectionId for collection with id: '%s'. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not GetAmountOfErrorsByCollectionId for collection with id: '%s'", id.Id)
@@ -648,7 +1032,7 @@ func (c *ClerkHandlerServer) GetAmountOfErrorsByCollectionId(ctx context.Context
 }
 
 func (c *ClerkHandlerServer) GetAmountOfErrorsForStorageLocationId(ctx context.Context, id *pb.Id) (*pb.SizeAndId, error) {
-	amount, err := c.StorageLocationRepository.GetAmountOfErrorsForStorageLocationId(id.Id)
+	amount, err := c.StorageLocationRepository.GetAmountOfErrorsForStorageLocationId(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not GetAmountOfErrorsByCollectionId for collection with id: '%s'. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not GetAmountOfErrorsByCollectionId for collection with id: '%s'", id.Id)
@@ -658,7 +1042,7 @@ func (c *ClerkHandlerServer) GetAmountOfErrorsForStorageLocationId(ctx context.C
 }
 
 func (c *ClerkHandlerServer) GetAmountOfObjectsForStorageLocationId(ctx context.Context, id *pb.Id) (*pb.SizeAndId, error) {
-	amount, err := c.StorageLocationRepository.GetAmountOfObjectsForStorageLocationId(id.Id)
+	amount, err := c.StorageLocationRepository.GetAmountOfObjectsForStorageLocationId(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not GetAmountOfErrorsByCollectionId for collection with id: '%s'. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not GetAmountOfErrorsByCollectionId for collection with id: '%s'", id.Id)
@@ -668,7 +1052,7 @@ func (c *ClerkHandlerServer) GetAmountOfObjectsForStorageLocationId(ctx context.
 }
 
 func (c *ClerkHandlerServer) GetAmountOfObjectsAndTotalSizeByTenantId(ctx context.Context, id *pb.Id) (*pb.AmountAndSize, error) {
-	amount, size, err := c.TenantRepository.GetAmountOfObjectsAndTotalSizeByTenantId(id.Id)
+	amount, size, err := c.TenantRepository.GetAmountOfObjectsAndTotalSizeByTenantId(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not GetAmountOfObjectsAndTotalSizeByTenantId for tenant with id: '%s'. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not GetAmountOfObjectsAndTotalSizeByTenantId for tenant with id: '%s'", id.Id)
@@ -678,7 +1062,7 @@ func (c *ClerkHandlerServer) GetAmountOfObjectsAndTotalSizeByTenantId(ctx contex
 }
 
 func (c *ClerkHandlerServer) GetStorageLocationsStatusForCollectionAlias(ctx context.Context, sizeAndCollectionAlias *pb.SizeAndId) (*pb.Id, error) {
-	status, err := c.StorageLocationService.GetStorageLocationsStatusForCollectionAlias(sizeAndCollectionAlias.Id, sizeAndCollectionAlias.Size, sizeAndCollectionAlias.Object.Signature, sizeAndCollectionAlias.Object.Head)
+	status, err := c.StorageLocationService.GetStorageLocationsStatusForCollectionAlias(ctx, sizeAndCollectionAlias.Id, sizeAndCollectionAlias.Size, sizeAndCollectionAlias.Object.Signature, sizeAndCollectionAlias.Object.Head)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not GetStorageLocationsStatusForCollectionAlias for collection alias : '%s'. err: %v", sizeAndCollectionAlias.Id, err)
 		return nil, errors.Wrapf(err, "Could not GetStorageLocationsStatusForCollectionAlias for collection alias : '%s'", sizeAndCollectionAlias.Id)
@@ -688,7 +1072,7 @@ func (c *ClerkHandlerServer) GetStorageLocationsStatusForCollectionAlias(ctx con
 }
 
 func (c *ClerkHandlerServer) GetSizeForAllObjectInstancesByCollectionId(ctx context.Context, id *pb.Id) (*pb.AmountAndSize, error) {
-	size, err := c.CollectionRepository.GetSizeForAllObjectInstancesByCollectionId(id.Id)
+	size, err := c.CollectionRepository.GetSizeForAllObjectInstancesByCollectionId(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not GetSizeForAllObjectInstancesByCollectionId for collection with id: '%s'. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not GetSizeForAllObjectInstancesByCollectionId for collection with id: '%s'", id.Id)
@@ -701,12 +1085,12 @@ func (c *ClerkHandlerServer) CreateObjectAndInstance(ctx context.Context, object
 
 	object := mapper.ConvertToObject(objectPb.Object)
 
-	partition, err := c.StoragePartitionRepository.GetStoragePartitionById(objectPb.StatusId)
+	partition, err := c.StoragePartitionRepository.GetStoragePartitionById(ctx, objectPb.StatusId)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not GetStoragePartitionById with Id: '%s'. err: %v", objectPb.StatusId, err)
 		return nil, errors.Wrapf(err, "Could not GetStoragePartitionById with Id: '%s'", objectPb.StatusId)
 	}
-	location, err := c.StorageLocationRepository.GetStorageLocationById(partition.StorageLocationId)
+	location, err := c.StorageLocationRepository.GetStorageLocationById(ctx, partition.StorageLocationId)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not GetStorageLocationById with Id: '%s'. err: %v", partition.StorageLocationId, err)
 		return nil, errors.Wrapf(err, "Could not GetStorageLocationById with Id: '%s'", partition.StorageLocationId)
@@ -715,7 +1099,7 @@ func (c *ClerkHandlerServer) CreateObjectAndInstance(ctx context.Context, object
 	if err = json.Unmarshal([]byte(location.Connection), &connection); err != nil {
 		return nil, errors.Wrapf(err, "error mapping storageLocation json for storageLocation ID: %v", location.Id)
 	}
-	collection, err := c.CollectionRepository.GetCollectionIdByAlias(objectPb.Object.CollectionId)
+	collection, err := c.CollectionRepository.GetCollectionIdByAlias(ctx, objectPb.Object.CollectionId)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not GetStorageLocationById with Id: '%s'. err: %v", partition.StorageLocationId, err)
 		return nil, errors.Wrapf(err, "Could not GetStorageLocationById with Id: '%s'", partition.StorageLocationId)
@@ -723,25 +1107,34 @@ func (c *ClerkHandlerServer) CreateObjectAndInstance(ctx context.Context, object
 	object.CollectionId = collection
 	object.Versions = fmt.Sprintf("{\"%s\" : {}}", object.Head)
 	pathString := path.Join(connection.Folder, partition.Alias, objectPb.FileName)
-	id, err := c.ObjectRepository.CreateObject(object)
-	if err != nil {
-		c.Logger.Error().Msgf("Could not CreateObject with signature: '%s'. err: %v", object.Signature, err)
-		return nil, errors.Wrapf(err, "Could not CreateObject with signature: '%s'", object.Signature)
-	}
-	objectInstance := models.ObjectInstance{ObjectId: id, Status: "raw", StoragePartitionId: objectPb.StatusId, Size: objectPb.Object.Size, Path: pathString}
-	_, err = c.ObjectInstanceRepository.CreateObjectInstance(objectInstance)
+	err = c.TransactionRepository.InTransaction(ctx, func(tx pgx.Tx) error {
+		id, err := c.ObjectRepository.CreateObjectTx(ctx, tx, object)
+		if err != nil {
+			return errors.Wrapf(err, "Could not CreateObject with signature: '%s'", object.Signature)
+		}
+		objectInstance := models.ObjectInstance{ObjectId: id, Status: "raw", StoragePartitionId: objectPb.StatusId, Size: objectPb.Object.Size, Path: pathString}
+		_, err = c.ObjectInstanceRepository.CreateObjectInstanceTx(ctx, tx, objectInstance)
+		if err != nil {
+			return errors.Wrapf(err, "Could not CreateObjectInstance for object with signature: '%s'", object.Signature)
+		}
+		after, err := c.ObjectRepository.GetObjectByIdTx(ctx, tx, id)
+		if err != nil {
+			return errors.Wrapf(err, "Could not get created object with signature: '%s'", object.Signature)
+		}
+		return c.audit(ctx, tx, repository.AuditEntityObject, id, nil, after)
+	})
 	if err != nil {
-		c.Logger.Error().Msgf("Could not CreateObjectInstance for object with signature: '%s'. err: %v", object.Signature, err)
-		return nil, errors.Wrapf(err, "Could not  CreateObjectInstance for object with signature: '%s'", object.Signature)
+		c.Logger.Error().Msgf("Could not create object and instance with signature: '%s'. err: %v", object.Signature, err)
+		return nil, err
 	}
 
 	return &pb.NoParam{}, nil
 }
 
-func getAliases(storagePartitionPb *pb.StoragePartition, repository repository.StoragePartitionRepository) (string, string, error) {
+func getAliases(storagePartitionPb *pb.StoragePartition, storagePartitionRepository repository.StoragePartitionRepository) (string, string, error) {
 	aliasParts := strings.Split(storagePartitionPb.Alias, "/")
 	if len(aliasParts) != 2 {
-		return "", "", errors.New("alias should have right format 'part1/part2'")
+		return "", "", errors.Wrap(repository.ErrInvalidArgument, "alias should have right format 'part1/part2'")
 	}
 	return aliasParts[0], aliasParts[1], nil
 }
@@ -14,10 +14,10 @@ import (
 
 func NewDispatcherHandlerServer(storagePartitionService service.StoragePartitionService, dispatcherRepository repository.DispatcherRepository, tenantService service.TenantService,
 	objectInstanceRepository repository.ObjectInstanceRepository, objectRepository repository.ObjectRepository, collectionRepository repository.CollectionRepository,
-	storageLocationRepository repository.StorageLocationRepository, objectInstanceCheckRepository repository.ObjectInstanceCheckRepository, logger zLogger.ZLogger) *DispatcherHandlerServer {
+	storageLocationRepository repository.StorageLocationRepository, objectInstanceCheckRepository repository.ObjectInstanceCheckRepository, eventPublisher service.EventPublisher, logger zLogger.ZLogger) *DispatcherHandlerServer {
 	return &DispatcherHandlerServer{DispatcherRepository: dispatcherRepository, TenantService: tenantService,
 		ObjectInstanceRepository: objectInstanceRepository, ObjectInstanceCheckRepository: objectInstanceCheckRepository, ObjectRepository: objectRepository, StorageLocationRepository: storageLocationRepository,
-		CollectionRepository: collectionRepository, StoragePartitionService: storagePartitionService, Logger: logger}
+		CollectionRepository: collectionRepository, StoragePartitionService: storagePartitionService, EventPublisher: eventPublisher, Logger: logger}
 }
 
 type DispatcherHandlerServer struct {
@@ -30,11 +30,12 @@ type DispatcherHandlerServer struct {
 	StorageLocationRepository     repository.StorageLocationRepository
 	ObjectInstanceRepository      repository.ObjectInstanceRepository
 	DispatcherRepository          repository.DispatcherRepository
+	EventPublisher                service.EventPublisher
 	Logger                        zLogger.ZLogger
 }
 
 func (d *DispatcherHandlerServer) FindAllTenants(ctx context.Context, status *pb.NoParam) (*pb.Tenants, error) {
-	tenants, err := d.TenantService.FindAllTenants()
+	tenants, err := d.TenantService.FindAllTenants(ctx)
 	if err != nil {
 		d.Logger.Error().Msgf("Could not get all tenants")
 		return nil, errors.Wrapf(err, "Could not get all tenants")
@@ -49,16 +50,16 @@ func (d *DispatcherHandlerServer) FindAllTenants(ctx context.Context, status *pb
 }
 
 func (d *DispatcherHandlerServer) UpdateObjectInstance(ctx context.Context, objectInstancePb *pb.ObjectInstance) (*pb.NoParam, error) {
-	err := d.ObjectInstanceRepository.UpdateObjectInstance(mapper.ConvertToObjectInstance(objectInstancePb))
+	err := d.ObjectInstanceRepository.UpdateObjectInstance(ctx, mapper.ConvertToObjectInstance(objectInstancePb))
 	if err != nil {
 		d.Logger.Error().Msgf("Could not get all object instances. err: %v", err)
 		return nil, errors.Wrapf(err, "Could not get all object instances")
 	}
-	return nil, nil
+	return &pb.NoParam{}, nil
 }
 
 func (d *DispatcherHandlerServer) UpdateStoragePartition(ctx context.Context, storagePartition *pb.StoragePartition) (*pb.Status, error) {
-	status, err := d.StoragePartitionService.UpdateStoragePartition(storagePartition)
+	status, err := d.StoragePartitionService.UpdateStoragePartition(ctx, storagePartition)
 	if err != nil {
 		d.Logger.Error().Msgf("Could not update storagePartition with ID: %s. err: %v", storagePartition.Id, err)
 		return nil, errors.Wrapf(err, "Could not update storagePartition with ID: %s", storagePartition.Id)
@@ -67,7 +68,7 @@ func (d *DispatcherHandlerServer) UpdateStoragePartition(ctx context.Context, st
 }
 
 func (d *DispatcherHandlerServer) GetObjectsInstancesByObjectId(ctx context.Context, id *pb.Id) (*pb.ObjectInstances, error) {
-	objectInstances, err := d.ObjectInstanceRepository.GetObjectInstancesByObjectId(id.Id)
+	objectInstances, err := d.ObjectInstanceRepository.GetObjectInstancesByObjectId(ctx, id.Id)
 	if err != nil {
 		d.Logger.Error().Msgf("Could not get objectInstances for object ID %s. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not get objectInstances for object ID %s", id.Id)
@@ -81,7 +82,7 @@ func (d *DispatcherHandlerServer) GetObjectsInstancesByObjectId(ctx context.Cont
 }
 
 func (d *DispatcherHandlerServer) GetObjectInstancesByObjectIdPositive(ctx context.Context, id *pb.Id) (*pb.ObjectInstances, error) {
-	objectInstances, err := d.ObjectInstanceRepository.GetObjectInstancesByObjectIdPositive(id.Id)
+	objectInstances, err := d.ObjectInstanceRepository.GetObjectInstancesByObjectIdPositive(ctx, id.Id)
 	if err != nil {
 		d.Logger.Error().Msgf("Could not get objectInstances for object ID %s. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not get objectInstances for object ID %s", id.Id)
@@ -95,7 +96,7 @@ func (d *DispatcherHandlerServer) GetObjectInstancesByObjectIdPositive(ctx conte
 }
 
 func (d *DispatcherHandlerServer) GetStoragePartitionForLocation(ctx context.Context, sizeAndLocationId *pb.SizeObjectLocation) (*pb.StoragePartition, error) {
-	partition, err := d.StoragePartitionService.GetStoragePartitionForLocation(sizeAndLocationId)
+	partition, err := d.StoragePartitionService.GetStoragePartitionForLocation(ctx, sizeAndLocationId)
 	if err != nil {
 		d.Logger.Error().Msgf("Could not get storagePartition for storageLocation with ID %s. err: %v", sizeAndLocationId.Location.Id, err)
 		return nil, errors.Wrapf(err, "Could not get storagePartition for storageLocation with ID %s", sizeAndLocationId.Location.Id)
@@ -104,7 +105,7 @@ func (d *DispatcherHandlerServer) GetStoragePartitionForLocation(ctx context.Con
 }
 
 func (d *DispatcherHandlerServer) GetStorageLocationsByTenantId(ctx context.Context, tenantId *pb.Id) (*pb.StorageLocations, error) {
-	storageLocations, err := d.StorageLocationRepository.GetStorageLocationsByTenantId(tenantId.Id)
+	storageLocations, err := d.StorageLocationRepository.GetStorageLocationsByTenantId(ctx, tenantId.Id)
 	if err != nil {
 		d.Logger.Error().Msgf("Could not get storageLocations by tenant with id: '%s' . err: %v", tenantId.Id, err)
 		return nil, errors.Wrapf(err, "Could not get storageLocations by tenant with id: '%s'", tenantId.Id)
@@ -119,7 +120,7 @@ func (d *DispatcherHandlerServer) GetStorageLocationsByTenantId(ctx context.Cont
 }
 
 func (d *DispatcherHandlerServer) GetObjectExceptListOlderThan(ctx context.Context, idsWithInterval *pb.IdsWithSQLInterval) (*pb.Object, error) {
-	object, err := d.ObjectRepository.GetObjectExceptListOlderThan(idsWithInterval.CollectionId, idsWithInterval.Ids, idsWithInterval.CollectionsIds)
+	object, err := d.ObjectRepository.GetObjectExceptListOlderThan(ctx, idsWithInterval.CollectionId, idsWithInterval.Ids, idsWithInterval.CollectionsIds)
 	if err != nil {
 		d.Logger.Error().Msgf("Could not GetObjectExceptListOlderThan for collection: %s. err: %v", idsWithInterval.CollectionId, err)
 		return nil, errors.Wrapf(err, "Could not GetObjectExceptListOlderThan for collection: %s", idsWithInterval.CollectionId)
@@ -128,7 +129,7 @@ func (d *DispatcherHandlerServer) GetObjectExceptListOlderThan(ctx context.Conte
 }
 
 func (d *DispatcherHandlerServer) GetStorageLocationByObjectInstanceId(ctx context.Context, id *pb.Id) (*pb.StorageLocation, error) {
-	storageLocation, err := d.StorageLocationRepository.GetStorageLocationByObjectInstanceId(id.Id)
+	storageLocation, err := d.StorageLocationRepository.GetStorageLocationByObjectInstanceId(ctx, id.Id)
 	if err != nil {
 		d.Logger.Error().Msgf("Could not get storage location by object instance id: %s. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not get storage location by object instance id: %s", id.Id)
@@ -137,7 +138,7 @@ func (d *DispatcherHandlerServer) GetStorageLocationByObjectInstanceId(ctx conte
 }
 
 func (d *DispatcherHandlerServer) GetExistingStorageLocationsCombinationsForCollectionId(ctx context.Context, id *pb.Id) (*pb.StorageLocationsCombinationsForCollections, error) {
-	collections, err := d.CollectionRepository.GetExistingStorageLocationsCombinationsForCollectionId(id.Id)
+	collections, err := d.CollectionRepository.GetExistingStorageLocationsCombinationsForCollectionId(ctx, id.Id)
 	if err != nil {
 		d.Logger.Error().Msgf("Could not GetExistingStorageLocationsCombinationsForCollectionId for collection with ID: '%s'. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not GetExistingStorageLocationsCombinationsForCollectionId for collection with ID: '%s'", id.Id)
@@ -151,7 +152,7 @@ func (d *DispatcherHandlerServer) GetExistingStorageLocationsCombinationsForColl
 }
 
 func (d *DispatcherHandlerServer) GetCollectionsByTenantId(ctx context.Context, id *pb.Id) (*pb.Collections, error) {
-	collections, err := d.CollectionRepository.GetCollectionsByTenantId(id.Id)
+	collections, err := d.CollectionRepository.GetCollectionsByTenantId(ctx, id.Id)
 	if err != nil {
 		d.Logger.Error().Msgf("Could not get collections by tenant with id: '%s'. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not get collections by tenant with id: '%s'", id.Id)
@@ -166,7 +167,7 @@ func (d *DispatcherHandlerServer) GetCollectionsByTenantId(ctx context.Context,
 }
 
 func (d *DispatcherHandlerServer) CreateObjectInstanceCheck(ctx context.Context, objectInstanceCheckPb *pb.ObjectInstanceCheck) (*pb.NoParam, error) {
-	_, err := d.ObjectInstanceCheckRepository.CreateObjectInstanceCheck(mapper.ConvertToObjectInstanceCheck(objectInstanceCheckPb))
+	_, err := d.ObjectInstanceCheckRepository.CreateObjectInstanceCheck(ctx, mapper.ConvertToObjectInstanceCheck(objectInstanceCheckPb))
 	if err != nil {
 		d.Logger.Error().Msgf("Could not create object instance check. err: %v", err)
 		return &pb.NoParam{}, errors.Wrapf(err, "Could not create object instance check")
@@ -175,7 +176,7 @@ func (d *DispatcherHandlerServer) CreateObjectInstanceCheck(ctx context.Context,
 }
 
 func (d *DispatcherHandlerServer) GetObjectInstanceChecksByObjectInstanceId(ctx context.Context, id *pb.Id) (*pb.ObjectInstanceChecks, error) {
-	objectInstanceChecks, err := d.ObjectInstanceCheckRepository.GetObjectInstanceChecksByObjectInstanceId(id.Id)
+	objectInstanceChecks, err := d.ObjectInstanceCheckRepository.GetObjectInstanceChecksByObjectInstanceId(ctx, id.Id)
 	if err != nil {
 		d.Logger.Error().Msgf("Could not get objectInstanceChecks for object instance ID %s. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not get objectInstances for object instance ID %s", id.Id)
@@ -189,7 +190,7 @@ func (d *DispatcherHandlerServer) GetObjectInstanceChecksByObjectInstanceId(ctx
 }
 
 func (d *DispatcherHandlerServer) CreateObjectInstance(ctx context.Context, objectInstance *pb.ObjectInstance) (*pb.Id, error) {
-	id, err := d.ObjectInstanceRepository.CreateObjectInstance(mapper.ConvertToObjectInstance(objectInstance))
+	id, err := d.ObjectInstanceRepository.CreateObjectInstance(ctx, mapper.ConvertToObjectInstance(objectInstance))
 	if err != nil {
 		d.Logger.Error().Msgf("Could not create objectInstance for object ID: '%s'. err: %v", objectInstance.ObjectId, err)
 		return nil, errors.Wrapf(err, "Could not create objectInstance for object ID: '%s'", objectInstance.ObjectId)
@@ -198,10 +199,14 @@ func (d *DispatcherHandlerServer) CreateObjectInstance(ctx context.Context, obje
 }
 
 func (d *DispatcherHandlerServer) GetStorageLocationById(ctx context.Context, id *pb.Id) (*pb.StorageLocation, error) {
-	storageLocation, err := d.StorageLocationRepository.GetStorageLocationById(id.Id)
+	storageLocation, err := d.StorageLocationRepository.GetStorageLocationById(ctx, id.Id)
 	if err != nil {
 		d.Logger.Error().Msgf("Could not get storageLocation for location ID %s. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not get storageLocation for location ID %s", id.Id)
 	}
 	return mapper.ConvertToStorageLocationPb(storageLocation), nil
 }
+
+func (d *DispatcherHandlerServer) WatchEvents(request *pbHandler.WatchEventsRequest, stream pbHandler.DispatcherHandlerService_WatchEventsServer) error {
+	return watchEvents(request, stream, d.EventPublisher, d.Logger)
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/ocfl-archive/dlza-manager-handler/repository"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const errorDomain = "dlza-manager-handler"
+
+// errorCodes are the status codes of the kinds of repository errors.
+var errorCodes = []struct {
+	kind   error
+	code   codes.Code
+	reason string
+}{
+	{repository.ErrNotFound, codes.NotFound, "NOT_FOUND"},
+	{repository.ErrAlreadyExists, codes.AlreadyExists, "ALREADY_EXISTS"},
+	{repository.ErrInvalidArgument, codes.InvalidArgument, "INVALID_ARGUMENT"},
+	{repository.ErrFailedPrecondition, codes.FailedPrecondition, "FAILED_PRECONDITION"},
+	{repository.ErrUnavailable, codes.Unavailable, "UNAVAILABLE"},
+	{repository.ErrAborted, codes.Aborted, "ABORTED"},
+}
+
+// ErrorInterceptors turn the errors returned by the handlers into status errors. Errors
+// that already carry a status code keep it, the kind of repository errors and errors of
+// the context decide the code of the others, everything else is Internal. The status
+// has an ErrorInfo with the reason and the method as details.
+func ErrorInterceptors() (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	unary := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, statusError(err, info.FullMethod)
+		}
+		return resp, nil
+	}
+	stream := func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, stream); err != nil {
+			return statusError(err, info.FullMethod)
+		}
+		return nil
+	}
+	return unary, stream
+}
+
+func statusError(err error, method string) error {
+	if st, ok := status.FromError(err); ok && st.Code() != codes.Unknown {
+		return err
+	}
+	code, reason := codes.Internal, "INTERNAL"
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		code, reason = codes.DeadlineExceeded, "DEADLINE_EXCEEDED"
+	case errors.Is(err, context.Canceled):
+		code, reason = codes.Canceled, "CANCELED"
+	default:
+		kind := repository.ErrorKind(err)
+		for _, errorCode := range errorCodes {
+			if kind == errorCode.kind {
+				code, reason = errorCode.code, errorCode.reason
+			}
+		}
+	}
+	st := status.New(code, err.Error())
+	detailed, detailsErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   errorDomain,
+		Metadata: map[string]string{"method": method},
+	})
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,37 @@
+package server
+
+import (
+	"context"
+	"slices"
+
+	"emperror.dev/errors"
+	"github.com/je4/utils/v2/pkg/zLogger"
+	pbHandler "github.com/ocfl-archive/dlza-manager-handler/handlerproto"
+	"github.com/ocfl-archive/dlza-manager-handler/repository"
+	"github.com/ocfl-archive/dlza-manager-handler/service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// lifecycleEventStream is the server side of the WatchEvents streams of all services.
+type lifecycleEventStream interface {
+	Send(*pbHandler.LifecycleEvent) error
+	Context() context.Context
+}
+
+// watchEvents streams the events selected by the request until the client cancels the
+// call or the publisher stops.
+func watchEvents(request *pbHandler.WatchEventsRequest, stream lifecycleEventStream, eventPublisher service.EventPublisher, logger zLogger.ZLogger) error {
+	ctx := stream.Context()
+	err := eventPublisher.Watch(ctx, request.AfterOffset, func(event repository.OutboxEvent) error {
+		if len(request.Types) != 0 && !slices.Contains(request.Types, event.Type) {
+			return nil
+		}
+		return stream.Send(&pbHandler.LifecycleEvent{Offset: event.Offset, Created: timestamppb.New(event.Created), Type: event.Type,
+			EntityId: event.EntityId, Payload: string(event.Payload)})
+	})
+	if err != nil && ctx.Err() == nil {
+		logger.Error().Msgf("Could not watch events after offset %d. err: %v", request.AfterOffset, err)
+		return errors.Wrapf(err, "Could not watch events after offset %d", request.AfterOffset)
+	}
+	return err
+}
@@ -0,0 +1,130 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/je4/utils/v2/pkg/zLogger"
+	pb "github.com/ocfl-archive/dlza-manager-handler/handlerproto"
+	"github.com/ocfl-archive/dlza-manager-handler/service"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// HandlerServices are the names of the services of the handler as reported by the health
+// service.
+var HandlerServices = []string{
+	pb.ClerkHandlerService_ServiceDesc.ServiceName,
+	pb.CheckerHandlerService_ServiceDesc.ServiceName,
+	pb.DispatcherHandlerService_ServiceDesc.ServiceName,
+	pb.StorageHandlerHandlerService_ServiceDesc.ServiceName,
+}
+
+// materializedViewServices are the services answering from the materialized views.
+var materializedViewServices = []string{pb.ClerkHandlerService_ServiceDesc.ServiceName}
+
+// HealthMonitor sets the status of the services in the health server. All services are
+// not serving while the database cannot be reached or has another schema version, the
+// services reading the materialized views also while a view is stale.
+type HealthMonitor struct {
+	Health                    *health.Server
+	CheckDatabase             func(ctx context.Context) error
+	MaterializedViewRefresher service.MaterializedViewRefresher
+	Interval                  time.Duration
+	MaxViewAge                time.Duration
+	Logger                    zLogger.ZLogger
+
+	lastProblem string
+}
+
+func NewHealthMonitor(healthServer *health.Server, checkDatabase func(ctx context.Context) error,
+	materializedViewRefresher service.MaterializedViewRefresher, interval time.Duration, maxViewAge time.Duration, logger zLogger.ZLogger) *HealthMonitor {
+	return &HealthMonitor{
+		Health:                    healthServer,
+		CheckDatabase:             checkDatabase,
+		MaterializedViewRefresher: materializedViewRefresher,
+		Interval:                  interval,
+		MaxViewAge:                maxViewAge,
+		Logger:                    logger,
+	}
+}
+
+// Run checks the health every Interval until ctx is done.
+func (h *HealthMonitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(h.Interval)
+	defer ticker.Stop()
+	for {
+		h.check(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *HealthMonitor) check(ctx context.Context) {
+	checkCtx, cancel := context.WithTimeout(ctx, h.Interval)
+	defer cancel()
+	err := h.CheckDatabase(checkCtx)
+	if ctx.Err() != nil {
+		return
+	}
+	if err != nil {
+		h.logChange("database: " + err.Error())
+		h.setAll(healthpb.HealthCheckResponse_NOT_SERVING)
+		return
+	}
+	viewStatus := healthpb.HealthCheckResponse_SERVING
+	if view := h.staleView(); view != "" {
+		h.logChange(fmt.Sprintf("materialized view '%s' has not been refreshed for more than %s", view, h.MaxViewAge))
+		viewStatus = healthpb.HealthCheckResponse_NOT_SERVING
+	} else {
+		h.logChange("")
+	}
+	h.Health.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	for _, name := range HandlerServices {
+		if slices.Contains(materializedViewServices, name) {
+			h.Health.SetServingStatus(name, viewStatus)
+		} else {
+			h.Health.SetServingStatus(name, healthpb.HealthCheckResponse_SERVING)
+		}
+	}
+}
+
+// staleView returns a view waiting for a refresh for longer than MaxViewAge. The wait is
+// measured from the request, as the last refresh of a view may not be known.
+func (h *HealthMonitor) staleView() string {
+	if h.MaxViewAge <= 0 || h.MaterializedViewRefresher == nil {
+		return ""
+	}
+	for _, refresh := range h.MaterializedViewRefresher.Refreshes() {
+		if refresh.Pending && time.Since(refresh.PendingSince) > h.MaxViewAge {
+			return refresh.View
+		}
+	}
+	return ""
+}
+
+func (h *HealthMonitor) setAll(status healthpb.HealthCheckResponse_ServingStatus) {
+	h.Health.SetServingStatus("", status)
+	for _, name := range HandlerServices {
+		h.Health.SetServingStatus(name, status)
+	}
+}
+
+// logChange logs the problem found by a check when it differs from the one of the last
+// check, an empty problem means healthy.
+func (h *HealthMonitor) logChange(problem string) {
+	if problem == h.lastProblem {
+		return
+	}
+	h.lastProblem = problem
+	if problem != "" {
+		h.Logger.Error().Msgf("handler is not healthy: %s", problem)
+	} else {
+		h.Logger.Info().Msg("handler is healthy")
+	}
+}
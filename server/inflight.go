@@ -0,0 +1,70 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// InFlight keeps track of the calls being handled, so that the calls aborted by a
+// shutdown can be reported.
+type InFlight struct {
+	mu    sync.Mutex
+	next  uint64
+	calls map[uint64]inFlightCall
+}
+
+type inFlightCall struct {
+	method  string
+	started time.Time
+}
+
+func NewInFlight() *InFlight {
+	return &InFlight{calls: make(map[uint64]inFlightCall)}
+}
+
+func (f *InFlight) Interceptors() (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	unary := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		defer f.add(info.FullMethod)()
+		return handler(ctx, req)
+	}
+	stream := func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		defer f.add(info.FullMethod)()
+		return handler(srv, stream)
+	}
+	return unary, stream
+}
+
+// add registers a call and returns the function removing it.
+func (f *InFlight) add(method string) func() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	id := f.next
+	f.next++
+	f.calls[id] = inFlightCall{method: method, started: time.Now()}
+	return func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		delete(f.calls, id)
+	}
+}
+
+// Calls describes the calls being handled, the longest running first.
+func (f *InFlight) Calls() []string {
+	f.mu.Lock()
+	calls := make([]inFlightCall, 0, len(f.calls))
+	for _, call := range f.calls {
+		calls = append(calls, call)
+	}
+	f.mu.Unlock()
+	slices.SortFunc(calls, func(a, b inFlightCall) int { return a.started.Compare(b.started) })
+	descriptions := make([]string, 0, len(calls))
+	for _, call := range calls {
+		descriptions = append(descriptions, fmt.Sprintf("%s running for %s", call.method, time.Since(call.started).Round(time.Millisecond)))
+	}
+	return descriptions
+}
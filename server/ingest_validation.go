@@ -0,0 +1,69 @@
+package server
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	pb "github.com/ocfl-archive/dlza-manager/dlzamanagerproto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validateIngestStream checks the messages received by SaveAllTableObjectsAfterCopyingStream
+// before anything is stored. All messages have to belong to the object and collection of
+// the first message and carry the id of the object instance. If any message has a file,
+// every message needs a file, and every file needs a checksum. The returned InvalidArgument error
+// lists every violation with the index of its message, also as BadRequest details.
+func validateIngestStream(messages []*pb.InstanceWithPartitionAndObjectWithFile) error {
+	if len(messages) == 0 {
+		return status.Error(codes.InvalidArgument, "stream did not contain any message")
+	}
+	var violations []*errdetails.BadRequest_FieldViolation
+	addViolation := func(index int, field string, description string) {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       fmt.Sprintf("messages[%d].%s", index, field),
+			Description: description,
+		})
+	}
+
+	first := messages[0]
+	withFiles := slices.ContainsFunc(messages, func(message *pb.InstanceWithPartitionAndObjectWithFile) bool {
+		return message.GetFile() != nil
+	})
+	for index, message := range messages {
+		if message.GetObjectInstance().GetId() == "" {
+			addViolation(index, "objectInstance.id", "object instance id is missing")
+		}
+		if message.GetObject() == nil {
+			addViolation(index, "object", "object is missing")
+		} else if first.GetObject() != nil && message.GetObject().GetId() != first.GetObject().GetId() {
+			addViolation(index, "object.id", fmt.Sprintf("object '%s' differs from object '%s' of the first message", message.GetObject().GetId(), first.GetObject().GetId()))
+		}
+		if message.GetCollectionAlias() != first.GetCollectionAlias() {
+			addViolation(index, "collectionAlias", fmt.Sprintf("collection '%s' differs from collection '%s' of the first message", message.GetCollectionAlias(), first.GetCollectionAlias()))
+		}
+		if message.GetFile() == nil {
+			if withFiles {
+				addViolation(index, "file", "file is missing")
+			}
+		} else if message.GetFile().GetChecksum() == "" {
+			addViolation(index, "file.checksum", "checksum of file is missing")
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+
+	descriptions := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		descriptions = append(descriptions, violation.Field+": "+violation.Description)
+	}
+	st := status.New(codes.InvalidArgument, "invalid messages in stream: "+strings.Join(descriptions, "; "))
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
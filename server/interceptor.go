@@ -0,0 +1,126 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	pbHandler "github.com/ocfl-archive/dlza-manager-handler/handlerproto"
+	"google.golang.org/grpc"
+)
+
+// InterceptingRegistrar registers services with their handlers wrapped in the unary and
+// stream interceptors. The grpc server is created by the resolver client, so the
+// interceptors are applied when the services are registered instead of as server options.
+type InterceptingRegistrar struct {
+	registrar grpc.ServiceRegistrar
+	unary     []grpc.UnaryServerInterceptor
+	stream    []grpc.StreamServerInterceptor
+}
+
+func NewInterceptingRegistrar(registrar grpc.ServiceRegistrar) *InterceptingRegistrar {
+	return &InterceptingRegistrar{registrar: registrar}
+}
+
+// Use adds the interceptors, the first one added is the outermost.
+func (r *InterceptingRegistrar) Use(unary grpc.UnaryServerInterceptor, stream grpc.StreamServerInterceptor) *InterceptingRegistrar {
+	if unary != nil {
+		r.unary = append(r.unary, unary)
+	}
+	if stream != nil {
+		r.stream = append(r.stream, stream)
+	}
+	return r
+}
+
+func (r *InterceptingRegistrar) RegisterService(desc *grpc.ServiceDesc, impl any) {
+	wrapped := *desc
+	wrapped.Methods = make([]grpc.MethodDesc, len(desc.Methods))
+	for i, method := range desc.Methods {
+		handler := method.Handler
+		wrapped.Methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler: func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+				return handler(srv, ctx, dec, r.chainUnary(interceptor))
+			},
+		}
+	}
+	wrapped.Streams = make([]grpc.StreamDesc, len(desc.Streams))
+	for i, stream := range desc.Streams {
+		info := &grpc.StreamServerInfo{FullMethod: "/" + desc.ServiceName + "/" + stream.StreamName,
+			IsClientStream: stream.ClientStreams, IsServerStream: stream.ServerStreams}
+		wrapped.Streams[i] = stream
+		wrapped.Streams[i].Handler = r.chainStream(info, stream.Handler)
+	}
+	r.registrar.RegisterService(&wrapped, impl)
+}
+
+// chainUnary runs the interceptors of the registrar before the one of the server.
+func (r *InterceptingRegistrar) chainUnary(server grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	interceptors := r.unary
+	if server != nil {
+		interceptors = append(slices.Clone(interceptors), server)
+	}
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, inner := interceptors[i], next
+			next = func(ctx context.Context, req any) (any, error) {
+				return interceptor(ctx, req, info, inner)
+			}
+		}
+		return next(ctx, req)
+	}
+}
+
+func (r *InterceptingRegistrar) chainStream(info *grpc.StreamServerInfo, handler grpc.StreamHandler) grpc.StreamHandler {
+	next := handler
+	for i := len(r.stream) - 1; i >= 0; i-- {
+		interceptor, inner := r.stream[i], next
+		next = func(srv any, stream grpc.ServerStream) error {
+			return interceptor(srv, stream, info, inner)
+		}
+	}
+	return next
+}
+
+// contextStream replaces the context of a server stream.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+// watchMethods are the streams that run until the client cancels them. Their queries are
+// short, so they are left without deadline.
+var watchMethods = []string{
+	pbHandler.CheckerHandlerService_WatchEvents_FullMethodName,
+	pbHandler.DispatcherHandlerService_WatchEvents_FullMethodName,
+}
+
+// ActionTimeoutInterceptors give every call without a deadline of the client a deadline,
+// so that no query runs for longer than the timeout. The unary calls get the timeout, the
+// streams, which export or receive whole collections, the stream timeout. The watch
+// streams are left without deadline, as is every call whose timeout is zero.
+func ActionTimeoutInterceptors(timeout, streamTimeout time.Duration) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	unary := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := ctx.Deadline(); ok || timeout <= 0 {
+			return handler(ctx, req)
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return handler(ctx, req)
+	}
+	stream := func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if _, ok := stream.Context().Deadline(); ok || streamTimeout <= 0 || slices.Contains(watchMethods, info.FullMethod) {
+			return handler(srv, stream)
+		}
+		ctx, cancel := context.WithTimeout(stream.Context(), streamTimeout)
+		defer cancel()
+		return handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+	}
+	return unary, stream
+}
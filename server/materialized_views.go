@@ -0,0 +1,46 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/ocfl-archive/dlza-manager-handler/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys of the reads served from materialized views. A client sends FreshHeader
+// "true" to read from the base tables instead of the view. The response header names the
+// view in MaterializedViewHeader and the start of its last refresh in RFC 3339 format in
+// MaterializedViewRefreshedHeader, a response read from the base tables has FreshHeader
+// "true" instead.
+const (
+	FreshHeader                     = "fresh"
+	MaterializedViewHeader          = "materialized-view"
+	MaterializedViewRefreshedHeader = "materialized-view-refreshed"
+)
+
+func isFreshRequested(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	values := md.Get(FreshHeader)
+	return len(values) != 0 && strings.EqualFold(values[0], "true")
+}
+
+// setMaterializedViewHeader tells the client how old the data of the response is. The
+// refresh time is left out if it is not known.
+func setMaterializedViewHeader(ctx context.Context, refresher service.MaterializedViewRefresher, view string, fresh bool) {
+	md := metadata.MD{}
+	if fresh {
+		md.Set(FreshHeader, "true")
+	} else {
+		md.Set(MaterializedViewHeader, view)
+		if refreshed := refresher.LastRefresh(view); !refreshed.IsZero() {
+			md.Set(MaterializedViewRefreshedHeader, refreshed.UTC().Format(time.RFC3339Nano))
+		}
+	}
+	grpc.SetHeader(ctx, md)
+}
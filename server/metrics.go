@@ -0,0 +1,71 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// RPCMetrics measures the calls of the services by service, method and status code.
+type RPCMetrics struct {
+	duration *prometheus.HistogramVec
+	errors   *prometheus.CounterVec
+}
+
+func NewRPCMetrics(registerer prometheus.Registerer) *RPCMetrics {
+	metrics := &RPCMetrics{
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "dlza_handler",
+			Name:      "rpc_duration_seconds",
+			Help:      "Duration of the calls by service, method and status code.",
+			Buckets:   []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60, 300},
+		}, []string{"service", "method", "code"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "dlza_handler",
+			Name:      "rpc_errors_total",
+			Help:      "Number of calls that failed by service, method and status code.",
+		}, []string{"service", "method", "code"}),
+	}
+	registerer.MustRegister(metrics.duration, metrics.errors)
+	return metrics
+}
+
+// Interceptors observe every call. They have to run outside of ErrorInterceptors to see
+// the status code sent to the client.
+func (m *RPCMetrics) Interceptors() (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	unary := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		m.observe(info.FullMethod, start, err)
+		return resp, err
+	}
+	stream := func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, stream)
+		m.observe(info.FullMethod, start, err)
+		return err
+	}
+	return unary, stream
+}
+
+func (m *RPCMetrics) observe(fullMethod string, start time.Time, err error) {
+	service, method := splitFullMethod(fullMethod)
+	code := status.Code(err).String()
+	m.duration.WithLabelValues(service, method, code).Observe(time.Since(start).Seconds())
+	if err != nil {
+		m.errors.WithLabelValues(service, method, code).Inc()
+	}
+}
+
+// splitFullMethod splits "/package.Service/Method" into service and method.
+func splitFullMethod(fullMethod string) (string, string) {
+	service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return "unknown", fullMethod
+	}
+	return service, method
+}
@@ -3,7 +3,6 @@ package server
 import (
 	"context"
 	"io"
-	"log"
 
 	"emperror.dev/errors"
 	"github.com/je4/utils/v2/pkg/zLogger"
@@ -15,28 +14,35 @@ import (
 	dlzaMapper "github.com/ocfl-archive/dlza-manager/mapper"
 	"github.com/ocfl-archive/dlza-manager/models"
 	dlzaService "github.com/ocfl-archive/dlza-manager/service"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+// IdempotencyKeyHeader is the metadata key under which the storage handler sends the
+// idempotency key of a SaveAllTableObjectsAfterCopyingStream call. Retrying the stream
+// with the same key returns the status of the first successful call.
+const IdempotencyKeyHeader = "idempotency-key"
+
 type StorageHandlerHandlerServer struct {
 	pbHandler.UnimplementedStorageHandlerHandlerServiceServer
-	UploaderService                    service.UploaderService
-	CollectionRepository               repository.CollectionRepository
-	TenantRepository                   repository.TenantRepository
-	ObjectRepository                   repository.ObjectRepository
-	ObjectInstanceRepository           repository.ObjectInstanceRepository
-	StorageLocationRepository          repository.StorageLocationRepository
-	StoragePartitionService            service.StoragePartitionService
-	FileRepository                     repository.FileRepository
-	StatusRepository                   repository.StatusRepository
-	TransactionRepository              repository.TransactionRepository
-	RefreshMaterializedViewsRepository repository.RefreshMaterializedViewsRepository
-	TenantService                      service.TenantService
-	Logger                             zLogger.ZLogger
+	UploaderService           service.UploaderService
+	CollectionRepository      repository.CollectionRepository
+	TenantRepository          repository.TenantRepository
+	ObjectRepository          repository.ObjectRepository
+	ObjectInstanceRepository  repository.ObjectInstanceRepository
+	StorageLocationRepository repository.StorageLocationRepository
+	StoragePartitionService   service.StoragePartitionService
+	FileRepository            repository.FileRepository
+	StatusRepository          repository.StatusRepository
+	TransactionRepository     repository.TransactionRepository
+	ReservationRepository     repository.ReservationRepository
+	MaterializedViewRefresher service.MaterializedViewRefresher
+	TenantService             service.TenantService
+	Logger                    zLogger.ZLogger
 }
 
 func (c *StorageHandlerHandlerServer) TenantHasAccess(ctx context.Context, object *pb.UploaderAccessObject) (*pb.Status, error) {
-	status, err := c.UploaderService.TenantHasAccess(object)
+	status, err := c.UploaderService.TenantHasAccess(ctx, object)
 	if err != nil {
 		return &pb.Status{Ok: false}, errors.Wrapf(err, "Could not execute TenantHasAccess, err: %v", err)
 	}
@@ -44,6 +50,7 @@ func (c *StorageHandlerHandlerServer) TenantHasAccess(ctx context.Context, objec
 }
 
 func (c *StorageHandlerHandlerServer) SaveAllTableObjectsAfterCopyingStream(stream pbHandler.StorageHandlerHandlerService_SaveAllTableObjectsAfterCopyingStreamServer) error {
+	ctx := stream.Context()
 	var instanceWithPartitionAndObjectWithFiles []*pb.InstanceWithPartitionAndObjectWithFile
 	for {
 		instanceWithPartitionAndObjectWithFile, err := stream.Recv()
@@ -55,36 +62,69 @@ func (c *StorageHandlerHandlerServer) SaveAllTableObjectsAfterCopyingStream(stre
 		}
 		instanceWithPartitionAndObjectWithFiles = append(instanceWithPartitionAndObjectWithFiles, instanceWithPartitionAndObjectWithFile)
 	}
-	collectionId, err := c.CollectionRepository.GetCollectionIdByAlias(instanceWithPartitionAndObjectWithFiles[0].CollectionAlias)
+	if err := validateIngestStream(instanceWithPartitionAndObjectWithFiles); err != nil {
+		if len(instanceWithPartitionAndObjectWithFiles) > 0 {
+			c.releaseReservation(ctx, instanceWithPartitionAndObjectWithFiles[0])
+		}
+		c.Logger.Error().Msgf("Could not SaveAllTableObjectsAfterCopying. err: %v", err)
+		return err
+	}
+	collectionId, err := c.CollectionRepository.GetCollectionIdByAlias(ctx, instanceWithPartitionAndObjectWithFiles[0].CollectionAlias)
 	if err != nil {
+		c.releaseReservation(ctx, instanceWithPartitionAndObjectWithFiles[0])
 		c.Logger.Error().Msgf("Could not get collectionId for collection with alias: '%s'. err: %v", instanceWithPartitionAndObjectWithFiles[0].CollectionAlias, err)
 		return errors.Wrapf(err, "Could not get collectionId for collection with alias: '%s'", instanceWithPartitionAndObjectWithFiles[0].CollectionAlias)
 	}
 	instanceWithPartitionAndObjectWithFiles[0].Object.CollectionId = collectionId
-	err = c.TransactionRepository.SaveAllTableObjectsAfterCopying(instanceWithPartitionAndObjectWithFiles)
+	idempotencyKey := getIdempotencyKey(ctx)
+	status, err := c.TransactionRepository.SaveAllTableObjectsAfterCopying(ctx, idempotencyKey, instanceWithPartitionAndObjectWithFiles)
 	if err != nil {
+		c.releaseReservation(ctx, instanceWithPartitionAndObjectWithFiles[0])
 		c.Logger.Error().Msgf("Could not SaveAllTableObjectsAfterCopying for collection with alias: %s and path: %s. err: %v", instanceWithPartitionAndObjectWithFiles[0].CollectionAlias,
 			instanceWithPartitionAndObjectWithFiles[0].ObjectInstance.Path, err)
 		return errors.Wrapf(err, "Could not SaveAllTableObjectsAfterCopying for collection with alias: %s and path: %s", instanceWithPartitionAndObjectWithFiles[0].CollectionAlias,
 			instanceWithPartitionAndObjectWithFiles[0].ObjectInstance.Path)
 	}
-	err = c.RefreshMaterializedViewsRepository.RefreshMaterializedViews()
+	err = c.MaterializedViewRefresher.Request()
 	if err != nil {
-		c.Logger.Error().Msgf("couldn't create refresh mat. views, err: %v", err)
-		log.Printf("couldn't create refresh mat. views, err: %v", err)
+		c.Logger.Error().Msgf("couldn't request refresh of mat. views, err: %v", err)
 	}
-	return stream.SendAndClose(&pb.Status{Ok: true})
+	return stream.SendAndClose(status)
 
 }
 
+// releaseReservation gives the capacity reserved for an object instance that could not be
+// stored back to its partition. A successful store commits the reservation itself.
+func (c *StorageHandlerHandlerServer) releaseReservation(ctx context.Context, message *pb.InstanceWithPartitionAndObjectWithFile) {
+	storagePartitionId := repository.ReservedStoragePartitionId(message)
+	err := c.ReservationRepository.ReleaseStoragePartitionReservation(context.WithoutCancel(ctx), storagePartitionId, message.GetObject().GetSignature())
+	if err != nil {
+		c.Logger.Error().Msgf("Could not release reservation of object '%s' on storagePartition '%s'. err: %v", message.GetObject().GetSignature(), storagePartitionId, err)
+	}
+}
+
+// getIdempotencyKey returns the key the client sent in the IdempotencyKeyHeader of the
+// stream metadata, or an empty string if it sent none.
+func getIdempotencyKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	keys := md.Get(IdempotencyKeyHeader)
+	if len(keys) == 0 {
+		return ""
+	}
+	return keys[0]
+}
+
 func (c *StorageHandlerHandlerServer) GetStorageLocationsByCollectionAlias(ctx context.Context, collectionAlias *pb.CollectionAlias) (*pb.StorageLocations, error) {
 
-	collection, err := c.CollectionRepository.GetCollectionByAlias(collectionAlias.CollectionAlias)
+	collection, err := c.CollectionRepository.GetCollectionByAlias(ctx, collectionAlias.CollectionAlias)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get collectionId for collection with alias '%s'. err: %v", collectionAlias.CollectionAlias, err)
 		return nil, errors.Wrapf(err, "Could not get collectionId for collection with alias '%s'", collectionAlias.CollectionAlias)
 	}
-	storageLocations, err := c.StorageLocationRepository.GetStorageLocationsByTenantId(collection.TenantId)
+	storageLocations, err := c.StorageLocationRepository.GetStorageLocationsByTenantId(ctx, collection.TenantId)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get storageLocations for collection with alias '%s'. err: %v", collectionAlias.CollectionAlias, err)
 		return nil, errors.Wrapf(err, "Could not get storageLocations for collection with alias '%v'", collectionAlias.CollectionAlias)
@@ -99,7 +139,7 @@ func (c *StorageHandlerHandlerServer) GetStorageLocationsByCollectionAlias(ctx c
 }
 
 func (c *StorageHandlerHandlerServer) GetStorageLocationsByObjectId(ctx context.Context, id *pb.Id) (*pb.StorageLocations, error) {
-	storageLocations, err := c.StorageLocationRepository.GetStorageLocationsByObjectId(id.Id)
+	storageLocations, err := c.StorageLocationRepository.GetStorageLocationsByObjectId(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get current storage locations. err: %v", err)
 		return nil, errors.Wrapf(err, "Could not get current storage locations")
@@ -113,7 +153,7 @@ func (c *StorageHandlerHandlerServer) GetStorageLocationsByObjectId(ctx context.
 }
 
 func (c *StorageHandlerHandlerServer) GetAllStorageLocations(ctx context.Context, param *emptypb.Empty) (*pb.StorageLocations, error) {
-	storageLocations, err := c.StorageLocationRepository.GetAllStorageLocations()
+	storageLocations, err := c.StorageLocationRepository.GetAllStorageLocations(ctx)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get GetAllStorageLocations. err: %v", err)
 		return nil, errors.Wrapf(err, "Could not get GetAllStorageLocations")
@@ -127,7 +167,7 @@ func (c *StorageHandlerHandlerServer) GetAllStorageLocations(ctx context.Context
 }
 
 func (c *StorageHandlerHandlerServer) GetStorageLocationById(ctx context.Context, id *pb.Id) (*pb.StorageLocation, error) {
-	storageLocation, err := c.StorageLocationRepository.GetStorageLocationById(id.Id)
+	storageLocation, err := c.StorageLocationRepository.GetStorageLocationById(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get storageLocation for location ID %s. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not get storageLocation for location ID %s", id.Id)
@@ -136,7 +176,7 @@ func (c *StorageHandlerHandlerServer) GetStorageLocationById(ctx context.Context
 }
 
 func (c *StorageHandlerHandlerServer) FindTenantByCollectionAlias(ctx context.Context, id *pb.Id) (*pb.Tenant, error) {
-	tenant, err := c.TenantRepository.FindTenantByCollectionAlias(id.Id)
+	tenant, err := c.TenantRepository.FindTenantByCollectionAlias(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get tenant for collection alias %s. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not get tenant for collection alias %s", id.Id)
@@ -145,7 +185,7 @@ func (c *StorageHandlerHandlerServer) FindTenantByCollectionAlias(ctx context.Co
 }
 
 func (c *StorageHandlerHandlerServer) GetStoragePartitionForLocation(ctx context.Context, sizeAndLocation *pb.SizeObjectLocation) (*pb.StoragePartition, error) {
-	partition, err := c.StoragePartitionService.GetStoragePartitionForLocation(sizeAndLocation)
+	partition, err := c.StoragePartitionService.GetStoragePartitionForLocation(ctx, sizeAndLocation)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get storagePartition for storageLocation with Group %s. err: %v", sizeAndLocation.Location.Group, err)
 		return nil, errors.Wrapf(err, "Could not get storagePartition for storageLocation")
@@ -154,7 +194,7 @@ func (c *StorageHandlerHandlerServer) GetStoragePartitionForLocation(ctx context
 }
 
 func (c *StorageHandlerHandlerServer) GetAndSaveStoragePartitionWithRelevantAlias(ctx context.Context, storagePartition *pb.StoragePartition) (*pb.StoragePartition, error) {
-	storagePartitionWithAlias, err := c.StoragePartitionService.GetAndSaveStoragePartitionWithRelevantAlias(storagePartition)
+	storagePartitionWithAlias, err := c.StoragePartitionService.GetAndSaveStoragePartitionWithRelevantAlias(ctx, storagePartition)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not fill storagePartition with alias. err: %v", err)
 		return nil, errors.Wrapf(err, "Could not fill storagePartition with alias")
@@ -163,13 +203,13 @@ func (c *StorageHandlerHandlerServer) GetAndSaveStoragePartitionWithRelevantAlia
 }
 func (c *StorageHandlerHandlerServer) GetObjectsByCollectionAlias(ctx context.Context, collectionAlias *pb.CollectionAlias) (*pb.Objects, error) {
 
-	id, err := c.CollectionRepository.GetCollectionIdByAlias(collectionAlias.CollectionAlias)
+	id, err := c.CollectionRepository.GetCollectionIdByAlias(ctx, collectionAlias.CollectionAlias)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get collectionId for collection with alias '%s'. err: %v", collectionAlias.CollectionAlias, err)
 		return nil, errors.Wrapf(err, "Could not get collectionId for collection with alias '%s'", collectionAlias.CollectionAlias)
 	}
 
-	objects, err := c.ObjectRepository.GetObjectsByCollectionId(id)
+	objects, err := c.ObjectRepository.GetObjectsByCollectionId(ctx, id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get objects for collection with alias '%s'. err: %v", collectionAlias.CollectionAlias, err)
 		return nil, errors.Wrapf(err, "Could not get objects for collection with alias '%s'", collectionAlias.CollectionAlias)
@@ -183,7 +223,7 @@ func (c *StorageHandlerHandlerServer) GetObjectsByCollectionAlias(ctx context.Co
 }
 
 func (c *StorageHandlerHandlerServer) GetObjectInstanceByFileNameAndPartitionId(ctx context.Context, fileNameAndPartition *pb.ObjectAndFile) (*pb.ObjectInstance, error) {
-	objectInstance, err := c.ObjectInstanceRepository.GetObjectInstanceByFileNameAndPartitionId(fileNameAndPartition.FileName, fileNameAndPartition.StatusId)
+	objectInstance, err := c.ObjectInstanceRepository.GetObjectInstanceByFileNameAndPartitionId(ctx, fileNameAndPartition.FileName, fileNameAndPartition.StatusId)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not GetObjectInstanceByFileNameAndPartitionId with fileName %s and partitionId %s. err: %v", fileNameAndPartition.FileName, fileNameAndPartition.StatusId, err)
 		return nil, errors.Wrapf(err, "Could not get GetObjectInstanceByFileNameAndPartitionId with fileName %s and partitionId %s.", fileNameAndPartition.FileName, fileNameAndPartition.StatusId)
@@ -193,7 +233,7 @@ func (c *StorageHandlerHandlerServer) GetObjectInstanceByFileNameAndPartitionId(
 }
 
 func (c *StorageHandlerHandlerServer) GetObjectsInstancesByObjectId(ctx context.Context, id *pb.Id) (*pb.ObjectInstances, error) {
-	objectInstances, err := c.ObjectInstanceRepository.GetObjectInstancesByObjectId(id.Id)
+	objectInstances, err := c.ObjectInstanceRepository.GetObjectInstancesByObjectId(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get objectInstances for object ID %s. err: %v", id.Id, err)
 		return nil, errors.Wrapf(err, "Could not get objectInstances for object ID")
@@ -207,7 +247,7 @@ func (c *StorageHandlerHandlerServer) GetObjectsInstancesByObjectId(ctx context.
 }
 
 func (c *StorageHandlerHandlerServer) CreateObjectInstance(ctx context.Context, objectInstance *pb.ObjectInstance) (*pb.Id, error) {
-	id, err := c.ObjectInstanceRepository.CreateObjectInstance(mapper.ConvertToObjectInstance(objectInstance))
+	id, err := c.ObjectInstanceRepository.CreateObjectInstance(ctx, mapper.ConvertToObjectInstance(objectInstance))
 	if err != nil {
 		c.Logger.Error().Msgf("Could not create objectInstance for object ID: '%s'. err: %v", objectInstance.ObjectId, err)
 		return nil, errors.Wrapf(err, "Could not create objectInstance for object ID: '%s'", objectInstance.ObjectId)
@@ -216,7 +256,7 @@ func (c *StorageHandlerHandlerServer) CreateObjectInstance(ctx context.Context,
 }
 
 func (c *StorageHandlerHandlerServer) GetStoragePartitionsByStorageLocationId(ctx context.Context, locationId *pb.Id) (*pb.StoragePartitions, error) {
-	partitions, err := c.StoragePartitionService.GetStoragePartitionsForLocationId(locationId)
+	partitions, err := c.StoragePartitionService.GetStoragePartitionsForLocationId(ctx, locationId)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get storagePartition for storageLocation ID %s. err: %v", locationId.Id, err)
 		return nil, errors.Wrapf(err, "Could not get storagePartition for storageLocation")
@@ -225,7 +265,7 @@ func (c *StorageHandlerHandlerServer) GetStoragePartitionsByStorageLocationId(ct
 }
 
 func (c *StorageHandlerHandlerServer) DeleteObjectInstance(ctx context.Context, id *pb.Id) (*pb.Status, error) {
-	err := c.ObjectInstanceRepository.DeleteObjectInstance(id.Id)
+	err := c.ObjectInstanceRepository.DeleteObjectInstance(ctx, id.Id)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not delete objectInstance with ID: '%s'. err: %v", id.Id, err)
 		return &pb.Status{Ok: false}, errors.Wrapf(err, "Could not delete objectInstance with ID: '%s'", id.Id)
@@ -235,7 +275,7 @@ func (c *StorageHandlerHandlerServer) DeleteObjectInstance(ctx context.Context,
 
 func (c *StorageHandlerHandlerServer) AlterStatus(ctx context.Context, statusPb *pb.StatusObject) (*pb.Status, error) {
 	status := models.ArchivingStatus{Status: statusPb.Status, LastChanged: statusPb.LastChanged, Id: statusPb.Id}
-	err := c.StatusRepository.AlterStatus(status)
+	err := c.StatusRepository.AlterStatus(ctx, status)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not AlterStatus with id: '%s'. err: %v", statusPb.Id, err)
 		return &pb.Status{Ok: false}, errors.Wrapf(err, "Could not AlterStatus with id: '%s'", statusPb.Id)
@@ -244,7 +284,7 @@ func (c *StorageHandlerHandlerServer) AlterStatus(ctx context.Context, statusPb
 }
 
 func (c *StorageHandlerHandlerServer) GetObjectById(ctx context.Context, id *pb.Id) (*pb.Object, error) {
-	object, err := c.ObjectRepository.GetObjectById(id.Id)
+	object, err := c.ObjectRepository.GetObjectById(ctx, id.Id)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Could not GetObjectById with id: '%s'", id.Id)
 	}
@@ -253,7 +293,7 @@ func (c *StorageHandlerHandlerServer) GetObjectById(ctx context.Context, id *pb.
 }
 
 func (c *StorageHandlerHandlerServer) GetStorageLocationByObjectInstanceId(ctx context.Context, id *pb.Id) (*pb.StorageLocation, error) {
-	storageLocation, err := c.StorageLocationRepository.GetStorageLocationByObjectInstanceId(id.Id)
+	storageLocation, err := c.StorageLocationRepository.GetStorageLocationByObjectInstanceId(ctx, id.Id)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Could not get storage location by id object instance id: %s", id.Id)
 	}
@@ -261,7 +301,7 @@ func (c *StorageHandlerHandlerServer) GetStorageLocationByObjectInstanceId(ctx c
 }
 
 func (c *StorageHandlerHandlerServer) FindAllTenants(ctx context.Context, status *pb.NoParam) (*pb.Tenants, error) {
-	tenants, err := c.TenantService.FindAllTenants()
+	tenants, err := c.TenantService.FindAllTenants(ctx)
 	if err != nil {
 		c.Logger.Error().Msgf("Could not get all tenants")
 		return nil, errors.Wrapf(err, "Could not get all tenants")
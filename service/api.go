@@ -2,8 +2,12 @@ package service
 
 import (
 	"context"
+
+	"emperror.dev/errors"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/je4/utils/v2/pkg/zLogger"
+	"github.com/ocfl-archive/dlza-manager-handler/migrations"
 	"github.com/ocfl-archive/dlza-manager-handler/repository"
 )
 
@@ -40,17 +44,62 @@ func AfterConnectFunc(ctx context.Context, conn *pgx.Conn, logger zLogger.ZLogge
 	if err != nil {
 		return err
 	}
-	err = repository.CreateCheckerPreparedStatements(ctx, conn)
+	err = repository.CreateDispatcherPreparedStatements(ctx, conn)
 	if err != nil {
 		return err
 	}
-	err = repository.CreateDispatcherPreparedStatements(ctx, conn)
+	err = repository.CreateStatusPreparedStatements(ctx, conn)
 	if err != nil {
 		return err
 	}
-	err = repository.CreateStatusPreparedStatements(ctx, conn)
+	err = repository.CreateStatisticsPreparedStatements(ctx, conn)
+	if err != nil {
+		return err
+	}
+	err = repository.CreateAuditPreparedStatements(ctx, conn)
+	if err != nil {
+		return err
+	}
+	err = repository.CreateOutboxPreparedStatements(ctx, conn)
+	if err != nil {
+		return err
+	}
+	err = repository.CreateReservationPreparedStatements(ctx, conn)
+	if err != nil {
+		return err
+	}
+	err = repository.CreatePlacementPreparedStatements(ctx, conn)
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
+// VerifyPreparedStatements checks the statements prepared on a connection of the pool
+// against the columns the repositories expect.
+func VerifyPreparedStatements(ctx context.Context, pool *pgxpool.Pool) error {
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+	return repository.VerifyPreparedStatements(ctx, conn.Conn())
+}
+
+// CheckDatabase pings the database through the pool and makes sure it still has the
+// schema version of this build.
+func CheckDatabase(ctx context.Context, pool *pgxpool.Pool, logger zLogger.ZLogger) error {
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+	if err := conn.Ping(ctx); err != nil {
+		return errors.Wrap(err, "cannot ping database")
+	}
+	migrator, err := migrations.NewMigrator(conn.Conn(), migrations.MigrationFS, logger)
+	if err != nil {
+		return err
+	}
+	return migrator.Check(ctx)
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/je4/utils/v2/pkg/zLogger"
+	"github.com/ocfl-archive/dlza-manager-handler/repository"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const metricsNamespace = "dlza_handler"
+
+// ArchiveStatistics keeps gauges of the state of the archive. The statistics are read from
+// the database every Interval and not on every scrape, because they count whole tables.
+type ArchiveStatistics struct {
+	StatisticsRepository      repository.StatisticsRepository
+	StorageLocationRepository repository.StorageLocationRepository
+	Interval                  time.Duration
+	Logger                    zLogger.ZLogger
+
+	objectInstances   *prometheus.GaugeVec
+	partitionFill     *prometheus.GaugeVec
+	partitionObjects  *prometheus.GaugeVec
+	locationErrors    *prometheus.GaugeVec
+	lastUpdateSuccess prometheus.Gauge
+}
+
+func NewArchiveStatistics(statisticsRepository repository.StatisticsRepository, storageLocationRepository repository.StorageLocationRepository,
+	interval time.Duration, logger zLogger.ZLogger) *ArchiveStatistics {
+	return &ArchiveStatistics{
+		StatisticsRepository:      statisticsRepository,
+		StorageLocationRepository: storageLocationRepository,
+		Interval:                  interval,
+		Logger:                    logger,
+		objectInstances: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "object_instances",
+			Help:      "Number of object instances by status.",
+		}, []string{"status"}),
+		partitionFill: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "storage_partition_size_fill_ratio",
+			Help:      "Used size of the storage partition divided by its maximum size.",
+		}, []string{"storage_location", "partition"}),
+		partitionObjects: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "storage_partition_objects_fill_ratio",
+			Help:      "Number of objects in the storage partition divided by its maximum number of objects.",
+		}, []string{"storage_location", "partition"}),
+		locationErrors: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "storage_location_error_object_instances",
+			Help:      "Number of object instances with status error in the storage location.",
+		}, []string{"storage_location"}),
+		lastUpdateSuccess: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "statistics_last_success_timestamp_seconds",
+			Help:      "Time of the last complete update of the archive statistics.",
+		}),
+	}
+}
+
+func (a *ArchiveStatistics) Describe(ch chan<- *prometheus.Desc) {
+	a.objectInstances.Describe(ch)
+	a.partitionFill.Describe(ch)
+	a.partitionObjects.Describe(ch)
+	a.locationErrors.Describe(ch)
+	a.lastUpdateSuccess.Describe(ch)
+}
+
+func (a *ArchiveStatistics) Collect(ch chan<- prometheus.Metric) {
+	a.objectInstances.Collect(ch)
+	a.partitionFill.Collect(ch)
+	a.partitionObjects.Collect(ch)
+	a.locationErrors.Collect(ch)
+	a.lastUpdateSuccess.Collect(ch)
+}
+
+// Run updates the statistics every Interval until ctx is done.
+func (a *ArchiveStatistics) Run(ctx context.Context) {
+	ticker := time.NewTicker(a.Interval)
+	defer ticker.Stop()
+	for {
+		a.update(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *ArchiveStatistics) update(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, a.Interval)
+	defer cancel()
+	ok := true
+
+	counts, err := a.StatisticsRepository.GetObjectInstanceCountsByStatus(ctx)
+	if err != nil {
+		a.Logger.Error().Msgf("cannot update object instance statistics: %v", err)
+		ok = false
+	} else {
+		a.objectInstances.Reset()
+		for status, count := range counts {
+			a.objectInstances.WithLabelValues(status).Set(float64(count))
+		}
+	}
+
+	fills, err := a.StatisticsRepository.GetStoragePartitionFills(ctx)
+	if err != nil {
+		a.Logger.Error().Msgf("cannot update storage partition statistics: %v", err)
+		ok = false
+	} else {
+		a.partitionFill.Reset()
+		a.partitionObjects.Reset()
+		for _, fill := range fills {
+			if fill.MaxSize > 0 {
+				a.partitionFill.WithLabelValues(fill.StorageLocationAlias, fill.Alias).Set(float64(fill.CurrentSize) / float64(fill.MaxSize))
+			}
+			if fill.MaxObjects > 0 {
+				a.partitionObjects.WithLabelValues(fill.StorageLocationAlias, fill.Alias).Set(float64(fill.CurrentObjects) / float64(fill.MaxObjects))
+			}
+		}
+	}
+
+	storageLocations, err := a.StorageLocationRepository.GetAllStorageLocations(ctx)
+	if err != nil {
+		a.Logger.Error().Msgf("cannot update storage location statistics: %v", err)
+		ok = false
+	} else {
+		errorCounts := make(map[string]int, len(storageLocations))
+		for _, storageLocation := range storageLocations {
+			errorCount, err := a.StorageLocationRepository.GetAmountOfErrorsForStorageLocationId(ctx, storageLocation.Id)
+			if err != nil {
+				a.Logger.Error().Msgf("cannot get errors of storage location %s: %v", storageLocation.Alias, err)
+				ok = false
+				continue
+			}
+			errorCounts[storageLocation.Alias] = errorCount
+		}
+		a.locationErrors.Reset()
+		for alias, errorCount := range errorCounts {
+			a.locationErrors.WithLabelValues(alias).Set(float64(errorCount))
+		}
+	}
+
+	if ok {
+		a.lastUpdateSuccess.SetToCurrentTime()
+	}
+}
+
+// poolCollector reads the statistics of the connection pool on every scrape.
+type poolCollector struct {
+	pool  *pgxpool.Pool
+	descs map[string]*prometheus.Desc
+}
+
+// NewPoolCollector exposes pgxpool.Stat of the pool as metrics.
+func NewPoolCollector(pool *pgxpool.Pool) prometheus.Collector {
+	desc := func(name string, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(metricsNamespace, "db_pool", name), help, nil, nil)
+	}
+	return &poolCollector{pool: pool, descs: map[string]*prometheus.Desc{
+		"acquired":        desc("acquired_connections", "Number of connections currently in use."),
+		"idle":            desc("idle_connections", "Number of idle connections."),
+		"constructing":    desc("constructing_connections", "Number of connections being opened."),
+		"total":           desc("total_connections", "Number of connections in the pool."),
+		"max":             desc("max_connections", "Maximum size of the pool."),
+		"acquires":        desc("acquires_total", "Number of successful acquires of a connection."),
+		"emptyAcquires":   desc("empty_acquires_total", "Number of acquires that had to wait for a connection."),
+		"canceled":        desc("canceled_acquires_total", "Number of acquires canceled by their context."),
+		"acquireDuration": desc("acquire_duration_seconds_total", "Time spent waiting for connections."),
+	}}
+}
+
+func (p *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range p.descs {
+		ch <- desc
+	}
+}
+
+func (p *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := p.pool.Stat()
+	ch <- prometheus.MustNewConstMetric(p.descs["acquired"], prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(p.descs["idle"], prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(p.descs["constructing"], prometheus.GaugeValue, float64(stat.ConstructingConns()))
+	ch <- prometheus.MustNewConstMetric(p.descs["total"], prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(p.descs["max"], prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(p.descs["acquires"], prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(p.descs["emptyAcquires"], prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(p.descs["canceled"], prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(p.descs["acquireDuration"], prometheus.CounterValue, stat.AcquireDuration().Seconds())
+}
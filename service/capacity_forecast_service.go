@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// NotExhausted is the days until full of a capacity that the ingest rate does not exhaust.
+const NotExhausted = -1
+
+// CapacityProjection is the capacity of a partition or location with its daily ingest
+// and the days until it is full at that rate. The low and high rates bound the mean daily
+// ingest with 95% confidence, the earliest and latest days until full follow from them.
+type CapacityProjection struct {
+	CurrentSize    int64
+	MaxSize        int64
+	CurrentObjects int64
+	MaxObjects     int64
+
+	DailySize        float64
+	DailySizeLow     float64
+	DailySizeHigh    float64
+	DailyObjects     float64
+	DailyObjectsLow  float64
+	DailyObjectsHigh float64
+
+	// DaysUntilFull is the earlier of the days until size or objects are exhausted, or
+	// NotExhausted.
+	DaysUntilFull         float64
+	DaysUntilFullEarliest float64
+	DaysUntilFullLatest   float64
+}
+
+type PartitionForecast struct {
+	Id    string
+	Alias string
+	CapacityProjection
+}
+
+// TenantIngestRate is the mean daily ingest of the objects of a tenant on a location.
+type TenantIngestRate struct {
+	TenantId     string
+	DailySize    float64
+	DailyObjects float64
+}
+
+// LocationForecast projects the capacity of a storage location. The capacity of a location
+// with partition provisioning enabled includes the partitions it can still be grown by.
+type LocationForecast struct {
+	Id    string
+	Alias string
+	CapacityProjection
+	Partitions []PartitionForecast
+	Tenants    []TenantIngestRate
+}
+
+// CapacityForecast is the projection of the locations from the ingest of the WindowDays
+// days before Generated.
+type CapacityForecast struct {
+	Locations  []LocationForecast
+	WindowDays int
+	Generated  time.Time
+}
+
+// MaxForecastWindowDays is the longest window of ingest a forecast can be made from.
+const MaxForecastWindowDays = 3650
+
+type CapacityForecastService interface {
+	// ForecastCapacity projects the capacity of the storage location with the id, or of all
+	// locations if it is empty, from the ingest of the window before now. A window of 0
+	// uses the configured window. A window of more than MaxForecastWindowDays days is an
+	// ErrInvalidArgument.
+	ForecastCapacity(ctx context.Context, storageLocationId string, window time.Duration) (CapacityForecast, error)
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"slices"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/ocfl-archive/dlza-manager-handler/repository"
+)
+
+// confidenceZ is the z-score of the 95% confidence band of the mean daily ingest.
+const confidenceZ = 1.96
+
+const day = 24 * time.Hour
+
+func NewCapacityForecastService(statisticsRepository repository.StatisticsRepository, placementRepository repository.PlacementRepository,
+	window time.Duration) CapacityForecastService {
+	return &CapacityForecastServiceImpl{StatisticsRepository: statisticsRepository, PlacementRepository: placementRepository, Window: window}
+}
+
+type CapacityForecastServiceImpl struct {
+	StatisticsRepository repository.StatisticsRepository
+	PlacementRepository  repository.PlacementRepository
+	// Window is the ingest history the rates are averaged over if a forecast does not pass its own.
+	Window time.Duration
+}
+
+// ingestSeries is the size and number of object instances created per day of the window.
+type ingestSeries struct {
+	sizes   []float64
+	objects []float64
+}
+
+func newIngestSeries(days int) *ingestSeries {
+	return &ingestSeries{sizes: make([]float64, days), objects: make([]float64, days)}
+}
+
+func (c *CapacityForecastServiceImpl) ForecastCapacity(ctx context.Context, storageLocationId string, window time.Duration) (CapacityForecast, error) {
+	if window <= 0 {
+		window = c.Window
+	}
+	if window > MaxForecastWindowDays*day {
+		return CapacityForecast{}, errors.Wrapf(repository.ErrInvalidArgument, "window of %s is longer than %d days", window, MaxForecastWindowDays)
+	}
+	days := max(int(window/day), 1)
+	fills, err := c.StatisticsRepository.GetStoragePartitionFills(ctx)
+	if err != nil {
+		return CapacityForecast{}, errors.Wrapf(err, "Could not get fills of storagePartitions")
+	}
+	until := time.Now()
+	history, err := c.StatisticsRepository.GetIngestHistory(ctx, until.Add(-time.Duration(days)*day), until)
+	if err != nil {
+		return CapacityForecast{}, errors.Wrapf(err, "Could not get ingest history of the last %d days", days)
+	}
+
+	partitionSeries := make(map[string]*ingestSeries)
+	tenantIngest := make(map[string]map[string]*TenantIngestRate)
+	partitionLocations := make(map[string]string)
+	for _, fill := range fills {
+		partitionLocations[fill.Id] = fill.StorageLocationId
+	}
+	for _, ingest := range history {
+		if ingest.Day < 0 || ingest.Day >= days {
+			continue
+		}
+		series, ok := partitionSeries[ingest.StoragePartitionId]
+		if !ok {
+			series = newIngestSeries(days)
+			partitionSeries[ingest.StoragePartitionId] = series
+		}
+		series.sizes[ingest.Day] += float64(ingest.Size)
+		series.objects[ingest.Day] += float64(ingest.Objects)
+
+		locationId := partitionLocations[ingest.StoragePartitionId]
+		if tenantIngest[locationId] == nil {
+			tenantIngest[locationId] = make(map[string]*TenantIngestRate)
+		}
+		tenant, ok := tenantIngest[locationId][ingest.TenantId]
+		if !ok {
+			tenant = &TenantIngestRate{TenantId: ingest.TenantId}
+			tenantIngest[locationId][ingest.TenantId] = tenant
+		}
+		tenant.DailySize += float64(ingest.Size) / float64(days)
+		tenant.DailyObjects += float64(ingest.Objects) / float64(days)
+	}
+
+	locations := make(map[string]*LocationForecast)
+	locationSeries := make(map[string]*ingestSeries)
+	for _, fill := range fills {
+		if storageLocationId != "" && fill.StorageLocationId != storageLocationId {
+			continue
+		}
+		location, ok := locations[fill.StorageLocationId]
+		if !ok {
+			location = &LocationForecast{Id: fill.StorageLocationId, Alias: fill.StorageLocationAlias}
+			locations[fill.StorageLocationId] = location
+			locationSeries[fill.StorageLocationId] = newIngestSeries(days)
+		}
+		series, ok := partitionSeries[fill.Id]
+		if !ok {
+			series = newIngestSeries(days)
+		}
+		partition := PartitionForecast{Id: fill.Id, Alias: fill.Alias, CapacityProjection: CapacityProjection{CurrentSize: fill.CurrentSize,
+			MaxSize: fill.MaxSize, CurrentObjects: fill.CurrentObjects, MaxObjects: fill.MaxObjects}}
+		partition.project(series)
+		location.Partitions = append(location.Partitions, partition)
+
+		location.CurrentSize += fill.CurrentSize
+		location.MaxSize += fill.MaxSize
+		location.CurrentObjects += fill.CurrentObjects
+		location.MaxObjects += fill.MaxObjects
+		for i := range days {
+			locationSeries[fill.StorageLocationId].sizes[i] += series.sizes[i]
+			locationSeries[fill.StorageLocationId].objects[i] += series.objects[i]
+		}
+	}
+	if storageLocationId != "" && len(locations) == 0 {
+		return CapacityForecast{}, errors.Wrapf(repository.ErrNotFound, "no storagePartitions of storageLocation with id: %v", storageLocationId)
+	}
+
+	forecasts := make([]LocationForecast, 0, len(locations))
+	for id, location := range locations {
+		provisioning, err := c.PlacementRepository.GetPartitionProvisioning(ctx, id)
+		if err != nil {
+			return CapacityForecast{}, errors.Wrapf(err, "Could not get partition provisioning for StorageLocation with id: %v", id)
+		}
+		if provisioning.Enabled && provisioning.MaxPartitions > len(location.Partitions) {
+			provisionable := int64(provisioning.MaxPartitions - len(location.Partitions))
+			location.MaxSize += provisionable * provisioning.MaxSize
+			location.MaxObjects += provisionable * provisioning.MaxObjects
+		}
+		location.project(locationSeries[id])
+		for _, tenant := range tenantIngest[id] {
+			location.Tenants = append(location.Tenants, *tenant)
+		}
+		slices.SortFunc(location.Partitions, func(a, b PartitionForecast) int { return cmp.Compare(a.Alias, b.Alias) })
+		slices.SortFunc(location.Tenants, func(a, b TenantIngestRate) int { return cmp.Compare(a.TenantId, b.TenantId) })
+		forecasts = append(forecasts, *location)
+	}
+	slices.SortFunc(forecasts, func(a, b LocationForecast) int { return cmp.Compare(a.Alias, b.Alias) })
+	return CapacityForecast{Locations: forecasts, WindowDays: days, Generated: until}, nil
+}
+
+// project sets the rates and the days until full from the ingest of the days.
+func (p *CapacityProjection) project(series *ingestSeries) {
+	p.DailySize, p.DailySizeLow, p.DailySizeHigh = dailyRate(series.sizes)
+	p.DailyObjects, p.DailyObjectsLow, p.DailyObjectsHigh = dailyRate(series.objects)
+	remainingSize := float64(p.MaxSize - p.CurrentSize)
+	remainingObjects := float64(p.MaxObjects - p.CurrentObjects)
+	p.DaysUntilFull = earliest(daysUntilFull(remainingSize, p.DailySize), daysUntilFull(remainingObjects, p.DailyObjects))
+	// the high rates fill the capacity earliest
+	p.DaysUntilFullEarliest = earliest(daysUntilFull(remainingSize, p.DailySizeHigh), daysUntilFull(remainingObjects, p.DailyObjectsHigh))
+	p.DaysUntilFullLatest = earliest(daysUntilFull(remainingSize, p.DailySizeLow), daysUntilFull(remainingObjects, p.DailyObjectsLow))
+}
+
+// dailyRate returns the mean of the days and the bounds of its confidence band.
+func dailyRate(days []float64) (mean float64, low float64, high float64) {
+	n := float64(len(days))
+	for _, ingest := range days {
+		mean += ingest
+	}
+	mean /= n
+	if len(days) < 2 {
+		return mean, mean, mean
+	}
+	var variance float64
+	for _, ingest := range days {
+		variance += (ingest - mean) * (ingest - mean)
+	}
+	variance /= n - 1
+	margin := confidenceZ * math.Sqrt(variance/n)
+	return mean, max(mean-margin, 0), mean + margin
+}
+
+func daysUntilFull(remaining float64, rate float64) float64 {
+	if remaining <= 0 {
+		return 0
+	}
+	if rate <= 0 {
+		return NotExhausted
+	}
+	return remaining / rate
+}
+
+// earliest returns the lower of the days until full, NotExhausted only if both are.
+func earliest(a float64, b float64) float64 {
+	if a == NotExhausted {
+		return b
+	}
+	if b == NotExhausted {
+		return a
+	}
+	return min(a, b)
+}
@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ocfl-archive/dlza-manager-handler/repository"
+)
+
+// EventPublisher publishes the lifecycle events written to the outbox to the watchers.
+type EventPublisher interface {
+	// Run publishes the committed events until ctx is done or Stop is called.
+	Run(ctx context.Context)
+	// Watch calls send for every event with an offset greater than afterOffset, a negative
+	// afterOffset skips the events published before. It returns when ctx is done, send
+	// fails or the publisher stops.
+	Watch(ctx context.Context, afterOffset int64, send func(event repository.OutboxEvent) error) error
+	// Stop ends Run and the running watches. If ctx is done before Run has returned, the
+	// error says so.
+	Stop(ctx context.Context) error
+}
@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/je4/utils/v2/pkg/zLogger"
+	"github.com/ocfl-archive/dlza-manager-handler/repository"
+)
+
+const (
+	// eventBatchSize is the number of events numbered or read with one query.
+	eventBatchSize = 500
+	// watcherBuffer is the number of batches a watcher can fall behind before batches
+	// are dropped for it and it reads the missed events from the database.
+	watcherBuffer = 16
+	// retentionInterval is the time between two deletions of expired events.
+	retentionInterval = time.Hour
+)
+
+func NewEventPublisher(outboxRepository repository.OutboxRepository, pollInterval time.Duration, retention time.Duration, logger zLogger.ZLogger) EventPublisher {
+	return &EventPublisherImpl{
+		OutboxRepository: outboxRepository,
+		PollInterval:     pollInterval,
+		Retention:        retention,
+		Logger:           logger,
+		watchers:         make(map[*eventWatcher]struct{}),
+		stop:             make(chan struct{}),
+		stopped:          make(chan struct{}),
+	}
+}
+
+// EventPublisherImpl numbers the committed events and passes every new batch to the
+// watchers. A watcher reads the events it has missed, because it has started behind or
+// has not kept up with the batches, from the database.
+type EventPublisherImpl struct {
+	OutboxRepository repository.OutboxRepository
+	PollInterval     time.Duration
+	Retention        time.Duration
+	Logger           zLogger.ZLogger
+
+	// published and lastCleanup are only used by Run
+	published   int64
+	lastCleanup time.Time
+
+	mu       sync.Mutex
+	watchers map[*eventWatcher]struct{}
+	closed   bool
+	stop     chan struct{}
+	stopOnce sync.Once
+	stopped  chan struct{}
+}
+
+type eventWatcher struct {
+	batches chan []repository.OutboxEvent
+	lagged  atomic.Bool
+}
+
+func (p *EventPublisherImpl) Run(ctx context.Context) {
+	defer close(p.stopped)
+	defer p.closeWatchers()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-p.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	var listener repository.OutboxListener
+	defer func() {
+		if listener != nil {
+			listener.Close()
+		}
+	}()
+	started := false
+	for ctx.Err() == nil {
+		if !started {
+			// the watchers read the events published before from the database
+			_, last, err := p.OutboxRepository.GetOutboxOffsets(ctx)
+			if err != nil && ctx.Err() == nil {
+				p.Logger.Error().Msgf("Could not get offset of the last published event. err: %v", err)
+			}
+			p.published, started = last, err == nil
+		}
+		if started {
+			p.publish(ctx)
+			p.deleteExpired(ctx)
+		}
+		listener = p.wait(ctx, listener)
+	}
+}
+
+// publish numbers the committed events and passes the events published since the last
+// call to the watchers.
+func (p *EventPublisherImpl) publish(ctx context.Context) {
+	for {
+		numbered, err := p.OutboxRepository.PublishOutboxEvents(ctx, eventBatchSize)
+		if err != nil {
+			if ctx.Err() == nil {
+				p.Logger.Error().Msgf("Could not publish outbox events. err: %v", err)
+			}
+			break
+		}
+		if numbered < eventBatchSize {
+			break
+		}
+	}
+	for {
+		events, err := p.OutboxRepository.GetOutboxEvents(ctx, p.published, eventBatchSize)
+		if err != nil {
+			if ctx.Err() == nil {
+				p.Logger.Error().Msgf("Could not get events published after offset %d. err: %v", p.published, err)
+			}
+			return
+		}
+		if len(events) == 0 {
+			return
+		}
+		p.broadcast(events)
+		p.published = events[len(events)-1].Offset
+		if len(events) < eventBatchSize {
+			return
+		}
+	}
+}
+
+func (p *EventPublisherImpl) broadcast(events []repository.OutboxEvent) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for watcher := range p.watchers {
+		select {
+		case watcher.batches <- events:
+		default:
+			watcher.lagged.Store(true)
+		}
+	}
+}
+
+func (p *EventPublisherImpl) deleteExpired(ctx context.Context) {
+	if p.Retention <= 0 || time.Since(p.lastCleanup) < retentionInterval {
+		return
+	}
+	p.lastCleanup = time.Now()
+	deleted, err := p.OutboxRepository.DeleteOutboxEventsBefore(ctx, time.Now().Add(-p.Retention))
+	if err != nil {
+		if ctx.Err() == nil {
+			p.Logger.Error().Msgf("Could not delete events older than %s. err: %v", p.Retention, err)
+		}
+		return
+	}
+	if deleted > 0 {
+		p.Logger.Debug().Msgf("deleted %d events older than %s", deleted, p.Retention)
+	}
+}
+
+// wait returns when new events have been committed or PollInterval has passed. Without a
+// working listener it only waits for the interval and tries to listen again next time.
+func (p *EventPublisherImpl) wait(ctx context.Context, listener repository.OutboxListener) repository.OutboxListener {
+	waitCtx, cancel := context.WithTimeout(ctx, p.PollInterval)
+	defer cancel()
+	if listener == nil {
+		var err error
+		if listener, err = p.OutboxRepository.ListenOutboxEvents(waitCtx); err != nil {
+			if waitCtx.Err() == nil {
+				p.Logger.Error().Msgf("Could not listen for outbox events, polling every %s. err: %v", p.PollInterval, err)
+			}
+			<-waitCtx.Done()
+			return nil
+		}
+	}
+	if err := listener.Wait(waitCtx); err != nil && waitCtx.Err() == nil {
+		p.Logger.Error().Msgf("Could not wait for outbox events, polling every %s. err: %v", p.PollInterval, err)
+		listener.Close()
+		<-waitCtx.Done()
+		return nil
+	}
+	return listener
+}
+
+func (p *EventPublisherImpl) Watch(ctx context.Context, afterOffset int64, send func(event repository.OutboxEvent) error) error {
+	watcher, err := p.addWatcher()
+	if err != nil {
+		return err
+	}
+	defer p.removeWatcher(watcher)
+	first, last, err := p.OutboxRepository.GetOutboxOffsets(ctx)
+	if err != nil {
+		return err
+	}
+	if afterOffset < 0 {
+		afterOffset = last
+	} else if first > 0 && afterOffset < first-1 {
+		return errors.Wrapf(repository.ErrFailedPrecondition, "events after offset %d have been deleted, the oldest event has offset %d", afterOffset, first)
+	}
+	position := afterOffset
+	catchUp := func() error {
+		for {
+			events, err := p.OutboxRepository.GetOutboxEvents(ctx, position, eventBatchSize)
+			if err != nil {
+				return err
+			}
+			for _, event := range events {
+				if err := send(event); err != nil {
+					return err
+				}
+				position = event.Offset
+			}
+			if len(events) < eventBatchSize {
+				return nil
+			}
+		}
+	}
+	if err := catchUp(); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case batch, ok := <-watcher.batches:
+			if !ok {
+				return errors.Wrap(repository.ErrUnavailable, "event publisher has been stopped")
+			}
+			if batch[0].Offset > position+1 {
+				if err := catchUp(); err != nil {
+					return err
+				}
+			}
+			for _, event := range batch {
+				if event.Offset <= position {
+					continue
+				}
+				if err := send(event); err != nil {
+					return err
+				}
+				position = event.Offset
+			}
+			if watcher.lagged.Swap(false) {
+				if err := catchUp(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+func (p *EventPublisherImpl) addWatcher() (*eventWatcher, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return nil, errors.Wrap(repository.ErrUnavailable, "event publisher has been stopped")
+	}
+	watcher := &eventWatcher{batches: make(chan []repository.OutboxEvent, watcherBuffer)}
+	p.watchers[watcher] = struct{}{}
+	return watcher, nil
+}
+
+func (p *EventPublisherImpl) removeWatcher(watcher *eventWatcher) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.watchers, watcher)
+}
+
+// closeWatchers ends the running watches and refuses new ones.
+func (p *EventPublisherImpl) closeWatchers() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closed = true
+	for watcher := range p.watchers {
+		close(watcher.batches)
+		delete(p.watchers, watcher)
+	}
+}
+
+func (p *EventPublisherImpl) Stop(ctx context.Context) error {
+	p.stopOnce.Do(func() { close(p.stop) })
+	select {
+	case <-p.stopped:
+		return nil
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "event publisher has not stopped")
+	}
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// MaterializedViewRefresher refreshes the materialized views in the background, so that
+// the requests changing the underlying tables do not wait for the refresh.
+type MaterializedViewRefresher interface {
+	// Request marks the views for a refresh, all views if none are given. Requests
+	// arriving before the refresh starts are coalesced into one refresh.
+	Request(views ...string) error
+	// Run refreshes the requested views until ctx is done or Stop is called.
+	Run(ctx context.Context)
+	// Stop makes Run refresh the pending views without waiting for the interval and return.
+	// If ctx is done before, the error names the views not refreshed.
+	Stop(ctx context.Context) error
+	// Refreshes returns the refresh state of every view.
+	Refreshes() []MaterializedViewRefresh
+	// LastRefresh returns the start of the last successful refresh of the view, zero if
+	// it is not known.
+	LastRefresh(view string) time.Time
+}
+
+// MaterializedViewRefresh is the refresh state of a view. LastRefresh is the start of
+// the last successful refresh and zero if it is not known. PendingSince is the time the
+// pending refresh has been requested first and zero if the view is not pending.
+type MaterializedViewRefresh struct {
+	View         string
+	LastRefresh  time.Time
+	Pending      bool
+	PendingSince time.Time
+}
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/je4/utils/v2/pkg/zLogger"
+	"github.com/ocfl-archive/dlza-manager-handler/repository"
+)
+
+func NewMaterializedViewRefresher(refreshMaterializedViewsRepository repository.RefreshMaterializedViewsRepository, minInterval time.Duration, logger zLogger.ZLogger) MaterializedViewRefresher {
+	return &MaterializedViewRefresherImpl{
+		RefreshMaterializedViewsRepository: refreshMaterializedViewsRepository,
+		MinInterval:                        minInterval,
+		Logger:                             logger,
+		pending:                            make(map[string]time.Time),
+		lastRefresh:                        make(map[string]time.Time),
+		wake:                               make(chan struct{}, 1),
+		stop:                               make(chan struct{}),
+		stopped:                            make(chan struct{}),
+	}
+}
+
+// MaterializedViewRefresherImpl starts a refresh at most once per MinInterval. The views
+// requested while a refresh is waiting for the interval or running are refreshed together
+// in the next one. pending holds the time every pending view has been requested first.
+type MaterializedViewRefresherImpl struct {
+	RefreshMaterializedViewsRepository repository.RefreshMaterializedViewsRepository
+	MinInterval                        time.Duration
+	Logger                             zLogger.ZLogger
+
+	mu          sync.Mutex
+	pending     map[string]time.Time
+	lastRefresh map[string]time.Time
+	lastRun     time.Time
+	refreshing  []string
+	wake        chan struct{}
+	stop        chan struct{}
+	stopOnce    sync.Once
+	stopped     chan struct{}
+}
+
+func (m *MaterializedViewRefresherImpl) Request(views ...string) error {
+	if len(views) == 0 {
+		views = repository.MaterializedViews
+	}
+	for _, view := range views {
+		if !slices.Contains(repository.MaterializedViews, view) {
+			return errors.Wrapf(repository.ErrInvalidArgument, "unknown materialized view '%s'", view)
+		}
+	}
+	now := time.Now()
+	m.mu.Lock()
+	for _, view := range views {
+		m.requestSince(view, now)
+	}
+	m.mu.Unlock()
+	select {
+	case m.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (m *MaterializedViewRefresherImpl) Run(ctx context.Context) {
+	defer close(m.stopped)
+	m.loadLastRefreshes(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-m.stop:
+			m.refreshPending(ctx)
+			return
+		case <-m.wake:
+		}
+		m.mu.Lock()
+		wait := time.Until(m.lastRun.Add(m.MinInterval))
+		m.mu.Unlock()
+		if wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-m.stop:
+				timer.Stop()
+			case <-timer.C:
+			}
+		}
+		m.refreshPending(ctx)
+	}
+}
+
+// loadLastRefreshes takes over the refresh times stored by earlier runs, so that they are
+// known before the first refresh of this run.
+func (m *MaterializedViewRefresherImpl) loadLastRefreshes(ctx context.Context) {
+	refreshes, err := m.RefreshMaterializedViewsRepository.GetMaterializedViewRefreshes(ctx)
+	if err != nil {
+		m.Logger.Error().Msgf("Could not get last refreshes of materialized views. err: %v", err)
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for view, refreshed := range refreshes {
+		if refreshed.After(m.lastRefresh[view]) {
+			m.lastRefresh[view] = refreshed
+		}
+	}
+}
+
+// requestSince marks the view as pending since the time, unless it is pending since
+// earlier. The caller holds mu.
+func (m *MaterializedViewRefresherImpl) requestSince(view string, since time.Time) {
+	if pendingSince, ok := m.pending[view]; !ok || since.Before(pendingSince) {
+		m.pending[view] = since
+	}
+}
+
+// refreshPending refreshes the pending views in the order of repository.MaterializedViews.
+// A view failing to refresh stays pending since its first request and is retried after
+// the interval.
+func (m *MaterializedViewRefresherImpl) refreshPending(ctx context.Context) {
+	m.mu.Lock()
+	var views []string
+	pendingSince := make(map[string]time.Time)
+	for _, view := range repository.MaterializedViews {
+		if since, ok := m.pending[view]; ok {
+			views = append(views, view)
+			pendingSince[view] = since
+			delete(m.pending, view)
+		}
+	}
+	m.lastRun = time.Now()
+	m.mu.Unlock()
+
+	defer func() {
+		m.mu.Lock()
+		m.refreshing = nil
+		m.mu.Unlock()
+	}()
+	for i, view := range views {
+		m.mu.Lock()
+		m.refreshing = views[i:]
+		m.mu.Unlock()
+		started, err := m.RefreshMaterializedViewsRepository.RefreshMaterializedView(ctx, view)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			m.Logger.Error().Msgf("Could not refresh materialized view '%s'. err: %v", view, err)
+			m.mu.Lock()
+			m.requestSince(view, pendingSince[view])
+			m.mu.Unlock()
+			select {
+			case m.wake <- struct{}{}:
+			default:
+			}
+			continue
+		}
+		m.Logger.Debug().Msgf("refreshed materialized view '%s' in %s", view, time.Since(started))
+		m.mu.Lock()
+		m.lastRefresh[view] = started
+		m.mu.Unlock()
+	}
+}
+
+func (m *MaterializedViewRefresherImpl) Stop(ctx context.Context) error {
+	m.stopOnce.Do(func() { close(m.stop) })
+	select {
+	case <-m.stopped:
+		return nil
+	case <-ctx.Done():
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	views := slices.Clone(m.refreshing)
+	for _, view := range repository.MaterializedViews {
+		if _, ok := m.pending[view]; ok && !slices.Contains(views, view) {
+			views = append(views, view)
+		}
+	}
+	return errors.Wrapf(ctx.Err(), "refresh of materialized views [%s] not finished", strings.Join(views, ", "))
+}
+
+func (m *MaterializedViewRefresherImpl) LastRefresh(view string) time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.lastRefresh[view]
+}
+
+func (m *MaterializedViewRefresherImpl) Refreshes() []MaterializedViewRefresh {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	refreshes := make([]MaterializedViewRefresh, 0, len(repository.MaterializedViews))
+	for _, view := range repository.MaterializedViews {
+		pendingSince, pending := m.pending[view]
+		refreshes = append(refreshes, MaterializedViewRefresh{View: view, LastRefresh: m.lastRefresh[view], Pending: pending, PendingSince: pendingSince})
+	}
+	return refreshes
+}
@@ -1,5 +1,7 @@
 package service
 
+import "context"
+
 type ObjectInstanceService interface {
-	GetStatusForObjectId(id string) (int, error)
+	GetStatusForObjectId(ctx context.Context, id string) (int, error)
 }
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"emperror.dev/errors"
 	"github.com/ocfl-archive/dlza-manager-handler/repository"
 )
@@ -22,8 +23,8 @@ type ObjectInstanceServiceImpl struct {
 	ObjectInstanceRepository repository.ObjectInstanceRepository
 }
 
-func (o ObjectInstanceServiceImpl) GetStatusForObjectId(id string) (int, error) {
-	objectInstances, err := o.ObjectInstanceRepository.GetObjectInstancesByObjectId(id)
+func (o ObjectInstanceServiceImpl) GetStatusForObjectId(ctx context.Context, id string) (int, error) {
+	objectInstances, err := o.ObjectInstanceRepository.GetObjectInstancesByObjectId(ctx, id)
 	if err != nil {
 		return 0, errors.Wrapf(err, "cannot get objectInstances by object id")
 	}
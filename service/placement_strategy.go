@@ -0,0 +1,147 @@
+package service
+
+import (
+	"hash/fnv"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/ocfl-archive/dlza-manager/models"
+)
+
+// Names of the placement strategies a storage location can be configured with.
+const (
+	FillFirstPlacement       = "fill-first"
+	LeastUsedPlacement       = "least-used"
+	RoundRobinPlacement      = "round-robin"
+	HashBySignaturePlacement = "hash-by-signature"
+
+	// DefaultPlacement is the strategy of the locations without configured strategy.
+	DefaultPlacement = FillFirstPlacement
+)
+
+// PlacementStrategies lists the names of all placement strategies.
+var PlacementStrategies = []string{FillFirstPlacement, LeastUsedPlacement, RoundRobinPlacement, HashBySignaturePlacement}
+
+// PlacementObject is the object instance to place.
+type PlacementObject struct {
+	Signature string
+	Size      int64
+	// ExcludedPartitionId is a partition the object must not be placed on, because it
+	// already holds another version of the object.
+	ExcludedPartitionId string
+}
+
+// PlacementStrategy chooses the partition of a storage location an object instance is
+// placed on. The partitions passed are those of one location, their current size and
+// objects include the capacity reserved by other placements.
+type PlacementStrategy interface {
+	Choose(partitions []models.StoragePartition, object PlacementObject) (models.StoragePartition, bool)
+}
+
+// NewPlacementStrategies returns an instance of every strategy by name. The instances
+// keep their state between placements, so they are created once.
+func NewPlacementStrategies() map[string]PlacementStrategy {
+	return map[string]PlacementStrategy{
+		FillFirstPlacement:       FillFirstStrategy{},
+		LeastUsedPlacement:       LeastUsedStrategy{},
+		RoundRobinPlacement:      &RoundRobinStrategy{last: make(map[string]string)},
+		HashBySignaturePlacement: HashBySignatureStrategy{},
+	}
+}
+
+// fits tells whether the object can be placed on the partition.
+func fits(partition models.StoragePartition, object PlacementObject) bool {
+	return partition.CurrentSize+object.Size <= partition.MaxSize && partition.CurrentObjects < partition.MaxObjects &&
+		partition.Id != object.ExcludedPartitionId
+}
+
+// FillFirstStrategy fills the fullest partition first, so that the other partitions stay
+// empty as long as possible.
+type FillFirstStrategy struct{}
+
+func (FillFirstStrategy) Choose(partitions []models.StoragePartition, object PlacementObject) (models.StoragePartition, bool) {
+	var chosen models.StoragePartition
+	var currentSize int64
+	for _, partition := range partitions {
+		if partition.CurrentSize >= currentSize && fits(partition, object) {
+			currentSize = partition.CurrentSize
+			chosen = partition
+		}
+	}
+	return chosen, chosen.Id != ""
+}
+
+// LeastUsedStrategy chooses the partition with the lowest fill ratio, so that the
+// partitions fill evenly.
+type LeastUsedStrategy struct{}
+
+func (LeastUsedStrategy) Choose(partitions []models.StoragePartition, object PlacementObject) (models.StoragePartition, bool) {
+	var chosen models.StoragePartition
+	var chosenRatio float64
+	for _, partition := range partitions {
+		if !fits(partition, object) {
+			continue
+		}
+		ratio := float64(partition.CurrentSize) / float64(partition.MaxSize)
+		if chosen.Id == "" || ratio < chosenRatio {
+			chosen, chosenRatio = partition, ratio
+		}
+	}
+	return chosen, chosen.Id != ""
+}
+
+// RoundRobinStrategy chooses the partitions of a location in turn, in the order of their
+// ids. The turn is kept per handler instance.
+type RoundRobinStrategy struct {
+	mu   sync.Mutex
+	last map[string]string
+}
+
+func (r *RoundRobinStrategy) Choose(partitions []models.StoragePartition, object PlacementObject) (models.StoragePartition, bool) {
+	candidates := make([]models.StoragePartition, 0, len(partitions))
+	for _, partition := range partitions {
+		if fits(partition, object) {
+			candidates = append(candidates, partition)
+		}
+	}
+	if len(candidates) == 0 {
+		return models.StoragePartition{}, false
+	}
+	slices.SortFunc(candidates, func(a, b models.StoragePartition) int { return strings.Compare(a.Id, b.Id) })
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	location := candidates[0].StorageLocationId
+	chosen := candidates[0]
+	for _, candidate := range candidates {
+		if candidate.Id > r.last[location] {
+			chosen = candidate
+			break
+		}
+	}
+	r.last[location] = chosen.Id
+	return chosen, true
+}
+
+// HashBySignatureStrategy places an object on the partition with the highest hash of
+// signature and partition id. The objects spread evenly and an object placed again lands
+// on the same partition as long as it has room.
+type HashBySignatureStrategy struct{}
+
+func (HashBySignatureStrategy) Choose(partitions []models.StoragePartition, object PlacementObject) (models.StoragePartition, bool) {
+	var chosen models.StoragePartition
+	var chosenHash uint64
+	for _, partition := range partitions {
+		if !fits(partition, object) {
+			continue
+		}
+		hash := fnv.New64a()
+		hash.Write([]byte(object.Signature))
+		hash.Write([]byte{0})
+		hash.Write([]byte(partition.Id))
+		if sum := hash.Sum64(); chosen.Id == "" || sum > chosenHash {
+			chosen, chosenHash = partition, sum
+		}
+	}
+	return chosen, chosen.Id != ""
+}
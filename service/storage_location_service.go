@@ -1,5 +1,7 @@
 package service
 
+import "context"
+
 type StorageLocationService interface {
-	GetStorageLocationsStatusForCollectionAlias(id string, size int64, signature string, head string) (string, error)
+	GetStorageLocationsStatusForCollectionAlias(ctx context.Context, id string, size int64, signature string, head string) (string, error)
 }
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"emperror.dev/errors"
 	"github.com/ocfl-archive/dlza-manager-handler/repository"
 	pb "github.com/ocfl-archive/dlza-manager/dlzamanagerproto"
@@ -21,13 +22,13 @@ type StorageLocationServiceImpl struct {
 	StoragePartitionService   StoragePartitionService
 }
 
-func (s StorageLocationServiceImpl) GetStorageLocationsStatusForCollectionAlias(alias string, size int64, signature string, head string) (string, error) {
+func (s StorageLocationServiceImpl) GetStorageLocationsStatusForCollectionAlias(ctx context.Context, alias string, size int64, signature string, head string) (string, error) {
 
-	collection, err := s.CollectionRepository.GetCollectionByAlias(alias)
+	collection, err := s.CollectionRepository.GetCollectionByAlias(ctx, alias)
 	if err != nil {
 		return "", errors.Wrapf(err, "Could not get collectionId for collection with alias '%s'", alias)
 	}
-	storageLocations, err := s.StorageLocationRepository.GetStorageLocationsByTenantId(collection.TenantId)
+	storageLocations, err := s.StorageLocationRepository.GetStorageLocationsByTenantId(ctx, collection.TenantId)
 	if err != nil {
 		return "", errors.Wrapf(err, "Could not get storageLocations for collection with alias '%s'", alias)
 	}
@@ -42,7 +43,7 @@ func (s StorageLocationServiceImpl) GetStorageLocationsStatusForCollectionAlias(
 
 	firstPartitionId := ""
 	for _, storageLocation := range storageLocationsPb.StorageLocations {
-		storagePartition, err := s.StoragePartitionService.GetStoragePartitionForLocation(&pb.SizeObjectLocation{Size: size, Location: storageLocation, Object: objectPb})
+		storagePartition, err := s.StoragePartitionService.GetStoragePartitionForLocation(ctx, &pb.SizeObjectLocation{Size: size, Location: storageLocation, Object: objectPb})
 		if err != nil {
 			return "Could not get storagePartition for storageLocation " + storageLocation.Alias, errors.Wrapf(err, "Could not get storagePartition for storageLocation '%s'", storageLocation.Alias)
 		}
@@ -1,9 +1,8 @@
 package service
 
 import (
-	"slices"
-	"strconv"
-	"strings"
+	"context"
+	"time"
 
 	"emperror.dev/errors"
 	"github.com/ocfl-archive/dlza-manager-handler/repository"
@@ -16,43 +15,25 @@ type StoragePartitionService struct {
 	StoragePartitionRepository repository.StoragePartitionRepository
 	StorageLocationRepository  repository.StorageLocationRepository
 	ObjectRepository           repository.ObjectRepository
+	ReservationRepository      repository.ReservationRepository
+	PlacementRepository        repository.PlacementRepository
+	// PlacementStrategies are the strategies by name, as returned by NewPlacementStrategies.
+	PlacementStrategies map[string]PlacementStrategy
+	// ReservationTTL is the time the capacity claimed by a placement stays reserved if
+	// the object instance is not stored.
+	ReservationTTL time.Duration
 }
 
-const aliasStart = "partition-"
-
-func (s *StoragePartitionService) CreateStoragePartition(storagePartition models.StoragePartition) error {
-	storagePartitions, err := s.StoragePartitionRepository.GetStoragePartitionsByLocationId(storagePartition.StorageLocationId)
-	if err != nil {
-		return errors.Wrapf(err, "Could not get StoragePartitions for StorageLocation with id: %v", storagePartition.StorageLocationId)
-	}
-	aliasNumbers := make([]int, 0)
-	for _, storagePartition := range storagePartitions {
-		alias := storagePartition.Alias
-		parts := strings.Split(alias, aliasStart)[1]
-		if len(parts) > 1 {
-			aliasNumber, err := strconv.Atoi(parts)
-			if err != nil {
-				return errors.Wrapf(err, "Error during converting '%v' to int", alias)
-			}
-			aliasNumbers = append(aliasNumbers, aliasNumber)
-		}
-	}
-	if len(aliasNumbers) == 0 {
-		aliasNumbers = append(aliasNumbers, 0)
-	}
-	nextAliasNumber := slices.Max(aliasNumbers) + 1
-
-	storagePartition.Alias = storagePartition.StorageLocationId + "-" + aliasStart + strconv.Itoa(nextAliasNumber)
-
-	_, err = s.StoragePartitionRepository.CreateStoragePartition(storagePartition)
+func (s *StoragePartitionService) CreateStoragePartition(ctx context.Context, storagePartition models.StoragePartition) error {
+	_, err := s.StoragePartitionRepository.CreateStoragePartitionWithNextAlias(ctx, storagePartition)
 	if err != nil {
 		return errors.Wrapf(err, "Could not save StoragePartitions for StorageLocation with id: %v", storagePartition.StorageLocationId)
 	}
 	return nil
 }
 
-func (s *StoragePartitionService) GetStoragePartitionsForLocationId(locationId *pb.Id) (*pb.StoragePartitions, error) {
-	storagePartitions, err := s.StoragePartitionRepository.GetStoragePartitionsByLocationId(locationId.Id)
+func (s *StoragePartitionService) GetStoragePartitionsForLocationId(ctx context.Context, locationId *pb.Id) (*pb.StoragePartitions, error) {
+	storagePartitions, err := s.StoragePartitionRepository.GetStoragePartitionsByLocationId(ctx, locationId.Id)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Could not get StoragePartitions for StorageLocation with id: %v", locationId.Id)
 	}
@@ -64,76 +45,113 @@ func (s *StoragePartitionService) GetStoragePartitionsForLocationId(locationId *
 	return &pb.StoragePartitions{StoragePartitions: storagePartitionsPb}, nil
 }
 
-func (s *StoragePartitionService) GetStoragePartitionForLocation(sizeAndLocationId *pb.SizeObjectLocation) (*pb.StoragePartition, error) {
-	object, err := s.ObjectRepository.GetObjectBySignatureAndStorageLocationGroup(sizeAndLocationId.Object.Signature, sizeAndLocationId.Location.Group)
+func (s *StoragePartitionService) GetStoragePartitionForLocation(ctx context.Context, sizeAndLocationId *pb.SizeObjectLocation) (*pb.StoragePartition, error) {
+	object, err := s.ObjectRepository.GetObjectBySignatureAndStorageLocationGroup(ctx, sizeAndLocationId.Object.Signature, sizeAndLocationId.Location.Group)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Could not get GetObjectBySignatureAndStorageLocationGroup for Object and StorageLocation with signature and group: %s/%s ", sizeAndLocationId.Object.Signature, sizeAndLocationId.Location.Group)
 	}
 	firstVersionPartition := models.StoragePartition{}
 	if sizeAndLocationId.Object.Head != "v1" && object.Id != "" {
-		firstVersionPartition, err = s.StoragePartitionRepository.GetStoragePartitionByObjectSignatureAndLocation(sizeAndLocationId.Object.Signature, sizeAndLocationId.Location.Group)
+		firstVersionPartition, err = s.StoragePartitionRepository.GetStoragePartitionByObjectSignatureAndLocation(ctx, sizeAndLocationId.Object.Signature, sizeAndLocationId.Location.Group)
 		if err != nil {
 			return nil, errors.Wrapf(err, "Could not get StoragePartitionByObjectSignatureAndLocation for Object and StorageLocation with signature and group: %s/%s ", sizeAndLocationId.Object.Signature, sizeAndLocationId.Location.Group)
 		}
 	}
-	storageLocations, err := s.StorageLocationRepository.GetStorageLocationsByTenantIdAndGroup(sizeAndLocationId.Location.TenantId, sizeAndLocationId.Location.Group)
-	var partitionOptimal models.StoragePartition
-	for _, storageLocation := range storageLocations {
-		partitions, err := s.StoragePartitionRepository.GetStoragePartitionsByLocationId(storageLocation.Id)
+	storageLocations, err := s.StorageLocationRepository.GetStorageLocationsByTenantIdAndGroup(ctx, sizeAndLocationId.Location.TenantId, sizeAndLocationId.Location.Group)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Could not get StorageLocations for tenant with id and group: %s/%s", sizeAndLocationId.Location.TenantId, sizeAndLocationId.Location.Group)
+	}
+	placementObject := PlacementObject{Signature: sizeAndLocationId.Object.Signature, Size: sizeAndLocationId.Size, ExcludedPartitionId: firstVersionPartition.Id}
+	// partitions are provisioned only if no location has room on its partitions
+	for _, provision := range []bool{false, true} {
+		for _, storageLocation := range storageLocations {
+			partitionOptimal, ok, err := s.reserveStoragePartition(ctx, storageLocation.Id, sizeAndLocationId.Location.Group, placementObject, provision)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				return mapper.ConvertToStoragePartitionPb(partitionOptimal), nil
+			}
+		}
+	}
+	return nil, errors.Wrapf(repository.ErrFailedPrecondition, "Could not find optimal storagePartition for storageLocation group: %s", sizeAndLocationId.Location.Group)
+}
+
+// reserveStoragePartition reserves a partition of the location chosen by the placement
+// strategy of the location. With provision a partition is created if none has room and
+// the location has provisioning enabled.
+func (s *StoragePartitionService) reserveStoragePartition(ctx context.Context, storageLocationId string, locationGroup string, object PlacementObject,
+	provision bool) (models.StoragePartition, bool, error) {
+	strategy, err := s.placementStrategy(ctx, storageLocationId)
+	if err != nil {
+		return models.StoragePartition{}, false, err
+	}
+	choose := func(partitions []models.StoragePartition) (models.StoragePartition, bool) {
+		return strategy.Choose(partitions, object)
+	}
+	var provisionPartition func(partitions []models.StoragePartition) (models.StoragePartition, bool)
+	if provision {
+		provisioning, err := s.PlacementRepository.GetPartitionProvisioning(ctx, storageLocationId)
 		if err != nil {
-			return nil, errors.Wrapf(err, "Could not get StoragePartitions for StorageLocation with id: %v", storageLocation.Id)
+			return models.StoragePartition{}, false, errors.Wrapf(err, "Could not get partition provisioning for StorageLocation with id: %v", storageLocationId)
 		}
-		var currentSize int64
-		for _, partition := range partitions {
-			if (partition.CurrentSize >= currentSize) && (partition.CurrentSize+sizeAndLocationId.Size <= partition.MaxSize) && (partition.CurrentObjects < partition.MaxObjects) && (partition.Id != firstVersionPartition.Id) {
-				currentSize = partition.CurrentSize
-				partitionOptimal = partition
-			}
+		if !provisioning.Enabled {
+			return models.StoragePartition{}, false, nil
 		}
-		if partitionOptimal.Id != "" {
-			break
+		provisionPartition = func(partitions []models.StoragePartition) (models.StoragePartition, bool) {
+			return provisionStoragePartition(provisioning, partitions, object)
 		}
 	}
-	if partitionOptimal.Id == "" {
-		return nil, errors.New("Could not find optimal storagePartition for storageLocation group: " + sizeAndLocationId.Location.Group)
+	reservation := repository.PartitionReservation{StorageLocationId: storageLocationId, Signature: object.Signature,
+		LocationGroup: locationGroup, Size: object.Size, TTL: s.ReservationTTL}
+	partition, ok, err := s.ReservationRepository.ReserveStoragePartition(ctx, reservation, choose, provisionPartition)
+	if err != nil {
+		return models.StoragePartition{}, false, errors.Wrapf(err, "Could not reserve StoragePartition for StorageLocation with id: %v", storageLocationId)
 	}
-	return mapper.ConvertToStoragePartitionPb(partitionOptimal), nil
+	return partition, ok, nil
 }
 
-func (s *StoragePartitionService) UpdateStoragePartition(storagePartitionPb *pb.StoragePartition) (*pb.Status, error) {
-	err := s.StoragePartitionRepository.UpdateStoragePartition(mapper.ConvertToStoragePartition(storagePartitionPb))
-	if err != nil {
-		return &pb.Status{Ok: false}, errors.Wrapf(err, "Could not update storagePartition with ID: %v", storagePartitionPb.Id)
+// provisionStoragePartition returns the partition to create on the location of the
+// partitions for the object, false if the location has reached its number of partitions
+// or the object is larger than the partitions provisioned.
+func provisionStoragePartition(provisioning repository.PartitionProvisioning, partitions []models.StoragePartition,
+	object PlacementObject) (models.StoragePartition, bool) {
+	if len(partitions) >= provisioning.MaxPartitions || object.Size > provisioning.MaxSize || provisioning.MaxObjects < 1 {
+		return models.StoragePartition{}, false
 	}
-	return &pb.Status{Ok: true}, nil
+	return models.StoragePartition{MaxSize: provisioning.MaxSize, MaxObjects: provisioning.MaxObjects,
+		StorageLocationId: provisioning.StorageLocationId}, true
 }
 
-func (s *StoragePartitionService) GetAndSaveStoragePartitionWithRelevantAlias(storagePartition *pb.StoragePartition) (*pb.StoragePartition, error) {
-	storagePartitions, err := s.StoragePartitionRepository.GetStoragePartitionsByLocationId(storagePartition.StorageLocationId)
+// placementStrategy returns the strategy configured for the location.
+func (s *StoragePartitionService) placementStrategy(ctx context.Context, storageLocationId string) (PlacementStrategy, error) {
+	name, err := s.PlacementRepository.GetPlacementStrategy(ctx, storageLocationId)
 	if err != nil {
-		return nil, errors.Wrapf(err, "Could not get StoragePartitions for StorageLocation with id: %v", storagePartition.StorageLocationId)
+		return nil, errors.Wrapf(err, "Could not get placement strategy for StorageLocation with id: %v", storageLocationId)
 	}
-	aliasNumbers := make([]int, 0)
-	for _, storagePartition := range storagePartitions {
-		alias := storagePartition.Alias
-		parts := strings.Split(alias, aliasStart)
-		if len(parts) > 1 {
-			aliasNumber, err := strconv.Atoi(parts[1])
-			if err != nil {
-				return nil, errors.Wrapf(err, "Error during converting '%v' to int", alias)
-			}
-			aliasNumbers = append(aliasNumbers, aliasNumber)
-		}
+	if name == "" {
+		name = DefaultPlacement
 	}
-	if len(aliasNumbers) == 0 {
-		aliasNumbers = append(aliasNumbers, 0)
+	strategy, ok := s.PlacementStrategies[name]
+	if !ok {
+		return nil, errors.Wrapf(repository.ErrFailedPrecondition, "unknown placement strategy '%s' of StorageLocation with id: %v", name, storageLocationId)
 	}
-	nextAliasNumber := slices.Max(aliasNumbers) + 1
+	return strategy, nil
+}
+
+func (s *StoragePartitionService) UpdateStoragePartition(ctx context.Context, storagePartitionPb *pb.StoragePartition) (*pb.Status, error) {
+	err := s.StoragePartitionRepository.UpdateStoragePartition(ctx, mapper.ConvertToStoragePartition(storagePartitionPb))
+	if err != nil {
+		return &pb.Status{Ok: false}, errors.Wrapf(err, "Could not update storagePartition with ID: %v", storagePartitionPb.Id)
+	}
+	return &pb.Status{Ok: true}, nil
+}
 
-	storagePartition.Alias = storagePartition.StorageLocationId + "-" + aliasStart + strconv.Itoa(nextAliasNumber)
-	_, err = s.StoragePartitionRepository.CreateStoragePartition(mapper.ConvertToStoragePartition(storagePartition))
+func (s *StoragePartitionService) GetAndSaveStoragePartitionWithRelevantAlias(ctx context.Context, storagePartition *pb.StoragePartition) (*pb.StoragePartition, error) {
+	partition, err := s.StoragePartitionRepository.CreateStoragePartitionWithNextAlias(ctx, mapper.ConvertToStoragePartition(storagePartition))
 	if err != nil {
 		return nil, errors.Wrapf(err, "Could not save StoragePartitions for StorageLocation with id: %v", storagePartition.StorageLocationId)
 	}
+	storagePartition.Alias, storagePartition.Name = partition.Alias, partition.Name
 	return storagePartition, nil
 }
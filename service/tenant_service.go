@@ -1,14 +1,15 @@
 package service
 
 import (
+	"context"
 	"github.com/ocfl-archive/dlza-manager/models"
 )
 
 type TenantService interface {
-	FindTenantById(id string) (models.Tenant, error)
-	DeleteTenant(id string) error
-	SaveTenant(tenant models.Tenant) error
-	UpdateTenant(tenant models.Tenant) error
-	FindAllTenants() ([]models.Tenant, error)
-	FindAllTenantsPaginated(pagination models.Pagination) ([]models.Tenant, int, error)
+	FindTenantById(ctx context.Context, id string) (models.Tenant, error)
+	DeleteTenant(ctx context.Context, id string) error
+	SaveTenant(ctx context.Context, tenant models.Tenant) (string, error)
+	UpdateTenant(ctx context.Context, tenant models.Tenant) error
+	FindAllTenants(ctx context.Context) ([]models.Tenant, error)
+	FindAllTenantsPaginated(ctx context.Context, pagination models.Pagination) ([]models.Tenant, int, error)
 }
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"github.com/ocfl-archive/dlza-manager-handler/repository"
 	"github.com/ocfl-archive/dlza-manager/models"
 )
@@ -13,36 +14,35 @@ func NewTenantService(tenantRepository repository.TenantRepository) TenantServic
 	return &TenantServiceImpl{TenantRepository: tenantRepository}
 }
 
-func (t *TenantServiceImpl) UpdateTenant(tenant models.Tenant) error {
-	_, err := t.TenantRepository.FindTenantById(tenant.Id)
+func (t *TenantServiceImpl) UpdateTenant(ctx context.Context, tenant models.Tenant) error {
+	_, err := t.TenantRepository.FindTenantById(ctx, tenant.Id)
 	if err != nil {
 		return err
 	}
-	return t.TenantRepository.UpdateTenant(tenant)
+	return t.TenantRepository.UpdateTenant(ctx, tenant)
 }
 
-func (t *TenantServiceImpl) DeleteTenant(id string) error {
-	_, err := t.TenantRepository.FindTenantById(id)
+func (t *TenantServiceImpl) DeleteTenant(ctx context.Context, id string) error {
+	_, err := t.TenantRepository.FindTenantById(ctx, id)
 	if err != nil {
 		return err
 	}
-	return t.TenantRepository.DeleteTenant(id)
+	return t.TenantRepository.DeleteTenant(ctx, id)
 }
 
-func (t *TenantServiceImpl) FindTenantById(id string) (models.Tenant, error) {
-	tenant, err := t.TenantRepository.FindTenantById(id)
+func (t *TenantServiceImpl) FindTenantById(ctx context.Context, id string) (models.Tenant, error) {
+	tenant, err := t.TenantRepository.FindTenantById(ctx, id)
 	return tenant, err
 }
 
-func (t *TenantServiceImpl) SaveTenant(tenant models.Tenant) error {
-	err := t.TenantRepository.SaveTenant(tenant)
-	return err
+func (t *TenantServiceImpl) SaveTenant(ctx context.Context, tenant models.Tenant) (string, error) {
+	return t.TenantRepository.SaveTenant(ctx, tenant)
 }
 
-func (t *TenantServiceImpl) FindAllTenantsPaginated(pagination models.Pagination) ([]models.Tenant, int, error) {
-	return t.TenantRepository.FindAllTenantsPaginated(pagination)
+func (t *TenantServiceImpl) FindAllTenantsPaginated(ctx context.Context, pagination models.Pagination) ([]models.Tenant, int, error) {
+	return t.TenantRepository.FindAllTenantsPaginated(ctx, pagination)
 }
 
-func (t *TenantServiceImpl) FindAllTenants() ([]models.Tenant, error) {
-	return t.TenantRepository.FindAllTenants()
+func (t *TenantServiceImpl) FindAllTenants(ctx context.Context) ([]models.Tenant, error) {
+	return t.TenantRepository.FindAllTenants(ctx)
 }
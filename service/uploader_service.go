@@ -1,9 +1,10 @@
 package service
 
 import (
+	"context"
 	pb "github.com/ocfl-archive/dlza-manager/dlzamanagerproto"
 )
 
 type UploaderService interface {
-	TenantHasAccess(object *pb.UploaderAccessObject) (pb.Status, error)
+	TenantHasAccess(ctx context.Context, object *pb.UploaderAccessObject) (pb.Status, error)
 }
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"emperror.dev/errors"
 	"github.com/ocfl-archive/dlza-manager-handler/repository"
 	pb "github.com/ocfl-archive/dlza-manager/dlzamanagerproto"
@@ -15,20 +16,20 @@ type UploaderServiceImpl struct {
 	CollectionRepository repository.CollectionRepository
 }
 
-func (u *UploaderServiceImpl) TenantHasAccess(object *pb.UploaderAccessObject) (pb.Status, error) {
-	tenant, err := u.TenantRepository.FindTenantByKey(object.Key)
+func (u *UploaderServiceImpl) TenantHasAccess(ctx context.Context, object *pb.UploaderAccessObject) (pb.Status, error) {
+	tenant, err := u.TenantRepository.FindTenantByKey(ctx, object.Key)
 	if err != nil {
 		return pb.Status{Ok: false}, errors.Wrapf(err, "Could not get tenant with id: '%s'", object.Key)
 	}
 	if tenant.Id == "" {
-		return pb.Status{Ok: false}, errors.New("The given key is invalid")
+		return pb.Status{Ok: false}, errors.Wrap(repository.ErrInvalidArgument, "The given key is invalid")
 	}
-	collection, err := u.CollectionRepository.GetCollectionByAlias(object.Collection)
+	collection, err := u.CollectionRepository.GetCollectionByAlias(ctx, object.Collection)
 	if err != nil {
 		return pb.Status{Ok: false}, errors.Wrapf(err, "Could not get collections with alias: '%s'", object.Collection)
 	}
 	if collection.Id == "" || collection.TenantId != tenant.Id {
-		return pb.Status{Ok: false}, errors.New("The given collection does not exist or belongs to other tenant")
+		return pb.Status{Ok: false}, errors.Wrap(repository.ErrNotFound, "The given collection does not exist or belongs to other tenant")
 	}
 	return pb.Status{Ok: true}, nil
 }
@@ -0,0 +1,57 @@
+package shutdown
+
+import (
+	"context"
+	"time"
+
+	"github.com/je4/utils/v2/pkg/zLogger"
+)
+
+// Manager runs the steps of the shutdown in the order they were added. All steps share
+// the drain timeout: a step still running when it expires gets a done context and has to
+// return, the following steps run nevertheless so that everything is closed.
+type Manager struct {
+	timeout time.Duration
+	logger  zLogger.ZLogger
+	steps   []step
+}
+
+type step struct {
+	name string
+	run  func(ctx context.Context) error
+}
+
+func NewManager(timeout time.Duration, logger zLogger.ZLogger) *Manager {
+	return &Manager{timeout: timeout, logger: logger}
+}
+
+// Add appends a step. Only the errors of the steps are logged, so that the loggers can be
+// closed by a step before the last ones.
+func (m *Manager) Add(name string, run func(ctx context.Context) error) {
+	m.steps = append(m.steps, step{name: name, run: run})
+}
+
+// AddFunc appends a step that cannot fail and does not wait.
+func (m *Manager) AddFunc(name string, run func()) {
+	m.Add(name, func(context.Context) error {
+		run()
+		return nil
+	})
+}
+
+// Shutdown runs the steps.
+func (m *Manager) Shutdown() {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+	m.logger.Info().Msgf("shutting down, drain timeout %s", m.timeout)
+	for _, step := range m.steps {
+		started := time.Now()
+		if err := step.run(ctx); err != nil {
+			if ctx.Err() != nil {
+				m.logger.Error().Msgf("shutdown: %s aborted after %s: %v", step.name, time.Since(started).Round(time.Millisecond), err)
+			} else {
+				m.logger.Error().Msgf("shutdown: %s failed: %v", step.name, err)
+			}
+		}
+	}
+}
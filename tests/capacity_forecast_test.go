@@ -0,0 +1,169 @@
+package tests
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/ocfl-archive/dlza-manager-handler/repository"
+	"github.com/ocfl-archive/dlza-manager-handler/service"
+)
+
+// StatisticsRepositoryFake returns the fills and the ingest history it is given.
+type StatisticsRepositoryFake struct {
+	repository.StatisticsRepository
+	fills   []repository.StoragePartitionFill
+	history []repository.IngestHistory
+}
+
+func (s StatisticsRepositoryFake) GetStoragePartitionFills(ctx context.Context) ([]repository.StoragePartitionFill, error) {
+	return s.fills, nil
+}
+
+func (s StatisticsRepositoryFake) GetIngestHistory(ctx context.Context, since time.Time, until time.Time) ([]repository.IngestHistory, error) {
+	return s.history, nil
+}
+
+// dailyIngest is the history of a partition with the sizes ingested by the tenant on the
+// days, one object a day.
+func dailyIngest(partitionId string, tenantId string, sizes ...int64) []repository.IngestHistory {
+	history := make([]repository.IngestHistory, 0, len(sizes))
+	for day, size := range sizes {
+		history = append(history, repository.IngestHistory{StoragePartitionId: partitionId, TenantId: tenantId, Day: day, Size: size, Objects: 1})
+	}
+	return history
+}
+
+func newForecastService(statistics StatisticsRepositoryFake, provisionings ...repository.PartitionProvisioning) service.CapacityForecastService {
+	placementRepository := PlacementRepositoryFake{strategies: map[string]string{}, provisionings: make(map[string]repository.PartitionProvisioning)}
+	for _, provisioning := range provisionings {
+		placementRepository.provisionings[provisioning.StorageLocationId] = provisioning
+	}
+	return service.NewCapacityForecastService(statistics, placementRepository, 10*24*time.Hour)
+}
+
+func assertDays(t *testing.T, name string, got float64, expected float64) {
+	t.Helper()
+	if math.Abs(got-expected) > 1e-9 {
+		t.Errorf("%s is %v, expected %v", name, got, expected)
+	}
+}
+
+func TestForecastSteadyIngest(t *testing.T) {
+	forecastService := newForecastService(StatisticsRepositoryFake{
+		fills: []repository.StoragePartitionFill{{Id: "p1", Alias: "disk-partition-1", StorageLocationId: "disk", StorageLocationAlias: "disk",
+			CurrentSize: 400, MaxSize: 1000, MaxObjects: 1000}},
+		history: dailyIngest("p1", "tenant", 10, 10, 10, 10, 10, 10, 10, 10, 10, 10),
+	})
+
+	forecast, err := forecastService.ForecastCapacity(context.Background(), "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if forecast.WindowDays != 10 || len(forecast.Locations) != 1 || len(forecast.Locations[0].Partitions) != 1 {
+		t.Fatalf("unexpected forecast %+v", forecast)
+	}
+	partition := forecast.Locations[0].Partitions[0]
+	assertDays(t, "daily size", partition.DailySize, 10)
+	// the size is exhausted after 60 days, before the objects after 1000 days
+	assertDays(t, "days until full", partition.DaysUntilFull, 60)
+	assertDays(t, "earliest days until full", partition.DaysUntilFullEarliest, 60)
+	assertDays(t, "latest days until full", partition.DaysUntilFullLatest, 60)
+	assertDays(t, "location days until full", forecast.Locations[0].DaysUntilFull, 60)
+}
+
+func TestForecastConfidenceBand(t *testing.T) {
+	forecastService := newForecastService(StatisticsRepositoryFake{
+		fills: []repository.StoragePartitionFill{{Id: "p1", Alias: "disk-partition-1", StorageLocationId: "disk", StorageLocationAlias: "disk",
+			CurrentSize: 400, MaxSize: 1000, MaxObjects: 1000}},
+		history: dailyIngest("p1", "tenant", 0, 20, 0, 20, 0, 20, 0, 20, 0, 20),
+	})
+
+	forecast, err := forecastService.ForecastCapacity(context.Background(), "disk", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	partition := forecast.Locations[0].Partitions[0]
+	assertDays(t, "days until full", partition.DaysUntilFull, 60)
+	if !(partition.DailySizeLow < partition.DailySize && partition.DailySize < partition.DailySizeHigh) {
+		t.Errorf("daily size %v not within band %v - %v", partition.DailySize, partition.DailySizeLow, partition.DailySizeHigh)
+	}
+	if !(partition.DaysUntilFullEarliest < partition.DaysUntilFull && partition.DaysUntilFull < partition.DaysUntilFullLatest) {
+		t.Errorf("days until full %v not within band %v - %v", partition.DaysUntilFull, partition.DaysUntilFullEarliest, partition.DaysUntilFullLatest)
+	}
+}
+
+func TestForecastWithoutIngest(t *testing.T) {
+	forecastService := newForecastService(StatisticsRepositoryFake{
+		fills: []repository.StoragePartitionFill{
+			{Id: "p1", Alias: "disk-partition-1", StorageLocationId: "disk", StorageLocationAlias: "disk", CurrentSize: 400, MaxSize: 1000, MaxObjects: 10},
+			{Id: "p2", Alias: "disk-partition-2", StorageLocationId: "disk", StorageLocationAlias: "disk", CurrentSize: 1000, MaxSize: 1000, MaxObjects: 10},
+		},
+	})
+
+	forecast, err := forecastService.ForecastCapacity(context.Background(), "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	partitions := forecast.Locations[0].Partitions
+	assertDays(t, "days until full without ingest", partitions[0].DaysUntilFull, service.NotExhausted)
+	assertDays(t, "days until full of a full partition", partitions[1].DaysUntilFull, 0)
+	assertDays(t, "location days until full", forecast.Locations[0].DaysUntilFull, service.NotExhausted)
+}
+
+func TestForecastLocationsAndTenants(t *testing.T) {
+	statistics := StatisticsRepositoryFake{
+		fills: []repository.StoragePartitionFill{
+			{Id: "p1", Alias: "disk-partition-1", StorageLocationId: "disk", StorageLocationAlias: "disk", MaxSize: 1000, MaxObjects: 1000},
+			{Id: "p2", Alias: "disk-partition-2", StorageLocationId: "disk", StorageLocationAlias: "disk", MaxSize: 1000, MaxObjects: 1000},
+			{Id: "t1", Alias: "tape-partition-1", StorageLocationId: "tape", StorageLocationAlias: "tape", MaxSize: 5000, MaxObjects: 1000},
+		},
+	}
+	statistics.history = append(statistics.history, dailyIngest("p1", "tenant-a", 10, 10, 10, 10, 10, 10, 10, 10, 10, 10)...)
+	statistics.history = append(statistics.history, dailyIngest("p2", "tenant-b", 30, 30, 30, 30, 30, 30, 30, 30, 30, 30)...)
+	// out of the window
+	statistics.history = append(statistics.history, repository.IngestHistory{StoragePartitionId: "p2", TenantId: "tenant-b", Day: 10, Size: 1000, Objects: 1})
+	forecastService := newForecastService(statistics, repository.PartitionProvisioning{StorageLocationId: "disk", Enabled: true,
+		MaxSize: 1000, MaxObjects: 1000, MaxPartitions: 4})
+
+	forecast, err := forecastService.ForecastCapacity(context.Background(), "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(forecast.Locations) != 2 || forecast.Locations[0].Alias != "disk" || forecast.Locations[1].Alias != "tape" {
+		t.Fatalf("unexpected locations %+v", forecast.Locations)
+	}
+	disk := forecast.Locations[0]
+	// two partitions and two more that can be provisioned
+	if disk.MaxSize != 4000 {
+		t.Errorf("location max size is %v, expected 4000", disk.MaxSize)
+	}
+	assertDays(t, "location daily size", disk.DailySize, 40)
+	assertDays(t, "location days until full", disk.DaysUntilFull, 100)
+	if len(disk.Tenants) != 2 || disk.Tenants[0].TenantId != "tenant-a" || disk.Tenants[1].TenantId != "tenant-b" {
+		t.Fatalf("unexpected tenants %+v", disk.Tenants)
+	}
+	assertDays(t, "daily size of tenant-a", disk.Tenants[0].DailySize, 10)
+	assertDays(t, "daily size of tenant-b", disk.Tenants[1].DailySize, 30)
+	assertDays(t, "tape days until full", forecast.Locations[1].DaysUntilFull, service.NotExhausted)
+}
+
+func TestForecastUnknownLocation(t *testing.T) {
+	forecastService := newForecastService(StatisticsRepositoryFake{})
+
+	_, err := forecastService.ForecastCapacity(context.Background(), "unknown", 0)
+	if !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("forecast of unknown location returned %v", err)
+	}
+}
+
+func TestForecastWindowTooLong(t *testing.T) {
+	forecastService := newForecastService(StatisticsRepositoryFake{})
+
+	_, err := forecastService.ForecastCapacity(context.Background(), "", (service.MaxForecastWindowDays+1)*24*time.Hour)
+	if !errors.Is(err, repository.ErrInvalidArgument) {
+		t.Fatalf("forecast of too long window returned %v", err)
+	}
+}
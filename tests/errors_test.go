@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/ocfl-archive/dlza-manager-handler/repository"
+	"github.com/ocfl-archive/dlza-manager-handler/server"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const errorTestMethod = "/handlerproto.ClerkHandlerService/GetCollectionById"
+
+func TestErrorKind(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		kind error
+	}{
+		{"no error", nil, nil},
+		{"no rows", pgx.ErrNoRows, repository.ErrNotFound},
+		{"wrapped no rows", errors.Wrapf(pgx.ErrNoRows, "Could not execute query for method: %v", repository.GetCollectionById), repository.ErrNotFound},
+		{"unique violation", &pgconn.PgError{Code: "23505"}, repository.ErrAlreadyExists},
+		{"foreign key violation", &pgconn.PgError{Code: "23503"}, repository.ErrFailedPrecondition},
+		{"not null violation", &pgconn.PgError{Code: "23502"}, repository.ErrInvalidArgument},
+		{"invalid text representation", &pgconn.PgError{Code: "22P02"}, repository.ErrInvalidArgument},
+		{"serialization failure", &pgconn.PgError{Code: "40001"}, repository.ErrAborted},
+		{"deadlock", errors.Wrap(&pgconn.PgError{Code: "40P01"}, "cannot update"), repository.ErrAborted},
+		{"connection failure", &pgconn.PgError{Code: "08006"}, repository.ErrUnavailable},
+		{"admin shutdown", &pgconn.PgError{Code: "57P01"}, repository.ErrUnavailable},
+		{"undefined table", &pgconn.PgError{Code: "42P01"}, nil},
+		{"explicit kind", errors.Wrapf(repository.ErrInvalidArgument, "unknown placement strategy"), repository.ErrInvalidArgument},
+		{"other error", errors.New("boom"), nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if kind := repository.ErrorKind(test.err); kind != test.kind {
+				t.Errorf("kind is %v, expected %v", kind, test.kind)
+			}
+		})
+	}
+}
+
+func TestErrorInterceptors(t *testing.T) {
+	unary, _ := server.ErrorInterceptors()
+	tests := []struct {
+		name   string
+		err    error
+		code   codes.Code
+		reason string
+	}{
+		{"no rows", errors.Wrapf(pgx.ErrNoRows, "Could not get collection with id: '%s'", "1"), codes.NotFound, "NOT_FOUND"},
+		{"unique violation", &pgconn.PgError{Code: "23505"}, codes.AlreadyExists, "ALREADY_EXISTS"},
+		{"foreign key violation", &pgconn.PgError{Code: "23503"}, codes.FailedPrecondition, "FAILED_PRECONDITION"},
+		{"serialization failure", &pgconn.PgError{Code: "40001"}, codes.Aborted, "ABORTED"},
+		{"connection failure", &pgconn.PgError{Code: "08006"}, codes.Unavailable, "UNAVAILABLE"},
+		{"deadline exceeded", errors.Wrapf(context.DeadlineExceeded, "Could not get collection with id: '%s'", "1"), codes.DeadlineExceeded, "DEADLINE_EXCEEDED"},
+		{"canceled", errors.Wrap(context.Canceled, "cannot list objects"), codes.Canceled, "CANCELED"},
+		{"other error", errors.New("boom"), codes.Internal, "INTERNAL"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: errorTestMethod},
+				func(ctx context.Context, req any) (any, error) { return nil, test.err })
+			st, ok := status.FromError(err)
+			if !ok {
+				t.Fatalf("error %v is no status", err)
+			}
+			if st.Code() != test.code {
+				t.Errorf("code is %v, expected %v", st.Code(), test.code)
+			}
+			if st.Message() != test.err.Error() {
+				t.Errorf("message is %q, expected %q", st.Message(), test.err.Error())
+			}
+			if len(st.Details()) != 1 {
+				t.Fatalf("status has %d details, expected the error info", len(st.Details()))
+			}
+			info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+			if !ok {
+				t.Fatalf("detail is %T, expected the error info", st.Details()[0])
+			}
+			if info.Reason != test.reason {
+				t.Errorf("reason is %s, expected %s", info.Reason, test.reason)
+			}
+			if info.Metadata["method"] != errorTestMethod {
+				t.Errorf("method is %s, expected %s", info.Metadata["method"], errorTestMethod)
+			}
+		})
+	}
+}
+
+func TestErrorInterceptorsKeepStatus(t *testing.T) {
+	unary, _ := server.ErrorInterceptors()
+	statusErr := status.Error(codes.PermissionDenied, "api key does not match the tenant")
+	_, err := unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: errorTestMethod},
+		func(ctx context.Context, req any) (any, error) { return nil, statusErr })
+	if err != statusErr {
+		t.Errorf("status error %v replaced by %v", statusErr, err)
+	}
+}
+
+func TestErrorInterceptorsKeepResponse(t *testing.T) {
+	unary, _ := server.ErrorInterceptors()
+	resp, err := unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: errorTestMethod},
+		func(ctx context.Context, req any) (any, error) { return "response", nil })
+	if err != nil || resp != "response" {
+		t.Errorf("call returned %v, %v, expected the response", resp, err)
+	}
+}
@@ -0,0 +1,129 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/je4/utils/v2/pkg/zLogger"
+	"github.com/ocfl-archive/dlza-manager-handler/repository"
+	"github.com/ocfl-archive/dlza-manager-handler/service"
+	"github.com/rs/zerolog"
+)
+
+// OutboxRepositoryFake keeps the events and the offset of the latest published one in
+// memory. Like the outbox_offset table, the offset is kept when the events are deleted.
+type OutboxRepositoryFake struct {
+	mu         sync.Mutex
+	events     []repository.OutboxEvent
+	lastOffset int64
+}
+
+// append inserts an event that is not published yet.
+func (o *OutboxRepositoryFake) append(created time.Time, entityId string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.events = append(o.events, repository.OutboxEvent{Created: created, Type: repository.EventObjectInstanceCreated, EntityId: entityId})
+}
+
+func (o *OutboxRepositoryFake) PublishOutboxEvents(ctx context.Context, limit int) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	published := 0
+	for i := range o.events {
+		if o.events[i].Offset == 0 && published < limit {
+			published++
+			o.events[i].Offset = o.lastOffset + int64(published)
+		}
+	}
+	o.lastOffset += int64(published)
+	return published, nil
+}
+
+func (o *OutboxRepositoryFake) GetOutboxEvents(ctx context.Context, afterOffset int64, limit int) ([]repository.OutboxEvent, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var events []repository.OutboxEvent
+	for _, event := range o.events {
+		if event.Offset > afterOffset && len(events) < limit {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func (o *OutboxRepositoryFake) GetOutboxOffsets(ctx context.Context) (int64, int64, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	first := o.lastOffset + 1
+	for _, event := range o.events {
+		if event.Offset > 0 && event.Offset < first {
+			first = event.Offset
+		}
+	}
+	return first, o.lastOffset, nil
+}
+
+func (o *OutboxRepositoryFake) DeleteOutboxEventsBefore(ctx context.Context, before time.Time) (int64, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	kept := o.events[:0]
+	for _, event := range o.events {
+		if event.Offset == 0 || !event.Created.Before(before) {
+			kept = append(kept, event)
+		}
+	}
+	deleted := int64(len(o.events) - len(kept))
+	o.events = kept
+	return deleted, nil
+}
+
+// ListenOutboxEvents fails, the publisher polls.
+func (o *OutboxRepositoryFake) ListenOutboxEvents(ctx context.Context) (repository.OutboxListener, error) {
+	return nil, errors.New("no notifications")
+}
+
+var errWatchDone = errors.New("watch done")
+
+func TestPublishAfterRetentionDeletedAllEvents(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	outbox := &OutboxRepositoryFake{}
+	for _, id := range []string{"1", "2", "3"} {
+		outbox.append(time.Now().Add(-time.Hour), id)
+	}
+	if _, err := outbox.PublishOutboxEvents(ctx, 100); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := outbox.DeleteOutboxEventsBefore(ctx, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+
+	nop := zerolog.Nop()
+	var logger zLogger.ZLogger = &nop
+	publisher := service.NewEventPublisher(outbox, 10*time.Millisecond, 0, logger)
+	go publisher.Run(ctx)
+	defer publisher.Stop(context.Background())
+	outbox.append(time.Now(), "4")
+
+	// a watcher resuming after the last event it has received gets the new event
+	var received repository.OutboxEvent
+	err := publisher.Watch(ctx, 3, func(event repository.OutboxEvent) error {
+		received = event
+		return errWatchDone
+	})
+	if !errors.Is(err, errWatchDone) {
+		t.Fatalf("watch ended with %v", err)
+	}
+	if received.Offset != 4 || received.EntityId != "4" {
+		t.Errorf("received event %d of '%s', expected event 4", received.Offset, received.EntityId)
+	}
+
+	// a watcher resuming before the deleted events is refused
+	err = publisher.Watch(ctx, 1, func(event repository.OutboxEvent) error { return errWatchDone })
+	if !errors.Is(err, repository.ErrFailedPrecondition) {
+		t.Errorf("watch after deleted events ended with %v", err)
+	}
+}
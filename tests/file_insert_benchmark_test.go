@@ -0,0 +1,110 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/ocfl-archive/dlza-manager-handler/repository"
+	pb "github.com/ocfl-archive/dlza-manager/dlzamanagerproto"
+)
+
+// The benchmarks insert files for an existing object of the database DLZA_TEST_DBCONN points
+// to. Every iteration runs in a transaction which is rolled back, so the database stays as
+// it was.
+//
+//	DLZA_TEST_DBCONN=postgres://... go test ./tests/ -run '^$' -bench FileInsert
+
+var fileInsertSizes = []int{100, 10000, 100000}
+
+func benchmarkFileInsertDb(b *testing.B) (*pgxpool.Pool, string) {
+	dbConn := os.Getenv("DLZA_TEST_DBCONN")
+	if dbConn == "" {
+		b.Skip("DLZA_TEST_DBCONN is not set")
+	}
+	db, err := pgxpool.New(context.Background(), dbConn)
+	if err != nil {
+		b.Fatalf("cannot connect to database: %v", err)
+	}
+	b.Cleanup(db.Close)
+	var objectId string
+	if err := db.QueryRow(context.Background(), "SELECT id FROM object LIMIT 1").Scan(&objectId); err != nil {
+		b.Skipf("no object to attach the files to: %v", err)
+	}
+	return db, objectId
+}
+
+func benchmarkFiles(count int) []*pb.File {
+	files := make([]*pb.File, 0, count)
+	for i := 0; i < count; i++ {
+		files = append(files, &pb.File{
+			Checksum: fmt.Sprintf("%064x", i),
+			Name:     []string{fmt.Sprintf("content/file_%d.tif", i)},
+			Size:     int64(1024 * i),
+			MimeType: "image/tiff",
+			Pronom:   "fmt/353",
+			Width:    4000,
+			Height:   3000,
+		})
+	}
+	return files
+}
+
+func BenchmarkFileInsertCopy(b *testing.B) {
+	db, objectId := benchmarkFileInsertDb(b)
+	transactionRepository := repository.NewTransactionRepository(db, nil, nil)
+	for _, count := range fileInsertSizes {
+		files := benchmarkFiles(count)
+		b.Run(fmt.Sprintf("files=%d", count), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				ctx := context.Background()
+				tx, err := db.Begin(ctx)
+				if err != nil {
+					b.Fatal(err)
+				}
+				if _, err := transactionRepository.CreateFilesTx(ctx, tx, objectId, files); err != nil {
+					tx.Rollback(ctx)
+					b.Fatal(err)
+				}
+				tx.Rollback(ctx)
+			}
+		})
+	}
+}
+
+// BenchmarkFileInsertExec inserts the files row by row, as the ingest did before it used
+// COPY, for comparison.
+func BenchmarkFileInsertExec(b *testing.B) {
+	db, objectId := benchmarkFileInsertDb(b)
+	queryCreateFile := "insert into File(checksum, \"name\", \"size\", mime_type, pronom, width, height, duration, object_id) values($1, $2, $3, $4, $5, $6, $7, $8, $9)"
+	for _, count := range fileInsertSizes {
+		files := benchmarkFiles(count)
+		b.Run(fmt.Sprintf("files=%d", count), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				ctx := context.Background()
+				tx, err := db.Begin(ctx)
+				if err != nil {
+					b.Fatal(err)
+				}
+				if err := execFiles(ctx, tx, queryCreateFile, objectId, files); err != nil {
+					tx.Rollback(ctx)
+					b.Fatal(err)
+				}
+				tx.Rollback(ctx)
+			}
+		})
+	}
+}
+
+func execFiles(ctx context.Context, tx pgx.Tx, query string, objectId string, files []*pb.File) error {
+	for _, file := range files {
+		_, err := tx.Exec(ctx, query, file.Checksum, file.Name, file.Size, file.MimeType, file.Pronom, file.Width, file.Height, file.Duration, objectId)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
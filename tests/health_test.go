@@ -0,0 +1,77 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/je4/utils/v2/pkg/zLogger"
+	pbHandler "github.com/ocfl-archive/dlza-manager-handler/handlerproto"
+	"github.com/ocfl-archive/dlza-manager-handler/server"
+	"github.com/ocfl-archive/dlza-manager-handler/service"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// MaterializedViewRefresherFake returns the refresh states it holds.
+type MaterializedViewRefresherFake struct {
+	service.MaterializedViewRefresher
+	refreshes []service.MaterializedViewRefresh
+}
+
+func (m MaterializedViewRefresherFake) Refreshes() []service.MaterializedViewRefresh {
+	return m.refreshes
+}
+
+// clerkHealth returns the status of the clerk service after the first check of the monitor.
+func clerkHealth(t *testing.T, refreshes []service.MaterializedViewRefresh) healthpb.HealthCheckResponse_ServingStatus {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	nop := zerolog.Nop()
+	var logger zLogger.ZLogger = &nop
+	healthServer := health.NewServer()
+	monitor := server.NewHealthMonitor(healthServer, func(ctx context.Context) error { return nil },
+		MaterializedViewRefresherFake{refreshes: refreshes}, time.Hour, time.Minute, logger)
+	runCtx, stop := context.WithCancel(ctx)
+	defer stop()
+	go monitor.Run(runCtx)
+	for {
+		response, err := healthServer.Check(ctx, &healthpb.HealthCheckRequest{Service: pbHandler.ClerkHandlerService_ServiceDesc.ServiceName})
+		if err == nil {
+			return response.GetStatus()
+		}
+		if ctx.Err() != nil {
+			t.Fatal("health has not been checked")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestHealthOfPendingViews(t *testing.T) {
+	tests := []struct {
+		name    string
+		refresh service.MaterializedViewRefresh
+		status  healthpb.HealthCheckResponse_ServingStatus
+	}{
+		{name: "refresh not known, just requested",
+			refresh: service.MaterializedViewRefresh{View: "view", Pending: true, PendingSince: time.Now()},
+			status:  healthpb.HealthCheckResponse_SERVING},
+		{name: "old refresh, just requested",
+			refresh: service.MaterializedViewRefresh{View: "view", LastRefresh: time.Now().Add(-time.Hour), Pending: true, PendingSince: time.Now()},
+			status:  healthpb.HealthCheckResponse_SERVING},
+		{name: "requested long ago",
+			refresh: service.MaterializedViewRefresh{View: "view", Pending: true, PendingSince: time.Now().Add(-time.Hour)},
+			status:  healthpb.HealthCheckResponse_NOT_SERVING},
+		{name: "refresh not known, not pending",
+			refresh: service.MaterializedViewRefresh{View: "view"},
+			status:  healthpb.HealthCheckResponse_SERVING},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if status := clerkHealth(t, []service.MaterializedViewRefresh{test.refresh}); status != test.status {
+				t.Errorf("clerk service is %v, expected %v", status, test.status)
+			}
+		})
+	}
+}
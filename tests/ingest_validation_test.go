@@ -0,0 +1,166 @@
+package tests
+
+import (
+	"context"
+	"io"
+	"slices"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/je4/utils/v2/pkg/zLogger"
+	"github.com/ocfl-archive/dlza-manager-handler/repository"
+	"github.com/ocfl-archive/dlza-manager-handler/server"
+	pb "github.com/ocfl-archive/dlza-manager/dlzamanagerproto"
+	"github.com/rs/zerolog"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ingestStreamFake receives the messages of an ingest stream.
+type ingestStreamFake struct {
+	grpc.ServerStream
+	messages []*pb.InstanceWithPartitionAndObjectWithFile
+	status   *pb.Status
+}
+
+func (s *ingestStreamFake) Context() context.Context {
+	return context.Background()
+}
+
+func (s *ingestStreamFake) Recv() (*pb.InstanceWithPartitionAndObjectWithFile, error) {
+	if len(s.messages) == 0 {
+		return nil, io.EOF
+	}
+	message := s.messages[0]
+	s.messages = s.messages[1:]
+	return message, nil
+}
+
+func (s *ingestStreamFake) SendAndClose(status *pb.Status) error {
+	s.status = status
+	return nil
+}
+
+// CollectionRepositoryFake knows no collection.
+type CollectionRepositoryFake struct {
+	repository.CollectionRepository
+}
+
+func (c CollectionRepositoryFake) GetCollectionIdByAlias(ctx context.Context, alias string) (string, error) {
+	return "", errors.Wrapf(repository.ErrNotFound, "collection '%s'", alias)
+}
+
+// ReleaseRecordingFake records the signatures of the released reservations.
+type ReleaseRecordingFake struct {
+	repository.ReservationRepository
+	released []string
+}
+
+func (r *ReleaseRecordingFake) ReleaseStoragePartitionReservation(ctx context.Context, storagePartitionId string, signature string) error {
+	r.released = append(r.released, signature)
+	return nil
+}
+
+func ingestMessage(objectId string, collectionAlias string, file *pb.File) *pb.InstanceWithPartitionAndObjectWithFile {
+	return &pb.InstanceWithPartitionAndObjectWithFile{
+		ObjectInstance:   &pb.ObjectInstance{Id: "instance", StoragePartitionId: "partition"},
+		StoragePartition: &pb.StoragePartition{Id: "partition"},
+		Object:           &pb.Object{Id: objectId, Signature: "signature-" + objectId},
+		File:             file,
+		CollectionAlias:  collectionAlias,
+	}
+}
+
+func newIngestServer(reservations repository.ReservationRepository) *server.StorageHandlerHandlerServer {
+	nop := zerolog.Nop()
+	var logger zLogger.ZLogger = &nop
+	return &server.StorageHandlerHandlerServer{CollectionRepository: CollectionRepositoryFake{},
+		ReservationRepository: reservations, Logger: logger}
+}
+
+// violatedFields returns the fields of the BadRequest details of the error.
+func violatedFields(err error) []string {
+	var fields []string
+	for _, detail := range status.Convert(err).Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.GetFieldViolations() {
+				fields = append(fields, violation.GetField())
+			}
+		}
+	}
+	return fields
+}
+
+func TestValidateIngestStream(t *testing.T) {
+	file := &pb.File{Id: "file", Checksum: "sha512"}
+	withoutObject := ingestMessage("object", "collection", file)
+	withoutObject.Object = nil
+	withoutInstanceId := ingestMessage("object", "collection", nil)
+	withoutInstanceId.ObjectInstance.Id = ""
+
+	tests := []struct {
+		name     string
+		messages []*pb.InstanceWithPartitionAndObjectWithFile
+		fields   []string
+	}{
+		{name: "empty stream"},
+		{name: "missing object",
+			messages: []*pb.InstanceWithPartitionAndObjectWithFile{ingestMessage("object", "collection", file), withoutObject},
+			fields:   []string{"messages[1].object"}},
+		{name: "mismatched object id",
+			messages: []*pb.InstanceWithPartitionAndObjectWithFile{ingestMessage("object", "collection", file), ingestMessage("other", "collection", file)},
+			fields:   []string{"messages[1].object.id"}},
+		{name: "mismatched collection alias",
+			messages: []*pb.InstanceWithPartitionAndObjectWithFile{ingestMessage("object", "collection", file), ingestMessage("object", "other", file)},
+			fields:   []string{"messages[1].collectionAlias"}},
+		{name: "missing file",
+			messages: []*pb.InstanceWithPartitionAndObjectWithFile{ingestMessage("object", "collection", file), ingestMessage("object", "collection", nil)},
+			fields:   []string{"messages[1].file"}},
+		{name: "missing file in first message",
+			messages: []*pb.InstanceWithPartitionAndObjectWithFile{ingestMessage("object", "collection", nil), ingestMessage("object", "collection", file)},
+			fields:   []string{"messages[0].file"}},
+		{name: "missing checksum",
+			messages: []*pb.InstanceWithPartitionAndObjectWithFile{ingestMessage("object", "collection", &pb.File{Id: "file"})},
+			fields:   []string{"messages[0].file.checksum"}},
+		{name: "several violations",
+			messages: []*pb.InstanceWithPartitionAndObjectWithFile{withoutInstanceId, ingestMessage("other", "other", &pb.File{Id: "file"})},
+			fields:   []string{"messages[0].objectInstance.id", "messages[0].file", "messages[1].object.id", "messages[1].collectionAlias", "messages[1].file.checksum"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reservations := &ReleaseRecordingFake{}
+			err := newIngestServer(reservations).SaveAllTableObjectsAfterCopyingStream(&ingestStreamFake{messages: test.messages})
+			if status.Code(err) != codes.InvalidArgument {
+				t.Fatalf("got %v, expected InvalidArgument", err)
+			}
+			if fields := violatedFields(err); !slices.Equal(fields, test.fields) {
+				t.Errorf("violated fields %v, expected %v", fields, test.fields)
+			}
+			// the reservation of the object of the rejected stream is released
+			var released []string
+			if len(test.messages) > 0 {
+				released = []string{test.messages[0].GetObject().GetSignature()}
+			}
+			if !slices.Equal(reservations.released, released) {
+				t.Errorf("released reservations %v, expected %v", reservations.released, released)
+			}
+		})
+	}
+}
+
+func TestValidIngestStream(t *testing.T) {
+	file := &pb.File{Id: "file", Checksum: "sha512"}
+	streams := [][]*pb.InstanceWithPartitionAndObjectWithFile{
+		{ingestMessage("object", "collection", file), ingestMessage("object", "collection", file)},
+		{ingestMessage("object", "collection", nil)},
+	}
+	for _, messages := range streams {
+		// the valid stream gets past the validation to the unknown collection
+		err := newIngestServer(&ReleaseRecordingFake{}).SaveAllTableObjectsAfterCopyingStream(&ingestStreamFake{messages: messages})
+		if !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("got %v, expected the collection to be not found", err)
+		}
+	}
+}
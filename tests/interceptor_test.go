@@ -0,0 +1,73 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pbHandler "github.com/ocfl-archive/dlza-manager-handler/handlerproto"
+	"github.com/ocfl-archive/dlza-manager-handler/server"
+	"google.golang.org/grpc"
+)
+
+// contextServerStream is a server stream with the context of a call.
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// streamDeadline returns the deadline the stream interceptor gives the handler of method.
+func streamDeadline(t *testing.T, interceptor grpc.StreamServerInterceptor, method string) (time.Duration, bool) {
+	var remaining time.Duration
+	var ok bool
+	info := &grpc.StreamServerInfo{FullMethod: method, IsServerStream: true}
+	err := interceptor(nil, &contextServerStream{ctx: context.Background()}, info, func(srv any, stream grpc.ServerStream) error {
+		var deadline time.Time
+		deadline, ok = stream.Context().Deadline()
+		remaining = time.Until(deadline)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return remaining, ok
+}
+
+func TestActionTimeoutOfStreams(t *testing.T) {
+	_, stream := server.ActionTimeoutInterceptors(time.Millisecond, time.Hour)
+
+	remaining, ok := streamDeadline(t, stream, pbHandler.ClerkHandlerService_ExportObjectsByCollectionId_FullMethodName)
+	if !ok || remaining < 59*time.Minute {
+		t.Errorf("export stream got deadline %v in %v, expected the stream timeout", ok, remaining)
+	}
+	remaining, ok = streamDeadline(t, stream, pbHandler.StorageHandlerHandlerService_SaveAllTableObjectsAfterCopyingStream_FullMethodName)
+	if !ok || remaining < 59*time.Minute {
+		t.Errorf("upload stream got deadline %v in %v, expected the stream timeout", ok, remaining)
+	}
+	if _, ok := streamDeadline(t, stream, pbHandler.CheckerHandlerService_WatchEvents_FullMethodName); ok {
+		t.Error("watch stream got a deadline")
+	}
+
+	_, stream = server.ActionTimeoutInterceptors(time.Millisecond, 0)
+	if _, ok := streamDeadline(t, stream, pbHandler.ClerkHandlerService_ExportFilesByCollectionId_FullMethodName); ok {
+		t.Error("export stream got a deadline with stream timeout zero")
+	}
+}
+
+func TestActionTimeoutOfUnaryCalls(t *testing.T) {
+	unary, _ := server.ActionTimeoutInterceptors(time.Minute, time.Hour)
+	_, err := unary(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+		deadline, ok := ctx.Deadline()
+		if !ok || time.Until(deadline) > time.Minute {
+			t.Errorf("unary call got deadline %v at %v, expected the action timeout", ok, deadline)
+		}
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
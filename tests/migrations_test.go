@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/ocfl-archive/dlza-manager-handler/migrations"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmbeddedMigrations(t *testing.T) {
+	loaded, err := migrations.Load(migrations.MigrationFS)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, loaded)
+	for i, migration := range loaded {
+		assert.Equal(t, i+1, migration.Version)
+		assert.NotEmpty(t, migration.Up)
+		assert.NotEmpty(t, migration.Down)
+		assert.Len(t, migration.Checksum, 64)
+	}
+}
+
+func TestMigrationsWithGap(t *testing.T) {
+	fSys := fstest.MapFS{
+		"0001_first.up.sql":    {Data: []byte("CREATE TABLE a (id int);")},
+		"0001_first.down.sql":  {Data: []byte("DROP TABLE a;")},
+		"0003_third.up.sql":    {Data: []byte("CREATE TABLE c (id int);")},
+		"0003_third.down.sql":  {Data: []byte("DROP TABLE c;")},
+		"0002_second.up.sql":   {Data: []byte("CREATE TABLE b (id int);")},
+		"0002_second.down.sql": {Data: []byte("DROP TABLE b;")},
+	}
+	loaded, err := migrations.Load(fSys)
+	assert.NoError(t, err)
+	assert.Len(t, loaded, 3)
+
+	delete(fSys, "0002_second.up.sql")
+	delete(fSys, "0002_second.down.sql")
+	_, err = migrations.Load(fSys)
+	assert.Error(t, err)
+}
+
+func TestMigrationWithoutDown(t *testing.T) {
+	fSys := fstest.MapFS{
+		"0001_first.up.sql": {Data: []byte("CREATE TABLE a (id int);")},
+	}
+	_, err := migrations.Load(fSys)
+	assert.Error(t, err)
+}
+
+func TestInitialMigrationRelations(t *testing.T) {
+	loaded, err := migrations.Load(migrations.MigrationFS)
+	assert.NoError(t, err)
+	relations := loaded[0].Relations()
+	for _, relation := range []string{"tenant", "storage_partition_base", "object_instance", "file", "storage_partition", "mat_coll_obj"} {
+		assert.Contains(t, relations, relation)
+	}
+	assert.NotContains(t, relations, "object_collection_id_idx")
+}
+
+func TestMigrationRelations(t *testing.T) {
+	migration := migrations.Migration{Up: "CREATE TABLE a (id int);\ncreate view b AS SELECT * FROM a;\n" +
+		"CREATE MATERIALIZED VIEW c AS SELECT * FROM a;\nCREATE INDEX d ON a (id);"}
+	assert.Equal(t, []string{"a", "b", "c"}, migration.Relations())
+}
@@ -1,8 +1,10 @@
 package tests
 
 import (
+	"context"
 	"testing"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/ocfl-archive/dlza-manager-handler/service"
 	"github.com/ocfl-archive/dlza-manager/models"
 	"github.com/stretchr/testify/mock"
@@ -20,47 +22,52 @@ type ObjectInstanceRepositoryMock struct {
 	mock.Mock
 }
 
-func (o ObjectInstanceRepositoryMock) GetObjectInstanceExceptListOlderThanWithChecks(ids []string, timeBefore string, timeToWaitAvailability string) (models.ObjectInstance, error) {
+func (o *ObjectInstanceRepositoryMock) GetObjectInstanceExceptListOlderThanWithChecks(ctx context.Context, ids []string, timeBefore string, timeToWaitAvailability string) (models.ObjectInstance, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (o ObjectInstanceRepositoryMock) GetObjectInstanceByFileNameAndPartitionId(fileName string, partitionId string) (models.ObjectInstance, error) {
+func (o *ObjectInstanceRepositoryMock) GetObjectInstanceByFileNameAndPartitionId(ctx context.Context, fileName string, partitionId string) (models.ObjectInstance, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (o ObjectInstanceRepositoryMock) GetObjectInstancesByObjectIdPositive(id string) ([]models.ObjectInstance, error) {
+func (o *ObjectInstanceRepositoryMock) GetObjectInstancesByObjectIdPositive(ctx context.Context, id string) ([]models.ObjectInstance, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (o ObjectInstanceRepositoryMock) GetObjectInstancesBySignatureAndLocationsPathName(signature string, locationsName string) (models.ObjectInstance, error) {
+func (o *ObjectInstanceRepositoryMock) GetObjectInstancesBySignatureAndLocationsPathName(ctx context.Context, signature string, locationsName string) (models.ObjectInstance, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (o ObjectInstanceRepositoryMock) CreateObjectInstance(instance models.ObjectInstance) (string, error) {
+func (o *ObjectInstanceRepositoryMock) CreateObjectInstance(ctx context.Context, instance models.ObjectInstance) (string, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (o ObjectInstanceRepositoryMock) UpdateObjectInstance(instance models.ObjectInstance) error {
+func (o *ObjectInstanceRepositoryMock) CreateObjectInstanceTx(ctx context.Context, tx pgx.Tx, instance models.ObjectInstance) (string, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (o ObjectInstanceRepositoryMock) DeleteObjectInstance(id string) error {
+func (o *ObjectInstanceRepositoryMock) UpdateObjectInstance(ctx context.Context, instance models.ObjectInstance) error {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (o ObjectInstanceRepositoryMock) GetObjectInstanceById(id string) (models.ObjectInstance, error) {
+func (o *ObjectInstanceRepositoryMock) DeleteObjectInstance(ctx context.Context, id string) error {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (o ObjectInstanceRepositoryMock) GetObjectInstancesByObjectId(id string) ([]models.ObjectInstance, error) {
+func (o *ObjectInstanceRepositoryMock) GetObjectInstanceById(ctx context.Context, id string) (models.ObjectInstance, error) {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (o *ObjectInstanceRepositoryMock) GetObjectInstancesByObjectId(ctx context.Context, id string) ([]models.ObjectInstance, error) {
 	args := o.Called(id)
 
 	objectInstances0 := []models.ObjectInstance{{Status: okStatus}, {Status: okStatus}, {Status: okStatus}, {Status: deleteStatus}, {Status: deleteStatus}}
@@ -82,32 +89,37 @@ func (o ObjectInstanceRepositoryMock) GetObjectInstancesByObjectId(id string) ([
 	return nil, args.Error(0)
 }
 
-func (o ObjectInstanceRepositoryMock) GetObjectInstancesByObjectIdPaginated(pagination models.Pagination) ([]models.ObjectInstance, int, error) {
+func (o *ObjectInstanceRepositoryMock) GetObjectInstancesByObjectIdPaginated(ctx context.Context, pagination models.Pagination) ([]models.ObjectInstance, int, error) {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (o *ObjectInstanceRepositoryMock) GetObjectInstancesByPartitionIdPaginated(ctx context.Context, pagination models.Pagination) ([]models.ObjectInstance, int, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (o ObjectInstanceRepositoryMock) GetObjectInstancesByPartitionIdPaginated(pagination models.Pagination) ([]models.ObjectInstance, int, error) {
+func (o *ObjectInstanceRepositoryMock) ExportObjectInstancesByCollectionId(ctx context.Context, collectionId string, send func(models.ObjectInstance) error) error {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (o ObjectInstanceRepositoryMock) GetAllObjectInstances() ([]models.ObjectInstance, error) {
+func (o *ObjectInstanceRepositoryMock) GetAllObjectInstances(ctx context.Context) ([]models.ObjectInstance, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (o ObjectInstanceRepositoryMock) GetAmountOfErrorsByCollectionId(id string) (int, error) {
+func (o *ObjectInstanceRepositoryMock) GetAmountOfErrorsByCollectionId(ctx context.Context, id string) (int, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (o ObjectInstanceRepositoryMock) CreateObjectInstancePreparedStatements() error {
+func (o *ObjectInstanceRepositoryMock) CreateObjectInstancePreparedStatements() error {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (o ObjectInstanceRepositoryMock) GetObjectInstancesByName(name string) ([]models.ObjectInstance, error) {
+func (o *ObjectInstanceRepositoryMock) GetObjectInstancesByName(ctx context.Context, name string) ([]models.ObjectInstance, error) {
 	//TODO implement me
 	panic("implement me")
 }
@@ -119,7 +131,7 @@ func TestGetStatusForObjectId1(t *testing.T) {
 
 	objectInstanceService := service.ObjectInstanceServiceImpl{ObjectInstanceRepository: repositoryMock}
 
-	status, _ := objectInstanceService.GetStatusForObjectId("1")
+	status, _ := objectInstanceService.GetStatusForObjectId(context.Background(), "1")
 
 	if status != 1 {
 		panic("TestGetStatusForObjectId1 failed")
@@ -133,7 +145,7 @@ func TestGetStatusForObjectId0(t *testing.T) {
 
 	objectInstanceService := service.ObjectInstanceServiceImpl{ObjectInstanceRepository: repositoryMock}
 
-	status, _ := objectInstanceService.GetStatusForObjectId("0")
+	status, _ := objectInstanceService.GetStatusForObjectId(context.Background(), "0")
 
 	if status != 0 {
 		panic("TestGetStatusForObjectId0 failed")
@@ -147,7 +159,7 @@ func TestGetStatusForObjectId2(t *testing.T) {
 
 	objectInstanceService := service.ObjectInstanceServiceImpl{ObjectInstanceRepository: repositoryMock}
 
-	status, _ := objectInstanceService.GetStatusForObjectId("2")
+	status, _ := objectInstanceService.GetStatusForObjectId(context.Background(), "2")
 
 	if status != 2 {
 		panic("TestGetStatusForObjectId2 failed")
@@ -161,7 +173,7 @@ func TestGetStatusForObjectId3(t *testing.T) {
 
 	objectInstanceService := service.ObjectInstanceServiceImpl{ObjectInstanceRepository: repositoryMock}
 
-	status, _ := objectInstanceService.GetStatusForObjectId("3")
+	status, _ := objectInstanceService.GetStatusForObjectId(context.Background(), "3")
 
 	if status != 2 {
 		panic("TestGetStatusForObjectId2 failed")
@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ocfl-archive/dlza-manager-handler/repository"
+	"github.com/ocfl-archive/dlza-manager-handler/service"
+	"github.com/ocfl-archive/dlza-manager/models"
+)
+
+// provisioningPartitions are two full partitions of the location "disk".
+func provisioningPartitions() *StoragePartitionRepositoryFake {
+	return &StoragePartitionRepositoryFake{partitions: map[string][]models.StoragePartition{
+		"disk": {
+			{Id: "a", Alias: "disk-partition-1", StorageLocationId: "disk", MaxSize: 1000, MaxObjects: 10, CurrentSize: 1000},
+			{Id: "b", Alias: "disk-partition-3", StorageLocationId: "disk", MaxSize: 1000, MaxObjects: 10, CurrentObjects: 10},
+		},
+	}, aliasNumbers: map[string]int{"disk": 3}}
+}
+
+func newProvisioningService(t *testing.T, partitionRepository *StoragePartitionRepositoryFake, provisioning repository.PartitionProvisioning,
+	locationIds ...string) *service.StoragePartitionService {
+	partitionService := newPlacementService(partitionRepository, map[string]string{}, locationIds...)
+	if err := partitionService.PlacementRepository.SetPartitionProvisioning(context.Background(), provisioning); err != nil {
+		t.Fatal(err)
+	}
+	return partitionService
+}
+
+func TestProvisionPartition(t *testing.T) {
+	partitionRepository := provisioningPartitions()
+	partitionService := newProvisioningService(t, partitionRepository, repository.PartitionProvisioning{StorageLocationId: "disk",
+		Enabled: true, MaxSize: 500, MaxObjects: 5, MaxPartitions: 4}, "disk")
+
+	// the partition created takes the objects until it is full, then the next one is created
+	assertPlacements(t, placePartitionIds(t, partitionService, 200, "o1", "o2", "o3"),
+		"disk-partition-4", "disk-partition-4", "disk-partition-5")
+
+	created := partitionRepository.partitions["disk"][2]
+	if created.MaxSize != 500 || created.MaxObjects != 5 || created.Name != "disk-partition-4" {
+		t.Errorf("partition created as %+v", created)
+	}
+}
+
+func TestProvisionPartitionAfterAliasCreatedByHand(t *testing.T) {
+	partitionRepository := provisioningPartitions()
+	partitionRepository.partitions["disk"] = append(partitionRepository.partitions["disk"],
+		models.StoragePartition{Id: "c", Alias: "disk-partition-6", StorageLocationId: "disk", MaxSize: 1000, MaxObjects: 10, CurrentSize: 1000})
+	partitionService := newProvisioningService(t, partitionRepository, repository.PartitionProvisioning{StorageLocationId: "disk",
+		Enabled: true, MaxSize: 500, MaxObjects: 5, MaxPartitions: 5}, "disk")
+
+	// the counter is behind the alias created by hand, the next number follows the alias
+	assertPlacements(t, placePartitionIds(t, partitionService, 200, "o1"), "disk-partition-7")
+}
+
+func TestProvisionPartitionUpToMaxPartitions(t *testing.T) {
+	partitionRepository := provisioningPartitions()
+	partitionService := newProvisioningService(t, partitionRepository, repository.PartitionProvisioning{StorageLocationId: "disk",
+		Enabled: true, MaxSize: 500, MaxObjects: 5, MaxPartitions: 3}, "disk")
+
+	placePartitionIds(t, partitionService, 400, "o1")
+	partition, err := partitionService.GetStoragePartitionForLocation(context.Background(), placementRequest("o2", 400))
+	if partition != nil || err == nil {
+		t.Fatalf("placed object beyond max partitions on %v", partition)
+	}
+	if len(partitionRepository.partitions["disk"]) != 3 {
+		t.Errorf("location has %d partitions, expected 3", len(partitionRepository.partitions["disk"]))
+	}
+}
+
+func TestProvisionPartitionTooSmall(t *testing.T) {
+	partitionRepository := provisioningPartitions()
+	partitionService := newProvisioningService(t, partitionRepository, repository.PartitionProvisioning{StorageLocationId: "disk",
+		Enabled: true, MaxSize: 500, MaxObjects: 5, MaxPartitions: 10}, "disk")
+
+	partition, err := partitionService.GetStoragePartitionForLocation(context.Background(), placementRequest("o1", 600))
+	if partition != nil || err == nil {
+		t.Fatalf("placed object larger than the partitions provisioned on %v", partition)
+	}
+	if len(partitionRepository.partitions["disk"]) != 2 {
+		t.Errorf("partition provisioned that cannot take the object")
+	}
+}
+
+func TestProvisionPartitionDisabled(t *testing.T) {
+	partitionRepository := provisioningPartitions()
+	partitionService := newProvisioningService(t, partitionRepository, repository.PartitionProvisioning{StorageLocationId: "disk",
+		MaxSize: 500, MaxObjects: 5, MaxPartitions: 10}, "disk")
+
+	partition, err := partitionService.GetStoragePartitionForLocation(context.Background(), placementRequest("o1", 100))
+	if partition != nil || err == nil {
+		t.Fatalf("placed object on %v without provisioning", partition)
+	}
+}
+
+func TestProvisionPartitionAfterOtherLocations(t *testing.T) {
+	partitionRepository := provisioningPartitions()
+	partitionRepository.partitions["tape"] = []models.StoragePartition{{Id: "t1", StorageLocationId: "tape", MaxSize: 1000, MaxObjects: 10}}
+	partitionService := newProvisioningService(t, partitionRepository, repository.PartitionProvisioning{StorageLocationId: "disk",
+		Enabled: true, MaxSize: 500, MaxObjects: 5, MaxPartitions: 10}, "disk", "tape")
+
+	// the room on the other location is used before a partition is provisioned
+	assertPlacements(t, placePartitionIds(t, partitionService, 400, "o1", "o2", "o3"), "t1", "t1", "disk-partition-4")
+}
@@ -1,6 +1,8 @@
 package tests
 
 import (
+	"context"
+	"github.com/jackc/pgx/v5"
 	"github.com/ocfl-archive/dlza-manager-handler/service"
 	pb "github.com/ocfl-archive/dlza-manager/dlzamanagerproto"
 	"github.com/ocfl-archive/dlza-manager/models"
@@ -12,52 +14,77 @@ type StoragePartitionRepositoryMock struct {
 	mock.Mock
 }
 
-func (s *StoragePartitionRepositoryMock) CreateStoragePartitionGroupElement(partitionGroupElement models.StoragePartitionGroup) (string, error) {
+func (s *StoragePartitionRepositoryMock) CreateStoragePartitionGroupElement(ctx context.Context, partitionGroupElement models.StoragePartitionGroup) (string, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (s *StoragePartitionRepositoryMock) DeleteStoragePartitionGroupElementByStoragePartitionId(id string) error {
+func (s *StoragePartitionRepositoryMock) CreateStoragePartitionGroupElementTx(ctx context.Context, tx pgx.Tx, partitionGroupElement models.StoragePartitionGroup) (string, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (s *StoragePartitionRepositoryMock) GetStoragePartitionGroupElementByAlias(alias string) (models.StoragePartitionGroup, error) {
+func (s *StoragePartitionRepositoryMock) DeleteStoragePartitionGroupElementByStoragePartitionId(ctx context.Context, id string) error {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (s *StoragePartitionRepositoryMock) UpdateStoragePartitionGroupElement(partition models.StoragePartitionGroup) error {
+func (s *StoragePartitionRepositoryMock) DeleteStoragePartitionGroupElementByStoragePartitionIdTx(ctx context.Context, tx pgx.Tx, id string) error {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (s *StoragePartitionRepositoryMock) GetStoragePartitionGroupElementsByStoragePartitionId(partitionGroupId string) ([]models.StoragePartitionGroup, error) {
+func (s *StoragePartitionRepositoryMock) GetStoragePartitionGroupElementByAlias(ctx context.Context, alias string) (models.StoragePartitionGroup, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (s *StoragePartitionRepositoryMock) GetStoragePartitionByObjectSignatureAndLocation(signature string, locationId string) (models.StoragePartition, error) {
+func (s *StoragePartitionRepositoryMock) UpdateStoragePartitionGroupElement(ctx context.Context, partition models.StoragePartitionGroup) error {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (s *StoragePartitionRepositoryMock) GetStoragePartitionGroupElementsByStoragePartitionId(ctx context.Context, partitionGroupId string) ([]models.StoragePartitionGroup, error) {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (s *StoragePartitionRepositoryMock) GetStoragePartitionByObjectSignatureAndLocation(ctx context.Context, signature string, locationId string) (models.StoragePartition, error) {
 	partition3 := models.StoragePartition{Name: "Partition3", Id: "1234-5678-4321-3333", MaxSize: 1000000, MaxObjects: 100, CurrentObjects: 23, CurrentSize: 150000, StorageLocationId: "1"}
 	return partition3, nil
 }
 
-func (s *StoragePartitionRepositoryMock) DeleteStoragePartitionById(id string) error {
+func (s *StoragePartitionRepositoryMock) DeleteStoragePartitionById(ctx context.Context, id string) error {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (s *StoragePartitionRepositoryMock) GetStoragePartitionById(id string) (models.StoragePartition, error) {
+func (s *StoragePartitionRepositoryMock) DeleteStoragePartitionByIdTx(ctx context.Context, tx pgx.Tx, id string) error {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (s *StoragePartitionRepositoryMock) GetStoragePartitionsByLocationIdPaginated(pagination models.Pagination) ([]models.StoragePartition, int, error) {
+func (s *StoragePartitionRepositoryMock) GetStoragePartitionById(ctx context.Context, id string) (models.StoragePartition, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (s *StoragePartitionRepositoryMock) CreateStoragePartition(partition models.StoragePartition) (string, error) {
+func (s *StoragePartitionRepositoryMock) GetStoragePartitionByIdTx(ctx context.Context, tx pgx.Tx, id string) (models.StoragePartition, error) {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (s *StoragePartitionRepositoryMock) GetStoragePartitionsByLocationIdPaginated(ctx context.Context, pagination models.Pagination) ([]models.StoragePartition, int, error) {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (s *StoragePartitionRepositoryMock) CreateStoragePartition(ctx context.Context, partition models.StoragePartition) (string, error) {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (s *StoragePartitionRepositoryMock) CreateStoragePartitionTx(ctx context.Context, tx pgx.Tx, partition models.StoragePartition) (string, error) {
 	//TODO implement me
 	panic("implement me")
 }
@@ -67,17 +94,32 @@ func (s *StoragePartitionRepositoryMock) DeleteStoragePartition(id string) error
 	panic("implement me")
 }
 
+func (s *StoragePartitionRepositoryMock) CreateStoragePartitionWithNextAlias(ctx context.Context, partition models.StoragePartition) (models.StoragePartition, error) {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (s *StoragePartitionRepositoryMock) GetStoragePartitionGroupElementById(ctx context.Context, id string) (models.StoragePartitionGroup, error) {
+	//TODO implement me
+	panic("implement me")
+}
+
 func (s *StoragePartitionRepositoryMock) GetStoragePartition(id string) (models.StoragePartition, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (s *StoragePartitionRepositoryMock) GetStoragePartitionForLocation(locationId string) (models.StoragePartition, error) {
+func (s *StoragePartitionRepositoryMock) GetStoragePartitionForLocation(ctx context.Context, locationId string) (models.StoragePartition, error) {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (s *StoragePartitionRepositoryMock) UpdateStoragePartition(ctx context.Context, partition models.StoragePartition) error {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (s *StoragePartitionRepositoryMock) UpdateStoragePartition(partition models.StoragePartition) error {
+func (s *StoragePartitionRepositoryMock) UpdateStoragePartitionTx(ctx context.Context, tx pgx.Tx, partition models.StoragePartition) error {
 	//TODO implement me
 	panic("implement me")
 }
@@ -87,7 +129,7 @@ func (s *StoragePartitionRepositoryMock) CreateStoragePartitionPreparedStatement
 	panic("implement me")
 }
 
-func (s *StoragePartitionRepositoryMock) GetStoragePartitionsByLocationId(locationId string) ([]models.StoragePartition, error) {
+func (s *StoragePartitionRepositoryMock) GetStoragePartitionsByLocationId(ctx context.Context, locationId string) ([]models.StoragePartition, error) {
 	args := s.Called(locationId)
 	partitions := make([]models.StoragePartition, 0)
 
@@ -106,9 +148,9 @@ func TestGetStoragePartitionForLocation(t *testing.T) {
 
 	repositoryMock.On("GetStoragePartitionsByLocationId", "1").Return(nil, nil)
 
-	storagePartitionService := service.StoragePartitionService{StoragePartitionRepository: repositoryMock}
+	storagePartitionService := newPlacementService(repositoryMock, map[string]string{}, "1")
 
-	partition, _ := storagePartitionService.GetStoragePartitionForLocation(&pb.SizeAndId{Id: "1", Size: 100000})
+	partition, _ := storagePartitionService.GetStoragePartitionForLocation(context.Background(), &pb.SizeObjectLocation{Size: 100000, Object: &pb.Object{Head: "v1"}, Location: &pb.StorageLocation{Id: "1"}})
 
 	repositoryMock.AssertExpectations(t)
 
@@ -123,9 +165,9 @@ func TestGetStoragePartitionForLocationNegative(t *testing.T) {
 
 	repositoryMock.On("GetStoragePartitionsByLocationId", "1").Return(nil, nil)
 
-	storagePartitionService := service.StoragePartitionService{StoragePartitionRepository: repositoryMock}
+	storagePartitionService := newPlacementService(repositoryMock, map[string]string{}, "1")
 
-	partition, _ := storagePartitionService.GetStoragePartitionForLocation(&pb.SizeAndId{Id: "1", Size: 950000})
+	partition, _ := storagePartitionService.GetStoragePartitionForLocation(context.Background(), &pb.SizeObjectLocation{Size: 950000, Object: &pb.Object{Head: "v1"}, Location: &pb.StorageLocation{Id: "1"}})
 
 	repositoryMock.AssertExpectations(t)
 
@@ -139,9 +181,9 @@ func TestGetStoragePartitionForLocationV2(t *testing.T) {
 	repositoryMock := &StoragePartitionRepositoryMock{}
 	repositoryMock.On("GetStoragePartitionsByLocationId", "1").Return(nil, nil)
 
-	storagePartitionService := service.StoragePartitionService{StoragePartitionRepository: repositoryMock}
+	storagePartitionService := newPlacementService(repositoryMock, map[string]string{}, "1")
 	object := &pb.Object{Head: "v2", Signature: "signature"}
-	partition, _ := storagePartitionService.GetStoragePartitionForLocation(&pb.SizeAndId{Id: "1", Size: 100000, Object: object})
+	partition, _ := storagePartitionService.GetStoragePartitionForLocation(context.Background(), &pb.SizeObjectLocation{Size: 100000, Object: object, Location: &pb.StorageLocation{Id: "1"}})
 
 	repositoryMock.AssertExpectations(t)
 
@@ -149,3 +191,32 @@ func TestGetStoragePartitionForLocationV2(t *testing.T) {
 		panic("TestGetStoragePartitionForLocation failed")
 	}
 }
+
+func TestGetAndSaveStoragePartitionWithRelevantAlias(t *testing.T) {
+	partitionRepository := &StoragePartitionRepositoryFake{partitions: map[string][]models.StoragePartition{}, aliasNumbers: map[string]int{"1": 2}}
+	storagePartitionService := service.StoragePartitionService{StoragePartitionRepository: partitionRepository}
+
+	for _, alias := range []string{"1-partition-3", "1-partition-4"} {
+		partition, err := storagePartitionService.GetAndSaveStoragePartitionWithRelevantAlias(context.Background(),
+			&pb.StoragePartition{Name: "disk", StorageLocationId: "1", MaxSize: 1000, MaxObjects: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if partition.Alias != alias || partition.Name != "disk" {
+			t.Errorf("partition created as %s/%s, expected %s/disk", partition.Alias, partition.Name, alias)
+		}
+	}
+}
+
+func TestCreateStoragePartitionFirstAlias(t *testing.T) {
+	partitionRepository := &StoragePartitionRepositoryFake{partitions: map[string][]models.StoragePartition{}}
+	storagePartitionService := service.StoragePartitionService{StoragePartitionRepository: partitionRepository}
+
+	err := storagePartitionService.CreateStoragePartition(context.Background(), models.StoragePartition{StorageLocationId: "2", MaxSize: 1000, MaxObjects: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if partition := partitionRepository.partitions["2"][0]; partition.Alias != "2-partition-1" || partition.Name != "2-partition-1" {
+		t.Errorf("partition created as %s/%s, expected 2-partition-1", partition.Alias, partition.Name)
+	}
+}
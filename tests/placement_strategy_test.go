@@ -0,0 +1,310 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/ocfl-archive/dlza-manager-handler/repository"
+	"github.com/ocfl-archive/dlza-manager-handler/service"
+	pb "github.com/ocfl-archive/dlza-manager/dlzamanagerproto"
+	"github.com/ocfl-archive/dlza-manager/models"
+)
+
+// StoragePartitionRepositoryFake holds the partitions of the storage locations and the
+// last alias numbers allocated on them in memory. The embedded interface is nil, the
+// methods placement does not use panic.
+type StoragePartitionRepositoryFake struct {
+	repository.StoragePartitionRepository
+	partitions   map[string][]models.StoragePartition
+	aliasNumbers map[string]int
+}
+
+func (s *StoragePartitionRepositoryFake) GetStoragePartitionsByLocationId(ctx context.Context, locationId string) ([]models.StoragePartition, error) {
+	return s.partitions[locationId], nil
+}
+
+func (s *StoragePartitionRepositoryFake) CreateStoragePartitionWithNextAlias(ctx context.Context, partition models.StoragePartition) (models.StoragePartition, error) {
+	if s.aliasNumbers == nil {
+		s.aliasNumbers = make(map[string]int)
+	}
+	s.aliasNumbers[partition.StorageLocationId]++
+	for _, existing := range s.partitions[partition.StorageLocationId] {
+		var number int
+		if _, err := fmt.Sscanf(existing.Alias, partition.StorageLocationId+"-partition-%d", &number); err == nil && number >= s.aliasNumbers[partition.StorageLocationId] {
+			s.aliasNumbers[partition.StorageLocationId] = number + 1
+		}
+	}
+	partition.Alias = fmt.Sprintf("%s-partition-%d", partition.StorageLocationId, s.aliasNumbers[partition.StorageLocationId])
+	if partition.Name == "" {
+		partition.Name = partition.Alias
+	}
+	partition.Id = partition.Alias
+	s.partitions[partition.StorageLocationId] = append(s.partitions[partition.StorageLocationId], partition)
+	return partition, nil
+}
+
+func (s *StoragePartitionRepositoryFake) GetStoragePartitionByObjectSignatureAndLocation(ctx context.Context, signature string, locationGroup string) (models.StoragePartition, error) {
+	return models.StoragePartition{}, nil
+}
+
+// ReservationRepositoryFake adds the reservations to the partitions read from the
+// partition repository, creates the partitions provisioned in it and replaces the
+// reservation of an object, as the database does.
+type ReservationRepositoryFake struct {
+	partitionRepository repository.StoragePartitionRepository
+	reservations        map[string]models.StoragePartition
+	sizes               map[string]int64
+}
+
+func (r *ReservationRepositoryFake) ReserveStoragePartition(ctx context.Context, reservation repository.PartitionReservation,
+	choose func(partitions []models.StoragePartition) (models.StoragePartition, bool),
+	provision func(partitions []models.StoragePartition) (models.StoragePartition, bool)) (models.StoragePartition, bool, error) {
+	stored, err := r.partitionRepository.GetStoragePartitionsByLocationId(ctx, reservation.StorageLocationId)
+	if err != nil {
+		return models.StoragePartition{}, false, err
+	}
+	delete(r.reservations, reservation.Signature)
+	partitions := make([]models.StoragePartition, len(stored))
+	copy(partitions, stored)
+	for i := range partitions {
+		for signature, reserved := range r.reservations {
+			if reserved.Id == partitions[i].Id {
+				partitions[i].CurrentSize += r.sizes[signature]
+				partitions[i].CurrentObjects++
+			}
+		}
+	}
+	partition, ok := choose(partitions)
+	if !ok && provision != nil {
+		if partition, ok = provision(partitions); !ok {
+			return partition, false, nil
+		}
+		if partition, err = r.partitionRepository.CreateStoragePartitionWithNextAlias(ctx, partition); err != nil {
+			return models.StoragePartition{}, false, err
+		}
+	}
+	if ok {
+		r.reservations[reservation.Signature] = partition
+		r.sizes[reservation.Signature] = reservation.Size
+	}
+	return partition, ok, nil
+}
+
+func (r *ReservationRepositoryFake) ReleaseStoragePartitionReservation(ctx context.Context, storagePartitionId string, signature string) error {
+	return nil
+}
+
+func (r *ReservationRepositoryFake) ReleaseStoragePartitionReservationTx(ctx context.Context, tx pgx.Tx, storagePartitionId string, signature string) error {
+	return nil
+}
+
+type PlacementRepositoryFake struct {
+	strategies    map[string]string
+	provisionings map[string]repository.PartitionProvisioning
+}
+
+func (p PlacementRepositoryFake) GetPlacementStrategy(ctx context.Context, storageLocationId string) (string, error) {
+	return p.strategies[storageLocationId], nil
+}
+
+func (p PlacementRepositoryFake) SetPlacementStrategy(ctx context.Context, storageLocationId string, strategy string) error {
+	p.strategies[storageLocationId] = strategy
+	return nil
+}
+
+func (p PlacementRepositoryFake) GetPartitionProvisioning(ctx context.Context, storageLocationId string) (repository.PartitionProvisioning, error) {
+	return p.provisionings[storageLocationId], nil
+}
+
+func (p PlacementRepositoryFake) SetPartitionProvisioning(ctx context.Context, provisioning repository.PartitionProvisioning) error {
+	p.provisionings[provisioning.StorageLocationId] = provisioning
+	return nil
+}
+
+func (p PlacementRepositoryFake) GetPlacementStrategyTx(ctx context.Context, tx pgx.Tx, storageLocationId string) (string, error) {
+	return p.GetPlacementStrategy(ctx, storageLocationId)
+}
+
+func (p PlacementRepositoryFake) SetPlacementStrategyTx(ctx context.Context, tx pgx.Tx, storageLocationId string, strategy string) error {
+	return p.SetPlacementStrategy(ctx, storageLocationId, strategy)
+}
+
+func (p PlacementRepositoryFake) GetPartitionProvisioningTx(ctx context.Context, tx pgx.Tx, storageLocationId string) (repository.PartitionProvisioning, error) {
+	return p.GetPartitionProvisioning(ctx, storageLocationId)
+}
+
+func (p PlacementRepositoryFake) SetPartitionProvisioningTx(ctx context.Context, tx pgx.Tx, provisioning repository.PartitionProvisioning) error {
+	return p.SetPartitionProvisioning(ctx, provisioning)
+}
+
+// ObjectRepositoryFake knows the object with the signature "signature".
+type ObjectRepositoryFake struct {
+	repository.ObjectRepository
+}
+
+func (o ObjectRepositoryFake) GetObjectBySignatureAndStorageLocationGroup(ctx context.Context, signature string, locationGroup string) (models.Object, error) {
+	if signature == "signature" {
+		return models.Object{Id: "object-1", Signature: signature}, nil
+	}
+	return models.Object{}, nil
+}
+
+// StorageLocationRepositoryFake returns the locations of every tenant and group.
+type StorageLocationRepositoryFake struct {
+	repository.StorageLocationRepository
+	locations []models.StorageLocation
+}
+
+func (s StorageLocationRepositoryFake) GetStorageLocationsByTenantIdAndGroup(ctx context.Context, tenantId string, group string) ([]models.StorageLocation, error) {
+	return s.locations, nil
+}
+
+// newPlacementService places on the locations in the order given, with the strategies
+// configured by location id.
+func newPlacementService(partitionRepository repository.StoragePartitionRepository, strategies map[string]string, locationIds ...string) *service.StoragePartitionService {
+	locations := make([]models.StorageLocation, 0, len(locationIds))
+	for _, id := range locationIds {
+		locations = append(locations, models.StorageLocation{Id: id})
+	}
+	return &service.StoragePartitionService{
+		StoragePartitionRepository: partitionRepository,
+		StorageLocationRepository:  StorageLocationRepositoryFake{locations: locations},
+		ObjectRepository:           ObjectRepositoryFake{},
+		ReservationRepository: &ReservationRepositoryFake{partitionRepository: partitionRepository,
+			reservations: make(map[string]models.StoragePartition), sizes: make(map[string]int64)},
+		ReservationTTL:      time.Hour,
+		PlacementRepository: PlacementRepositoryFake{strategies: strategies, provisionings: make(map[string]repository.PartitionProvisioning)},
+		PlacementStrategies: service.NewPlacementStrategies(),
+	}
+}
+
+func placementRequest(signature string, size int64) *pb.SizeObjectLocation {
+	return &pb.SizeObjectLocation{Size: size, Object: &pb.Object{Signature: signature, Head: "v1"},
+		Location: &pb.StorageLocation{Id: "disk", TenantId: "tenant", Group: "group"}}
+}
+
+// placementPartitions are partitions of the location "disk" with max size 1000: a full
+// one, a half full one and two with little use.
+func placementPartitions() *StoragePartitionRepositoryFake {
+	return &StoragePartitionRepositoryFake{partitions: map[string][]models.StoragePartition{
+		"disk": {
+			{Id: "a", StorageLocationId: "disk", MaxSize: 1000, MaxObjects: 10, CurrentSize: 990, CurrentObjects: 9},
+			{Id: "b", StorageLocationId: "disk", MaxSize: 1000, MaxObjects: 10, CurrentSize: 500, CurrentObjects: 5},
+			{Id: "c", StorageLocationId: "disk", MaxSize: 1000, MaxObjects: 10, CurrentSize: 100, CurrentObjects: 1},
+			{Id: "d", StorageLocationId: "disk", MaxSize: 2000, MaxObjects: 10, CurrentSize: 100, CurrentObjects: 1},
+		},
+	}}
+}
+
+// placePartitionIds places the objects with the signatures one after the other and returns
+// the ids of the partitions chosen.
+func placePartitionIds(t *testing.T, partitionService *service.StoragePartitionService, size int64, signatures ...string) []string {
+	t.Helper()
+	ids := make([]string, 0, len(signatures))
+	for _, signature := range signatures {
+		partition, err := partitionService.GetStoragePartitionForLocation(context.Background(), placementRequest(signature, size))
+		if err != nil {
+			t.Fatalf("placement of %s failed: %v", signature, err)
+		}
+		ids = append(ids, partition.Id)
+	}
+	return ids
+}
+
+func assertPlacements(t *testing.T, got []string, expected ...string) {
+	t.Helper()
+	if len(got) != len(expected) {
+		t.Fatalf("placed on %v, expected %v", got, expected)
+	}
+	for i := range got {
+		if got[i] != expected[i] {
+			t.Fatalf("placed on %v, expected %v", got, expected)
+		}
+	}
+}
+
+func TestFillFirstPlacement(t *testing.T) {
+	partitionService := newPlacementService(placementPartitions(), map[string]string{"disk": service.FillFirstPlacement}, "disk")
+
+	// b is the fullest partition with room, until the reservations have filled it
+	assertPlacements(t, placePartitionIds(t, partitionService, 200, "o1", "o2", "o3", "o4"), "b", "b", "d", "d")
+}
+
+func TestFillFirstIsDefaultPlacement(t *testing.T) {
+	partitionService := newPlacementService(placementPartitions(), map[string]string{}, "disk")
+
+	assertPlacements(t, placePartitionIds(t, partitionService, 200, "o1"), "b")
+}
+
+func TestLeastUsedPlacement(t *testing.T) {
+	partitionService := newPlacementService(placementPartitions(), map[string]string{"disk": service.LeastUsedPlacement}, "disk")
+
+	// d has the lowest fill ratio, c takes over once the reservation on d has raised it
+	assertPlacements(t, placePartitionIds(t, partitionService, 100, "o1", "o2", "o3"), "d", "c", "d")
+}
+
+func TestRoundRobinPlacement(t *testing.T) {
+	partitionService := newPlacementService(placementPartitions(), map[string]string{"disk": service.RoundRobinPlacement}, "disk")
+
+	// a has no room for the object and is skipped
+	assertPlacements(t, placePartitionIds(t, partitionService, 100, "o1", "o2", "o3", "o4"), "b", "c", "d", "b")
+}
+
+func TestHashBySignaturePlacement(t *testing.T) {
+	partitionService := newPlacementService(placementPartitions(), map[string]string{"disk": service.HashBySignaturePlacement}, "disk")
+
+	// placed again, the object replaces its reservation on the same partition
+	first := placePartitionIds(t, partitionService, 20, "object")[0]
+	assertPlacements(t, placePartitionIds(t, partitionService, 20, "object", "object"), first, first)
+
+	spread := make(map[string]bool)
+	for _, id := range placePartitionIds(t, partitionService, 20, "s1", "s2", "s3", "s4", "s5", "s6", "s7", "s8", "s9", "s10", "s11", "s12") {
+		spread[id] = true
+	}
+	if spread["a"] {
+		t.Errorf("object placed on partition a without room")
+	}
+	if len(spread) < 2 {
+		t.Errorf("all signatures placed on %v", spread)
+	}
+}
+
+func TestPlacementStrategyPerLocation(t *testing.T) {
+	partitionRepository := &StoragePartitionRepositoryFake{partitions: map[string][]models.StoragePartition{
+		"tape": {
+			{Id: "t1", StorageLocationId: "tape", MaxSize: 1000, MaxObjects: 10, CurrentSize: 100},
+			{Id: "t2", StorageLocationId: "tape", MaxSize: 1000, MaxObjects: 10, CurrentSize: 600},
+		},
+		"disk": {
+			{Id: "d1", StorageLocationId: "disk", MaxSize: 1000, MaxObjects: 10, CurrentSize: 100},
+			{Id: "d2", StorageLocationId: "disk", MaxSize: 1000, MaxObjects: 10, CurrentSize: 600},
+		},
+	}}
+	strategies := map[string]string{"tape": service.FillFirstPlacement, "disk": service.LeastUsedPlacement}
+
+	assertPlacements(t, placePartitionIds(t, newPlacementService(partitionRepository, strategies, "tape"), 100, "o1"), "t2")
+	assertPlacements(t, placePartitionIds(t, newPlacementService(partitionRepository, strategies, "disk"), 100, "o1"), "d1")
+}
+
+func TestPlacementFallsBackToNextLocation(t *testing.T) {
+	partitionRepository := &StoragePartitionRepositoryFake{partitions: map[string][]models.StoragePartition{
+		"full": {{Id: "f1", StorageLocationId: "full", MaxSize: 1000, MaxObjects: 10, CurrentSize: 1000}},
+		"disk": {{Id: "d1", StorageLocationId: "disk", MaxSize: 1000, MaxObjects: 10}},
+	}}
+	partitionService := newPlacementService(partitionRepository, map[string]string{}, "full", "disk")
+
+	assertPlacements(t, placePartitionIds(t, partitionService, 100, "o1"), "d1")
+}
+
+func TestPlacementWithoutRoom(t *testing.T) {
+	partitionService := newPlacementService(placementPartitions(), map[string]string{"disk": service.RoundRobinPlacement}, "disk")
+
+	partition, err := partitionService.GetStoragePartitionForLocation(context.Background(), placementRequest("object", 5000))
+	if partition != nil || err == nil {
+		t.Fatalf("placed object larger than every partition on %v", partition)
+	}
+}
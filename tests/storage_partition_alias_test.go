@@ -0,0 +1,188 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/ocfl-archive/dlza-manager-handler/migrations"
+	"github.com/ocfl-archive/dlza-manager-handler/repository"
+	"github.com/ocfl-archive/dlza-manager/models"
+)
+
+// The tests allocate partition aliases on a storage location they create in the database
+// DLZA_TEST_DBCONN points to, and delete it with its partitions afterwards.
+//
+//	DLZA_TEST_DBCONN=postgres://... go test ./tests/ -run StoragePartitionAlias
+
+const storagePartitionAliasMigration = 9
+
+func storagePartitionAliasDb(t *testing.T) (*pgxpool.Pool, string) {
+	dbConn := os.Getenv("DLZA_TEST_DBCONN")
+	if dbConn == "" {
+		t.Skip("DLZA_TEST_DBCONN is not set")
+	}
+	config, err := pgxpool.ParseConfig(dbConn)
+	if err != nil {
+		t.Fatalf("cannot parse DLZA_TEST_DBCONN: %v", err)
+	}
+	config.AfterConnect = repository.CreateStoragePartitionPreparedStatements
+	ctx := context.Background()
+	db, err := pgxpool.NewWithConfig(ctx, config)
+	if err != nil {
+		t.Fatalf("cannot connect to database: %v", err)
+	}
+	t.Cleanup(db.Close)
+
+	alias := fmt.Sprintf("alias-test-%d", time.Now().UnixNano())
+	var tenantId, locationId string
+	if err := db.QueryRow(ctx, "INSERT INTO tenant(name, alias) VALUES ($1, $1) RETURNING id", alias).Scan(&tenantId); err != nil {
+		t.Fatalf("cannot create tenant: %v", err)
+	}
+	err = db.QueryRow(ctx, "INSERT INTO storage_location(alias, type, connection, tenant_id) VALUES ($1, 'local', '{}', $2) RETURNING id",
+		alias, tenantId).Scan(&locationId)
+	if err != nil {
+		db.Exec(ctx, "DELETE FROM tenant WHERE id = $1", tenantId)
+		t.Fatalf("cannot create storage location: %v", err)
+	}
+	t.Cleanup(func() {
+		for _, statement := range []string{
+			"DELETE FROM storage_partition_base WHERE storage_location_id = $1",
+			"DELETE FROM storage_location WHERE id = $1",
+		} {
+			if _, err := db.Exec(ctx, statement, locationId); err != nil {
+				t.Errorf("cannot clean up storage location %s: %v", locationId, err)
+			}
+		}
+		if _, err := db.Exec(ctx, "DELETE FROM tenant WHERE id = $1", tenantId); err != nil {
+			t.Errorf("cannot clean up tenant %s: %v", tenantId, err)
+		}
+	})
+	return db, locationId
+}
+
+func createStoragePartitionByHand(t *testing.T, db *pgxpool.Pool, locationId string, number int) {
+	_, err := db.Exec(context.Background(), "INSERT INTO storage_partition_base(alias, name, max_size, max_objects, storage_location_id) VALUES ($1, $1, 1, 1, $2)",
+		fmt.Sprintf("%s-partition-%d", locationId, number), locationId)
+	if err != nil {
+		t.Fatalf("cannot create partition %d by hand: %v", number, err)
+	}
+}
+
+func nextStoragePartitionAlias(t *testing.T, storagePartitionRepository repository.StoragePartitionRepository, locationId string) string {
+	partition, err := storagePartitionRepository.CreateStoragePartitionWithNextAlias(context.Background(),
+		models.StoragePartition{StorageLocationId: locationId, MaxSize: 1, MaxObjects: 1})
+	if err != nil {
+		t.Fatalf("cannot create partition with next alias: %v", err)
+	}
+	return partition.Alias
+}
+
+func TestStoragePartitionAliasConcurrent(t *testing.T) {
+	db, locationId := storagePartitionAliasDb(t)
+	storagePartitionRepository := repository.NewStoragePartitionRepository(db)
+
+	const count = 20
+	aliases := make(chan string, count)
+	var wg sync.WaitGroup
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			partition, err := storagePartitionRepository.CreateStoragePartitionWithNextAlias(context.Background(),
+				models.StoragePartition{StorageLocationId: locationId, MaxSize: 1, MaxObjects: 1})
+			if err != nil {
+				t.Errorf("cannot create partition with next alias: %v", err)
+				return
+			}
+			aliases <- partition.Alias
+		}()
+	}
+	wg.Wait()
+	close(aliases)
+
+	allocated := make(map[string]bool)
+	for alias := range aliases {
+		if allocated[alias] {
+			t.Errorf("alias %s allocated twice", alias)
+		}
+		allocated[alias] = true
+	}
+	for number := 1; number <= count; number++ {
+		if alias := fmt.Sprintf("%s-partition-%d", locationId, number); !allocated[alias] {
+			t.Errorf("alias %s not allocated", alias)
+		}
+	}
+}
+
+func TestStoragePartitionAliasAfterCreatedByHand(t *testing.T) {
+	db, locationId := storagePartitionAliasDb(t)
+	storagePartitionRepository := repository.NewStoragePartitionRepository(db)
+
+	// the location has no counter yet
+	createStoragePartitionByHand(t, db, locationId, 7)
+	if alias := nextStoragePartitionAlias(t, storagePartitionRepository, locationId); alias != locationId+"-partition-8" {
+		t.Errorf("allocated %s after partition 7 created by hand, expected partition 8", alias)
+	}
+	// the counter is behind the number created by hand
+	createStoragePartitionByHand(t, db, locationId, 20)
+	if alias := nextStoragePartitionAlias(t, storagePartitionRepository, locationId); alias != locationId+"-partition-21" {
+		t.Errorf("allocated %s after partition 20 created by hand, expected partition 21", alias)
+	}
+}
+
+func TestStoragePartitionAliasCounterBackfill(t *testing.T) {
+	db, locationId := storagePartitionAliasDb(t)
+	loaded, err := migrations.Load(migrations.MigrationFS)
+	if err != nil {
+		t.Fatalf("cannot load migrations: %v", err)
+	}
+	var backfill string
+	for _, migration := range loaded {
+		if migration.Version != storagePartitionAliasMigration {
+			continue
+		}
+		for _, statement := range strings.Split(migration.Up, ";") {
+			if strings.HasPrefix(strings.TrimSpace(statement), "INSERT INTO storage_partition_alias_counter") {
+				backfill = statement
+			}
+		}
+	}
+	if backfill == "" {
+		t.Fatalf("migration %d has no backfill of the alias counters", storagePartitionAliasMigration)
+	}
+
+	createStoragePartitionByHand(t, db, locationId, 5)
+	// the counters are dropped and backfilled as before the migration, in a transaction
+	// which is rolled back
+	ctx := context.Background()
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback(ctx)
+	if _, err := tx.Exec(ctx, "DELETE FROM storage_partition_alias_counter"); err != nil {
+		t.Fatalf("cannot delete alias counters: %v", err)
+	}
+	if _, err := tx.Exec(ctx, backfill); err != nil {
+		t.Fatalf("cannot backfill alias counters: %v", err)
+	}
+	var number int
+	if err := tx.QueryRow(ctx, "SELECT last_number FROM storage_partition_alias_counter WHERE storage_location_id = $1", locationId).Scan(&number); err != nil {
+		t.Fatalf("no alias counter backfilled for the location: %v", err)
+	}
+	if number != 5 {
+		t.Errorf("backfilled alias counter %d, expected 5", number)
+	}
+	if err := tx.QueryRow(ctx, repository.NextStoragePartitionAliasNumber, locationId).Scan(&number); err != nil {
+		t.Fatalf("cannot allocate next alias number: %v", err)
+	}
+	if number != 6 {
+		t.Errorf("allocated alias number %d after backfill, expected 6", number)
+	}
+}
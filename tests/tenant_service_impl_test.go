@@ -1,8 +1,10 @@
 package tests
 
 import (
+	"context"
 	"testing"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/ocfl-archive/dlza-manager-handler/service"
 	"github.com/ocfl-archive/dlza-manager/models"
 
@@ -13,32 +15,57 @@ type TenantRepositoryMock struct {
 	mock.Mock
 }
 
-func (m TenantRepositoryMock) FindTenantByCollectionId(id string) (models.Tenant, error) {
+func (m *TenantRepositoryMock) FindTenantByCollectionId(ctx context.Context, id string) (models.Tenant, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (m TenantRepositoryMock) GetAmountOfObjectsAndTotalSizeByTenantId(id string) (int64, int64, error) {
+func (m *TenantRepositoryMock) FindTenantByCollectionAlias(ctx context.Context, alias string) (models.Tenant, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (m TenantRepositoryMock) FindTenantByKey(key string) (models.Tenant, error) {
+func (m *TenantRepositoryMock) GetAmountOfObjectsAndTotalSizeByTenantId(ctx context.Context, id string) (int64, int64, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (m TenantRepositoryMock) FindAllTenantsPaginated(pagination models.Pagination) ([]models.Tenant, int, error) {
+func (m *TenantRepositoryMock) FindTenantByKey(ctx context.Context, key string) (models.Tenant, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (m TenantRepositoryMock) SaveTenant(tenant models.Tenant) error {
+func (m *TenantRepositoryMock) FindAllTenantsPaginated(ctx context.Context, pagination models.Pagination) ([]models.Tenant, int, error) {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (m *TenantRepositoryMock) FindTenantByIdTx(ctx context.Context, tx pgx.Tx, id string) (models.Tenant, error) {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (m *TenantRepositoryMock) SaveTenantTx(ctx context.Context, tx pgx.Tx, tenant models.Tenant) (string, error) {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (m *TenantRepositoryMock) UpdateTenantTx(ctx context.Context, tx pgx.Tx, tenant models.Tenant) error {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (m *TenantRepositoryMock) DeleteTenantTx(ctx context.Context, tx pgx.Tx, id string) error {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (m *TenantRepositoryMock) SaveTenant(ctx context.Context, tenant models.Tenant) (string, error) {
 	args := m.Called(tenant)
-	return args.Error(0)
+	return args.String(0), args.Error(1)
 }
 
-func (m TenantRepositoryMock) FindTenantById(id string) (models.Tenant, error) {
+func (m *TenantRepositoryMock) FindTenantById(ctx context.Context, id string) (models.Tenant, error) {
 	args := m.Called(id)
 	tenant := models.Tenant{
 		Id:     "1",
@@ -50,18 +77,18 @@ func (m TenantRepositoryMock) FindTenantById(id string) (models.Tenant, error) {
 
 	return tenant, args.Error(0)
 }
-func (m TenantRepositoryMock) UpdateTenant(tenant models.Tenant) error {
+func (m *TenantRepositoryMock) UpdateTenant(ctx context.Context, tenant models.Tenant) error {
 	args := m.Called(tenant)
 	return args.Error(0)
 }
-func (m TenantRepositoryMock) DeleteTenant(id string) error {
+func (m *TenantRepositoryMock) DeleteTenant(ctx context.Context, id string) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
-func (m TenantRepositoryMock) FindAllTenants() ([]models.Tenant, error) {
+func (m *TenantRepositoryMock) FindAllTenants(ctx context.Context) ([]models.Tenant, error) {
 	return []models.Tenant{}, nil
 }
-func (m TenantRepositoryMock) CreatePreparedStatements() error {
+func (m *TenantRepositoryMock) CreatePreparedStatements() error {
 	return nil
 }
 
@@ -75,7 +102,7 @@ func TestSaveTenant(t *testing.T) {
 		Email:  "test@test",
 	}
 
-	repositoryMock.On("SaveTenant", tenant).Return(nil)
+	repositoryMock.On("SaveTenant", tenant).Return("1", nil)
 
 	tenantService := service.TenantServiceImpl{TenantRepository: repositoryMock}
 
@@ -86,7 +113,10 @@ func TestSaveTenant(t *testing.T) {
 		Email:  tenant.Email,
 	}
 
-	tenantService.SaveTenant(tenantRequest)
+	id, err := tenantService.SaveTenant(context.Background(), tenantRequest)
+	if err != nil || id != "1" {
+		t.Errorf("saved tenant got id '%s', err: %v", id, err)
+	}
 
 	repositoryMock.AssertExpectations(t)
 
@@ -100,7 +130,7 @@ func TestFindTenantById(t *testing.T) {
 
 	tenantService := service.TenantServiceImpl{TenantRepository: repositoryMock}
 
-	tenantService.FindTenantById("1")
+	tenantService.FindTenantById(context.Background(), "1")
 
 	repositoryMock.AssertExpectations(t)
 
@@ -120,7 +150,7 @@ func TestUpdateTenant(t *testing.T) {
 	repositoryMock.On("UpdateTenant", tenant).Return(nil)
 	tenantService := service.TenantServiceImpl{TenantRepository: repositoryMock}
 
-	tenantService.UpdateTenant(tenant)
+	tenantService.UpdateTenant(context.Background(), tenant)
 
 	repositoryMock.AssertExpectations(t)
 }